@@ -54,11 +54,13 @@ func DefaultTextileConfig(t util.TestingTWithCleanup) core.Config {
 		AddrGatewayURL:            fmt.Sprintf("http://127.0.0.1:%d", gatewayPort),
 		IPNSRepublishSchedule:     "0 1 * * *",
 		IPNSRepublishConcurrency:  5,
+		IPNSKeySecret:             SessionSecret,
 		CustomerioAPIKey:          os.Getenv("CUSTOMERIO_API_KEY"),
 		CustomerioConfirmTmpl:     os.Getenv("CUSTOMERIO_CONFIRM_TMPL"),
 		CustomerioInviteTmpl:      os.Getenv("CUSTOMERIO_INVITE_TMPL"),
 		EmailSessionSecret:        SessionSecret,
 		MaxBucketArchiveRepFactor: 4,
+		ExportDir:                 t.TempDir(),
 	}
 }
 
@@ -23,6 +23,8 @@ const (
 	BucketArchiveCreated
 	MailboxCreated
 	ThreadDbCreated
+	KeyRotated
+	OrgOwnershipTransferred
 )
 
 func (e Event) String() string {
@@ -57,6 +59,10 @@ func (e Event) String() string {
 		return "mailbox_created"
 	case ThreadDbCreated:
 		return "threaddb_created"
+	case KeyRotated:
+		return "key_rotated"
+	case OrgOwnershipTransferred:
+		return "org_ownership_transferred"
 	default:
 		return fmt.Sprintf("%d", int(e))
 	}
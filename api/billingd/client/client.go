@@ -139,6 +139,14 @@ func (c *Client) RecreateCustomerSubscription(ctx context.Context, key thread.Pu
 	return err
 }
 
+func (c *Client) UpdateCustomerEmail(ctx context.Context, key thread.PubKey, email string) error {
+	_, err := c.c.UpdateCustomerEmail(ctx, &pb.UpdateCustomerEmailRequest{
+		Key:   key.String(),
+		Email: email,
+	})
+	return err
+}
+
 func (c *Client) DeleteCustomer(ctx context.Context, key thread.PubKey) error {
 	_, err := c.c.DeleteCustomer(ctx, &pb.DeleteCustomerRequest{
 		Key: key.String(),
@@ -203,6 +203,20 @@ func TestClient_RecreateCustomerSubscription(t *testing.T) {
 	assert.Equal(t, string(stripe.SubscriptionStatusActive), cus.SubscriptionStatus)
 }
 
+func TestClient_UpdateCustomerEmail(t *testing.T) {
+	c := setup(t)
+	key := newKey(t)
+	_, err := c.CreateCustomer(context.Background(), key, apitest.NewEmail(), apitest.NewUsername(), mdb.Dev)
+	require.NoError(t, err)
+
+	email := apitest.NewEmail()
+	err = c.UpdateCustomerEmail(context.Background(), key, email)
+	require.NoError(t, err)
+	cus, err := c.GetCustomer(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, email, cus.Email)
+}
+
 func TestClient_DeleteCustomer(t *testing.T) {
 	c := setup(t)
 	key := newKey(t)
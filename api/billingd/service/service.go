@@ -855,6 +855,28 @@ func (s *Service) RecreateCustomerSubscription(ctx context.Context, req *pb.Recr
 	return &pb.RecreateCustomerSubscriptionResponse{}, nil
 }
 
+func (s *Service) UpdateCustomerEmail(ctx context.Context, req *pb.UpdateCustomerEmailRequest) (
+	*pb.UpdateCustomerEmailResponse, error) {
+	lck := s.semaphores.Get(customerLock(req.Key))
+	lck.Acquire()
+	defer lck.Release()
+
+	doc, err := s.getCustomer(ctx, "_id", req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.stripe.Customers.Update(doc.CustomerID, &stripe.CustomerParams{
+		Email: stripe.String(req.Email),
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := s.cdb.UpdateOne(ctx, bson.M{"_id": req.Key}, bson.M{"$set": bson.M{"email": req.Email}}); err != nil {
+		return nil, err
+	}
+	log.Debugf("updated customer %s email", req.Key)
+	return &pb.UpdateCustomerEmailResponse{}, nil
+}
+
 func (s *Service) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequest) (
 	*pb.DeleteCustomerResponse, error) {
 	lck := s.semaphores.Get(customerLock(req.Key))
@@ -13,6 +13,8 @@ import (
 	"github.com/gogo/status"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
 	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/util"
@@ -64,11 +66,20 @@ func (c *Client) Create(ctx context.Context, opts ...CreateOption) (*pb.CreateRe
 	if args.fromCid.Defined() {
 		strCid = args.fromCid.String()
 	}
+	var ipnsKey []byte
+	if args.ipnsKey != nil {
+		var err error
+		ipnsKey, err = crypto.MarshalPrivateKey(args.ipnsKey)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return c.c.Create(ctx, &pb.CreateRequest{
 		Name:         args.name,
 		Private:      args.private,
 		BootstrapCid: strCid,
 		Unfreeze:     args.unfreeze,
+		IpnsKey:      ipnsKey,
 	})
 }
 
@@ -144,12 +155,17 @@ func (c *Client) PushPath(
 	if args.root != nil {
 		xr = args.root.String()
 	}
+	var info *pb.FileInfo
+	if args.info != nil {
+		info = buckets.FileInfoToPb(args.info)
+	}
 	if err = stream.Send(&pb.PushPathRequest{
 		Payload: &pb.PushPathRequest_Header_{
 			Header: &pb.PushPathRequest_Header{
 				Key:  key,
 				Path: filepath.ToSlash(pth),
 				Root: xr,
+				Info: info,
 			},
 		},
 	}); err != nil {
@@ -257,6 +273,7 @@ type PushPathsQueue struct {
 
 type pushPath struct {
 	path string
+	info *pb.FileInfo
 	r    io.Reader
 	c    io.Closer
 }
@@ -270,6 +287,8 @@ func (p pushPath) close() {
 // AddFile adds a file to the queue.
 // pth is the location relative to the bucket root at which to insert the file, e.g., "/path/to/mybone.jpg".
 // name is the location of the file on the local filesystem, e.g., "/Users/clyde/Downloads/mybone.jpg".
+// The file's mode and modification time are stored in the bucket path metadata.
+// Symbolic links are not followed. Instead, the link target is pushed as the file content.
 func (c *PushPathsQueue) AddFile(pth, name string) error {
 	c.lk.Lock()
 	defer c.lk.Unlock()
@@ -281,22 +300,35 @@ func (c *PushPathsQueue) AddFile(pth, name string) error {
 		return errors.New("cannot call AddFile after Next")
 	}
 
-	f, err := os.Open(name)
+	info, err := buckets.NewFileInfo(name)
 	if err != nil {
 		return err
 	}
-	info, err := f.Stat()
-	if err != nil {
-		f.Close()
-		return err
+	p := pushPath{
+		path: filepath.ToSlash(pth),
+		info: buckets.FileInfoToPb(&info),
+	}
+	var size int64
+	if info.IsSymlink() {
+		p.r = strings.NewReader(info.Symlink)
+		size = int64(len(info.Symlink))
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		p.r = f
+		p.c = f
+		size = fi.Size()
 	}
 
-	atomic.AddInt64(&c.size, info.Size())
-	c.q = append(c.q, pushPath{
-		path: filepath.ToSlash(pth),
-		r:    f,
-		c:    f,
-	})
+	atomic.AddInt64(&c.size, size)
+	c.q = append(c.q, p)
 	return nil
 }
 
@@ -323,6 +355,35 @@ func (c *PushPathsQueue) AddReader(pth string, r io.Reader, size int64) error {
 	return nil
 }
 
+// AddReaderWithInfo adds a reader to the queue along with file info,
+// which is stored in the bucket path metadata.
+// pth is the location relative to the bucket root at which to insert the file, e.g., "/path/to/mybone.jpg".
+// r is the reader to read from. If r is also an io.Closer, it will be closed once pushed.
+// size is the size of the reader.
+func (c *PushPathsQueue) AddReaderWithInfo(pth string, r io.Reader, size int64, info buckets.FileInfo) error {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	if c.closed {
+		return ErrPushPathQueueClosed
+	}
+	if c.started {
+		return errors.New("cannot call AddReaderWithInfo after Next")
+	}
+
+	p := pushPath{
+		path: filepath.ToSlash(pth),
+		info: buckets.FileInfoToPb(&info),
+		r:    r,
+	}
+	if rc, ok := r.(io.Closer); ok {
+		p.c = rc
+	}
+	atomic.AddInt64(&c.size, size)
+	c.q = append(c.q, p)
+	return nil
+}
+
 // Size returns the queue size in bytes.
 func (c *PushPathsQueue) Size() int64 {
 	return atomic.LoadInt64(&c.size)
@@ -533,11 +594,16 @@ func (c *Client) PushPaths(ctx context.Context, key string, opts ...Option) (*Pu
 	go func() {
 		for p := range q.inCh {
 			buf := make([]byte, chunkSize)
+			first := true
 			for {
 				n, err := p.r.Read(buf)
 				c := &pb.PushPathsRequest_Chunk{
 					Path: p.path,
 				}
+				if first { // File info is only needed with the first chunk
+					c.Info = p.info
+					first = false
+				}
 				if n > 0 {
 					c.Data = make([]byte, n)
 					copy(c.Data, buf[:n])
@@ -764,3 +830,73 @@ func (c *Client) ArchiveWatch(ctx context.Context, key string, ch chan<- string)
 	}
 	return nil
 }
+
+// AddDomain attaches a custom domain to a bucket.
+// The returned domain contains a TXT challenge that must be published before calling VerifyDomain.
+func (c *Client) AddDomain(ctx context.Context, key, domain string) (*pb.Domain, error) {
+	res, err := c.c.AddDomain(ctx, &pb.AddDomainRequest{Key: key, Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domain, nil
+}
+
+// VerifyDomain checks the TXT challenge for a custom domain.
+// Once verified, the bucket website is served at the domain.
+func (c *Client) VerifyDomain(ctx context.Context, key, domain string) (*pb.Domain, error) {
+	res, err := c.c.VerifyDomain(ctx, &pb.VerifyDomainRequest{Key: key, Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domain, nil
+}
+
+// ListDomains returns the custom domains attached to a bucket.
+func (c *Client) ListDomains(ctx context.Context, key string) ([]*pb.Domain, error) {
+	res, err := c.c.ListDomains(ctx, &pb.ListDomainsRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domains, nil
+}
+
+// RemoveDomain detaches a custom domain from a bucket.
+func (c *Client) RemoveDomain(ctx context.Context, key, domain string) error {
+	_, err := c.c.RemoveDomain(ctx, &pb.RemoveDomainRequest{Key: key, Domain: domain})
+	return err
+}
+
+// IPNSSettings returns the IPNS publishing settings for a bucket.
+func (c *Client) IPNSSettings(ctx context.Context, key string) (*pb.IPNSSettings, error) {
+	res, err := c.c.GetIPNSSettings(ctx, &pb.GetIPNSSettingsRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return res.Settings, nil
+}
+
+// SetIPNSSettings replaces the IPNS publishing settings for a bucket.
+func (c *Client) SetIPNSSettings(ctx context.Context, key string, settings *pb.IPNSSettings) (*pb.IPNSSettings, error) {
+	res, err := c.c.SetIPNSSettings(ctx, &pb.SetIPNSSettingsRequest{Key: key, Settings: settings})
+	if err != nil {
+		return nil, err
+	}
+	return res.Settings, nil
+}
+
+// ExportIPNSKey returns a bucket's IPNS private key encrypted with password or to identity.
+// Only the bucket owner can export its key. Use ipns.DecryptKey to decrypt the result.
+func (c *Client) ExportIPNSKey(ctx context.Context, key, password string, identity thread.PubKey) ([]byte, error) {
+	req := &pb.ExportIPNSKeyRequest{
+		Key:      key,
+		Password: password,
+	}
+	if identity != nil {
+		req.Identity = identity.String()
+	}
+	res, err := c.c.ExportIPNSKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
@@ -20,6 +20,7 @@ import (
 	httpapi "github.com/ipfs/go-ipfs-http-client"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tc "github.com/textileio/go-threads/api/client"
@@ -78,6 +79,27 @@ func TestClient_Create(t *testing.T) {
 	assert.NotEmpty(t, pbuck.Seed)
 }
 
+func TestClient_CreateWithIPNSKey(t *testing.T) {
+	ctx, client := setup(t)
+
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	// Bucket creation fails in a missing thread after the key is imported
+	missing := common.NewThreadIDContext(ctx, thread.NewIDV1(thread.Raw, 32))
+	_, err = client.Create(missing, c.WithIPNSKey(sk))
+	require.Error(t, err)
+
+	// The key is removed, so it can be imported again
+	buck, err := client.Create(ctx, c.WithIPNSKey(sk))
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(sk)
+	require.NoError(t, err)
+	key, err := peer.ToCid(pid).StringOfBase(multibase.Base32)
+	require.NoError(t, err)
+	assert.Equal(t, key, buck.Root.Key)
+}
+
 func TestClient_CreateWithCid(t *testing.T) {
 	ctx, client := setup(t)
 
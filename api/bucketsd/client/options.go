@@ -3,7 +3,9 @@ package client
 import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
 )
 
 type createOptions struct {
@@ -11,6 +13,7 @@ type createOptions struct {
 	private  bool
 	fromCid  cid.Cid
 	unfreeze bool
+	ipnsKey  crypto.PrivKey
 }
 
 type CreateOption func(*createOptions)
@@ -45,9 +48,18 @@ func WithUnfreeze(enabled bool) CreateOption {
 	}
 }
 
+// WithIPNSKey imports an existing private key as the bucket's IPNS key,
+// which preserves the bucket's IPNS address across deployments.
+func WithIPNSKey(sk crypto.PrivKey) CreateOption {
+	return func(args *createOptions) {
+		args.ipnsKey = sk
+	}
+}
+
 type options struct {
 	root     path.Resolved
 	progress chan<- int64
+	info     *buckets.FileInfo
 }
 
 type Option func(*options)
@@ -66,6 +78,13 @@ func WithProgress(ch chan<- int64) Option {
 	}
 }
 
+// WithFileInfo stores info in the bucket path metadata when pushing a single path.
+func WithFileInfo(info buckets.FileInfo) Option {
+	return func(args *options) {
+		args.info = &info
+	}
+}
+
 type ArchiveOption func(*pb.ArchiveRequest)
 
 // WithArchiveConfig allows you to provide a custom ArchiveConfig for a single call to Archive.
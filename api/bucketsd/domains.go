@@ -0,0 +1,144 @@
+package bucketsd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/domains"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDomainsDisabled = status.Error(codes.Unimplemented, "custom domains are not enabled")
+
+func (s *Service) AddDomain(ctx context.Context, req *pb.AddDomainRequest) (*pb.AddDomainResponse, error) {
+	log.Debugf("received add domain request")
+
+	buck, err := s.getWritableDomainBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	dbID, _ := common.ThreadIDFromContext(ctx)
+	d, err := s.Domains.Add(ctx, req.Domain, buck.Key, dbID)
+	if err != nil {
+		return nil, domainError(err)
+	}
+	return &pb.AddDomainResponse{
+		Domain: s.toPbDomain(d),
+	}, nil
+}
+
+func (s *Service) VerifyDomain(ctx context.Context, req *pb.VerifyDomainRequest) (*pb.VerifyDomainResponse, error) {
+	log.Debugf("received verify domain request")
+
+	buck, err := s.getWritableDomainBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.Domains.Verify(ctx, req.Domain, buck.Key)
+	if err != nil {
+		return nil, domainError(err)
+	}
+	return &pb.VerifyDomainResponse{
+		Domain: s.toPbDomain(d),
+	}, nil
+}
+
+func (s *Service) ListDomains(ctx context.Context, req *pb.ListDomainsRequest) (*pb.ListDomainsResponse, error) {
+	log.Debugf("received list domains request")
+
+	buck, err := s.getDomainBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.Domains.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ListDomainsResponse{Domains: make([]*pb.Domain, len(list))}
+	for i := range list {
+		res.Domains[i] = s.toPbDomain(&list[i])
+	}
+	return res, nil
+}
+
+func (s *Service) RemoveDomain(ctx context.Context, req *pb.RemoveDomainRequest) (*pb.RemoveDomainResponse, error) {
+	log.Debugf("received remove domain request")
+
+	buck, err := s.getWritableDomainBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Domains.Remove(ctx, req.Domain, buck.Key); err != nil {
+		return nil, domainError(err)
+	}
+	return &pb.RemoveDomainResponse{}, nil
+}
+
+// getDomainBucket returns the bucket if custom domains are enabled and the caller has access to it.
+func (s *Service) getDomainBucket(ctx context.Context, key string) (*tdb.Bucket, error) {
+	if s.Domains == nil {
+		return nil, errDomainsDisabled
+	}
+	return s.getBucket(ctx, key)
+}
+
+// getWritableDomainBucket returns the bucket if custom domains are enabled and the caller can write to it.
+func (s *Service) getWritableDomainBucket(ctx context.Context, key string) (*tdb.Bucket, error) {
+	buck, err := s.getDomainBucket(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireBucketWrite(ctx, buck); err != nil {
+		return nil, err
+	}
+	return buck, nil
+}
+
+// domainTarget returns the host that a custom domain's CNAME record should point to.
+func (s *Service) domainTarget(key string) string {
+	if s.GatewayBucketsHost != "" {
+		return fmt.Sprintf("%s.%s", key, s.GatewayBucketsHost)
+	}
+	if u, err := url.Parse(s.GatewayURL); err == nil {
+		return u.Hostname()
+	}
+	return ""
+}
+
+func (s *Service) toPbDomain(d *mdb.Domain) *pb.Domain {
+	pbd := &pb.Domain{
+		Name:           d.Name,
+		Key:            d.Key,
+		Verified:       d.Verified,
+		ChallengeName:  domains.ChallengeName(d.Name),
+		ChallengeValue: d.Challenge,
+		Target:         s.domainTarget(d.Key),
+		CreatedAt:      d.CreatedAt.UnixNano(),
+	}
+	if !d.VerifiedAt.IsZero() {
+		pbd.VerifiedAt = d.VerifiedAt.UnixNano()
+	}
+	return pbd
+}
+
+func domainError(err error) error {
+	switch {
+	case errors.Is(err, domains.ErrInvalidDomain):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domains.ErrDomainTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domains.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domains.ErrChallengeFailed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
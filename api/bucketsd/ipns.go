@@ -0,0 +1,154 @@
+package bucketsd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"github.com/textileio/textile/v2/ipns"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) GetIPNSSettings(ctx context.Context, req *pb.GetIPNSSettingsRequest) (*pb.GetIPNSSettingsResponse, error) {
+	log.Debugf("received get ipns settings request")
+
+	buck, err := s.getBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.IPNSManager.Settings(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetIPNSSettingsResponse{
+		Settings: toPbIPNSSettings(settings),
+	}, nil
+}
+
+func (s *Service) SetIPNSSettings(ctx context.Context, req *pb.SetIPNSSettingsRequest) (*pb.SetIPNSSettingsResponse, error) {
+	log.Debugf("received set ipns settings request")
+
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+	buck, err := s.getBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireBucketWrite(ctx, buck); err != nil {
+		return nil, err
+	}
+	settings := fromPbIPNSSettings(req.Settings)
+	if err := s.IPNSManager.SetSettings(ctx, buck.Key, settings); err != nil {
+		if errors.Is(err, ipns.ErrInvalidSettings) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.SetIPNSSettingsResponse{
+		Settings: toPbIPNSSettings(settings),
+	}, nil
+}
+
+func (s *Service) ExportIPNSKey(ctx context.Context, req *pb.ExportIPNSKeyRequest) (*pb.ExportIPNSKeyResponse, error) {
+	log.Debugf("received export ipns key request")
+
+	if (req.Password == "") == (req.Identity == "") {
+		return nil, status.Error(codes.InvalidArgument, "either password or identity is required")
+	}
+	buck, err := s.getBucket(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+	owner, err := dbToken.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil || buck.Owner == "" || owner.String() != buck.Owner {
+		return nil, status.Error(codes.PermissionDenied, "only the bucket owner can export its ipns key")
+	}
+	sk, err := s.IPNSManager.ExportKey(ctx, buck.Key)
+	if err != nil {
+		return nil, ipnsKeyError(err)
+	}
+	var data []byte
+	if req.Password != "" {
+		data, err = ipns.EncryptKeyWithPassword(sk, req.Password)
+	} else {
+		pk := &thread.Libp2pPubKey{}
+		if err := pk.UnmarshalString(req.Identity); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid identity: %v", err)
+		}
+		data, err = ipns.EncryptKeyForIdentity(sk, pk)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportIPNSKeyResponse{
+		Data: data,
+	}, nil
+}
+
+// requireBucketWrite returns an error if the context token doesn't belong to the bucket owner
+// or an identity with write access to the bucket root.
+func requireBucketWrite(ctx context.Context, buck *tdb.Bucket) error {
+	if buck.Owner == "" {
+		return nil
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+	writer, err := dbToken.PubKey()
+	if err != nil {
+		return err
+	}
+	if writer == nil {
+		return status.Error(codes.PermissionDenied, "write access to the bucket is required")
+	}
+	if writer.String() == buck.Owner {
+		return nil
+	}
+	if md, _, ok := buck.GetMetadataForPath("", false); ok {
+		if md.Roles[writer.String()] >= buckets.Writer || md.Roles["*"] >= buckets.Writer {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "write access to the bucket is required")
+}
+
+// ipnsKeyError maps ipns key errors to grpc status errors.
+func ipnsKeyError(err error) error {
+	switch {
+	case errors.Is(err, ipns.ErrKeyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ipns.ErrKeyNotExportable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ipns.ErrImportNotSupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		return err
+	}
+}
+
+func toPbIPNSSettings(settings mdb.IPNSSettings) *pb.IPNSSettings {
+	return &pb.IPNSSettings{
+		Disabled:                settings.Disabled,
+		LifetimeSeconds:         int64(settings.Lifetime / time.Second),
+		TtlSeconds:              int64(settings.TTL / time.Second),
+		CoalesceIntervalSeconds: int64(settings.CoalesceInterval / time.Second),
+	}
+}
+
+func fromPbIPNSSettings(settings *pb.IPNSSettings) mdb.IPNSSettings {
+	return mdb.IPNSSettings{
+		Disabled:         settings.Disabled,
+		Lifetime:         time.Duration(settings.LifetimeSeconds) * time.Second,
+		TTL:              time.Duration(settings.TtlSeconds) * time.Second,
+		CoalesceInterval: time.Duration(settings.CoalesceIntervalSeconds) * time.Second,
+	}
+}
@@ -147,6 +147,7 @@ type Metadata struct {
 	Roles     map[string]PathAccessRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=api.bucketsd.pb.PathAccessRole"`
 	UpdatedAt int64                     `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Key       string                    `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Info      *FileInfo                 `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -202,6 +203,76 @@ func (x *Metadata) GetKey() string {
 	return ""
 }
 
+func (x *Metadata) GetInfo() *FileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode    uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	ModTime int64  `protobuf:"varint,2,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	Symlink string `protobuf:"bytes,3,opt,name=symlink,proto3" json:"symlink,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FileInfo) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileInfo) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *FileInfo) GetSymlink() string {
+	if x != nil {
+		return x.Symlink
+	}
+	return ""
+}
+
 type Root struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -224,7 +295,7 @@ type Root struct {
 func (x *Root) Reset() {
 	*x = Root{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[1]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +308,7 @@ func (x *Root) String() string {
 func (*Root) ProtoMessage() {}
 
 func (x *Root) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[1]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +321,7 @@ func (x *Root) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Root.ProtoReflect.Descriptor instead.
 func (*Root) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{1}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Root) GetKey() string {
@@ -346,7 +417,7 @@ type ListRequest struct {
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[2]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +430,7 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[2]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +443,7 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{2}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{3}
 }
 
 type ListResponse struct {
@@ -386,7 +457,7 @@ type ListResponse struct {
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[3]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +470,7 @@ func (x *ListResponse) String() string {
 func (*ListResponse) ProtoMessage() {}
 
 func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[3]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +483,7 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{3}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListResponse) GetRoots() []*Root {
@@ -431,12 +502,13 @@ type CreateRequest struct {
 	BootstrapCid string `protobuf:"bytes,2,opt,name=bootstrap_cid,json=bootstrapCid,proto3" json:"bootstrap_cid,omitempty"`
 	Private      bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
 	Unfreeze     bool   `protobuf:"varint,4,opt,name=unfreeze,proto3" json:"unfreeze,omitempty"`
+	IpnsKey      []byte `protobuf:"bytes,5,opt,name=ipns_key,json=ipnsKey,proto3" json:"ipns_key,omitempty"`
 }
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[4]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -449,7 +521,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[4]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +534,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{4}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateRequest) GetName() string {
@@ -493,6 +565,13 @@ func (x *CreateRequest) GetUnfreeze() bool {
 	return false
 }
 
+func (x *CreateRequest) GetIpnsKey() []byte {
+	if x != nil {
+		return x.IpnsKey
+	}
+	return nil
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -509,7 +588,7 @@ type CreateResponse struct {
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[5]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -522,7 +601,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[5]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +614,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{5}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateResponse) GetRoot() *Root {
@@ -591,7 +670,7 @@ type RootRequest struct {
 func (x *RootRequest) Reset() {
 	*x = RootRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[6]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +683,7 @@ func (x *RootRequest) String() string {
 func (*RootRequest) ProtoMessage() {}
 
 func (x *RootRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[6]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +696,7 @@ func (x *RootRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootRequest.ProtoReflect.Descriptor instead.
 func (*RootRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{6}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RootRequest) GetKey() string {
@@ -638,7 +717,7 @@ type RootResponse struct {
 func (x *RootResponse) Reset() {
 	*x = RootResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[7]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -651,7 +730,7 @@ func (x *RootResponse) String() string {
 func (*RootResponse) ProtoMessage() {}
 
 func (x *RootResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[7]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +743,7 @@ func (x *RootResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RootResponse.ProtoReflect.Descriptor instead.
 func (*RootResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{7}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RootResponse) GetRoot() *Root {
@@ -686,7 +765,7 @@ type LinksRequest struct {
 func (x *LinksRequest) Reset() {
 	*x = LinksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[8]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +778,7 @@ func (x *LinksRequest) String() string {
 func (*LinksRequest) ProtoMessage() {}
 
 func (x *LinksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[8]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +791,7 @@ func (x *LinksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinksRequest.ProtoReflect.Descriptor instead.
 func (*LinksRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{8}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LinksRequest) GetKey() string {
@@ -742,7 +821,7 @@ type LinksResponse struct {
 func (x *LinksResponse) Reset() {
 	*x = LinksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[9]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +834,7 @@ func (x *LinksResponse) String() string {
 func (*LinksResponse) ProtoMessage() {}
 
 func (x *LinksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[9]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +847,7 @@ func (x *LinksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinksResponse.ProtoReflect.Descriptor instead.
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{9}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LinksResponse) GetUrl() string {
@@ -804,7 +883,7 @@ type ListPathRequest struct {
 func (x *ListPathRequest) Reset() {
 	*x = ListPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[10]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +896,7 @@ func (x *ListPathRequest) String() string {
 func (*ListPathRequest) ProtoMessage() {}
 
 func (x *ListPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[10]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +909,7 @@ func (x *ListPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPathRequest.ProtoReflect.Descriptor instead.
 func (*ListPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{10}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListPathRequest) GetKey() string {
@@ -859,7 +938,7 @@ type ListPathResponse struct {
 func (x *ListPathResponse) Reset() {
 	*x = ListPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[11]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -872,7 +951,7 @@ func (x *ListPathResponse) String() string {
 func (*ListPathResponse) ProtoMessage() {}
 
 func (x *ListPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[11]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +964,7 @@ func (x *ListPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPathResponse.ProtoReflect.Descriptor instead.
 func (*ListPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{11}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListPathResponse) GetItem() *PathItem {
@@ -920,7 +999,7 @@ type PathItem struct {
 func (x *PathItem) Reset() {
 	*x = PathItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[12]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -933,7 +1012,7 @@ func (x *PathItem) String() string {
 func (*PathItem) ProtoMessage() {}
 
 func (x *PathItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[12]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1025,7 @@ func (x *PathItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathItem.ProtoReflect.Descriptor instead.
 func (*PathItem) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{12}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PathItem) GetCid() string {
@@ -1016,7 +1095,7 @@ type ListIpfsPathRequest struct {
 func (x *ListIpfsPathRequest) Reset() {
 	*x = ListIpfsPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[13]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1029,7 +1108,7 @@ func (x *ListIpfsPathRequest) String() string {
 func (*ListIpfsPathRequest) ProtoMessage() {}
 
 func (x *ListIpfsPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[13]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1121,7 @@ func (x *ListIpfsPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIpfsPathRequest.ProtoReflect.Descriptor instead.
 func (*ListIpfsPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{13}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListIpfsPathRequest) GetPath() string {
@@ -1063,7 +1142,7 @@ type ListIpfsPathResponse struct {
 func (x *ListIpfsPathResponse) Reset() {
 	*x = ListIpfsPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[14]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +1155,7 @@ func (x *ListIpfsPathResponse) String() string {
 func (*ListIpfsPathResponse) ProtoMessage() {}
 
 func (x *ListIpfsPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[14]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1168,7 @@ func (x *ListIpfsPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIpfsPathResponse.ProtoReflect.Descriptor instead.
 func (*ListIpfsPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{14}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListIpfsPathResponse) GetItem() *PathItem {
@@ -1113,7 +1192,7 @@ type PushPathRequest struct {
 func (x *PushPathRequest) Reset() {
 	*x = PushPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[15]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1126,7 +1205,7 @@ func (x *PushPathRequest) String() string {
 func (*PushPathRequest) ProtoMessage() {}
 
 func (x *PushPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[15]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1218,7 @@ func (x *PushPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathRequest.ProtoReflect.Descriptor instead.
 func (*PushPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{15}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *PushPathRequest) GetPayload() isPushPathRequest_Payload {
@@ -1190,7 +1269,7 @@ type PushPathResponse struct {
 func (x *PushPathResponse) Reset() {
 	*x = PushPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[16]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1203,7 +1282,7 @@ func (x *PushPathResponse) String() string {
 func (*PushPathResponse) ProtoMessage() {}
 
 func (x *PushPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[16]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1295,7 @@ func (x *PushPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathResponse.ProtoReflect.Descriptor instead.
 func (*PushPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{16}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PushPathResponse) GetEvent() *PushPathResponse_Event {
@@ -1240,7 +1319,7 @@ type PushPathsRequest struct {
 func (x *PushPathsRequest) Reset() {
 	*x = PushPathsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[17]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1253,7 +1332,7 @@ func (x *PushPathsRequest) String() string {
 func (*PushPathsRequest) ProtoMessage() {}
 
 func (x *PushPathsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[17]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,7 +1345,7 @@ func (x *PushPathsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathsRequest.ProtoReflect.Descriptor instead.
 func (*PushPathsRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{17}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{18}
 }
 
 func (m *PushPathsRequest) GetPayload() isPushPathsRequest_Payload {
@@ -1321,7 +1400,7 @@ type PushPathsResponse struct {
 func (x *PushPathsResponse) Reset() {
 	*x = PushPathsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[18]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +1413,7 @@ func (x *PushPathsResponse) String() string {
 func (*PushPathsResponse) ProtoMessage() {}
 
 func (x *PushPathsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[18]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1426,7 @@ func (x *PushPathsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathsResponse.ProtoReflect.Descriptor instead.
 func (*PushPathsResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{18}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PushPathsResponse) GetPath() string {
@@ -1397,7 +1476,7 @@ type PullPathRequest struct {
 func (x *PullPathRequest) Reset() {
 	*x = PullPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[19]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1410,7 +1489,7 @@ func (x *PullPathRequest) String() string {
 func (*PullPathRequest) ProtoMessage() {}
 
 func (x *PullPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[19]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,7 +1502,7 @@ func (x *PullPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathRequest.ProtoReflect.Descriptor instead.
 func (*PullPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{19}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PullPathRequest) GetKey() string {
@@ -1451,7 +1530,7 @@ type PullPathResponse struct {
 func (x *PullPathResponse) Reset() {
 	*x = PullPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[20]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1543,7 @@ func (x *PullPathResponse) String() string {
 func (*PullPathResponse) ProtoMessage() {}
 
 func (x *PullPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[20]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1556,7 @@ func (x *PullPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathResponse.ProtoReflect.Descriptor instead.
 func (*PullPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{20}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *PullPathResponse) GetChunk() []byte {
@@ -1498,7 +1577,7 @@ type PullIpfsPathRequest struct {
 func (x *PullIpfsPathRequest) Reset() {
 	*x = PullIpfsPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[21]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1511,7 +1590,7 @@ func (x *PullIpfsPathRequest) String() string {
 func (*PullIpfsPathRequest) ProtoMessage() {}
 
 func (x *PullIpfsPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[21]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1603,7 @@ func (x *PullIpfsPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullIpfsPathRequest.ProtoReflect.Descriptor instead.
 func (*PullIpfsPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{21}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PullIpfsPathRequest) GetPath() string {
@@ -1545,7 +1624,7 @@ type PullIpfsPathResponse struct {
 func (x *PullIpfsPathResponse) Reset() {
 	*x = PullIpfsPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[22]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1558,7 +1637,7 @@ func (x *PullIpfsPathResponse) String() string {
 func (*PullIpfsPathResponse) ProtoMessage() {}
 
 func (x *PullIpfsPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[22]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,7 +1650,7 @@ func (x *PullIpfsPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullIpfsPathResponse.ProtoReflect.Descriptor instead.
 func (*PullIpfsPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{22}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PullIpfsPathResponse) GetChunk() []byte {
@@ -1594,7 +1673,7 @@ type SetPathRequest struct {
 func (x *SetPathRequest) Reset() {
 	*x = SetPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[23]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +1686,7 @@ func (x *SetPathRequest) String() string {
 func (*SetPathRequest) ProtoMessage() {}
 
 func (x *SetPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[23]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1699,7 @@ func (x *SetPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPathRequest.ProtoReflect.Descriptor instead.
 func (*SetPathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{23}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SetPathRequest) GetKey() string {
@@ -1655,7 +1734,7 @@ type SetPathResponse struct {
 func (x *SetPathResponse) Reset() {
 	*x = SetPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[24]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1668,7 +1747,7 @@ func (x *SetPathResponse) String() string {
 func (*SetPathResponse) ProtoMessage() {}
 
 func (x *SetPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[24]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1681,7 +1760,7 @@ func (x *SetPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPathResponse.ProtoReflect.Descriptor instead.
 func (*SetPathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{24}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SetPathResponse) GetPinned() int64 {
@@ -1702,7 +1781,7 @@ type RemoveRequest struct {
 func (x *RemoveRequest) Reset() {
 	*x = RemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[25]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1715,7 +1794,7 @@ func (x *RemoveRequest) String() string {
 func (*RemoveRequest) ProtoMessage() {}
 
 func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[25]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,7 +1807,7 @@ func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
 func (*RemoveRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{25}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RemoveRequest) GetKey() string {
@@ -1749,7 +1828,7 @@ type RemoveResponse struct {
 func (x *RemoveResponse) Reset() {
 	*x = RemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[26]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1762,7 +1841,7 @@ func (x *RemoveResponse) String() string {
 func (*RemoveResponse) ProtoMessage() {}
 
 func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[26]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,7 +1854,7 @@ func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
 func (*RemoveResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{26}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RemoveResponse) GetPinned() int64 {
@@ -1798,7 +1877,7 @@ type RemovePathRequest struct {
 func (x *RemovePathRequest) Reset() {
 	*x = RemovePathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[27]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1811,7 +1890,7 @@ func (x *RemovePathRequest) String() string {
 func (*RemovePathRequest) ProtoMessage() {}
 
 func (x *RemovePathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[27]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +1903,7 @@ func (x *RemovePathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePathRequest.ProtoReflect.Descriptor instead.
 func (*RemovePathRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{27}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RemovePathRequest) GetKey() string {
@@ -1860,7 +1939,7 @@ type RemovePathResponse struct {
 func (x *RemovePathResponse) Reset() {
 	*x = RemovePathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[28]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1873,7 +1952,7 @@ func (x *RemovePathResponse) String() string {
 func (*RemovePathResponse) ProtoMessage() {}
 
 func (x *RemovePathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[28]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1886,7 +1965,7 @@ func (x *RemovePathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePathResponse.ProtoReflect.Descriptor instead.
 func (*RemovePathResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{28}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RemovePathResponse) GetRoot() *Root {
@@ -1916,7 +1995,7 @@ type PushPathAccessRolesRequest struct {
 func (x *PushPathAccessRolesRequest) Reset() {
 	*x = PushPathAccessRolesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2008,7 @@ func (x *PushPathAccessRolesRequest) String() string {
 func (*PushPathAccessRolesRequest) ProtoMessage() {}
 
 func (x *PushPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[29]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2021,7 @@ func (x *PushPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathAccessRolesRequest.ProtoReflect.Descriptor instead.
 func (*PushPathAccessRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{29}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PushPathAccessRolesRequest) GetKey() string {
@@ -1977,7 +2056,7 @@ type PushPathAccessRolesResponse struct {
 func (x *PushPathAccessRolesResponse) Reset() {
 	*x = PushPathAccessRolesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1990,7 +2069,7 @@ func (x *PushPathAccessRolesResponse) String() string {
 func (*PushPathAccessRolesResponse) ProtoMessage() {}
 
 func (x *PushPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[30]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2082,7 @@ func (x *PushPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPathAccessRolesResponse.ProtoReflect.Descriptor instead.
 func (*PushPathAccessRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{30}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PushPathAccessRolesResponse) GetPinned() int64 {
@@ -2025,7 +2104,7 @@ type PullPathAccessRolesRequest struct {
 func (x *PullPathAccessRolesRequest) Reset() {
 	*x = PullPathAccessRolesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2038,7 +2117,7 @@ func (x *PullPathAccessRolesRequest) String() string {
 func (*PullPathAccessRolesRequest) ProtoMessage() {}
 
 func (x *PullPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[31]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2130,7 @@ func (x *PullPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathAccessRolesRequest.ProtoReflect.Descriptor instead.
 func (*PullPathAccessRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{31}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PullPathAccessRolesRequest) GetKey() string {
@@ -2079,7 +2158,7 @@ type PullPathAccessRolesResponse struct {
 func (x *PullPathAccessRolesResponse) Reset() {
 	*x = PullPathAccessRolesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2092,7 +2171,7 @@ func (x *PullPathAccessRolesResponse) String() string {
 func (*PullPathAccessRolesResponse) ProtoMessage() {}
 
 func (x *PullPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[32]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,7 +2184,7 @@ func (x *PullPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullPathAccessRolesResponse.ProtoReflect.Descriptor instead.
 func (*PullPathAccessRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{32}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PullPathAccessRolesResponse) GetRoles() map[string]PathAccessRole {
@@ -2129,7 +2208,7 @@ type AcceptPathAccessRolesRequest struct {
 func (x *AcceptPathAccessRolesRequest) Reset() {
 	*x = AcceptPathAccessRolesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2142,7 +2221,7 @@ func (x *AcceptPathAccessRolesRequest) String() string {
 func (*AcceptPathAccessRolesRequest) ProtoMessage() {}
 
 func (x *AcceptPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[33]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,7 +2234,7 @@ func (x *AcceptPathAccessRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptPathAccessRolesRequest.ProtoReflect.Descriptor instead.
 func (*AcceptPathAccessRolesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{33}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AcceptPathAccessRolesRequest) GetKey() string {
@@ -2197,7 +2276,7 @@ type AcceptPathAccessRolesResponse struct {
 func (x *AcceptPathAccessRolesResponse) Reset() {
 	*x = AcceptPathAccessRolesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2210,7 +2289,7 @@ func (x *AcceptPathAccessRolesResponse) String() string {
 func (*AcceptPathAccessRolesResponse) ProtoMessage() {}
 
 func (x *AcceptPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[34]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +2302,7 @@ func (x *AcceptPathAccessRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptPathAccessRolesResponse.ProtoReflect.Descriptor instead.
 func (*AcceptPathAccessRolesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{34}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AcceptPathAccessRolesResponse) GetAcceptedRole() PathAccessRole {
@@ -2252,7 +2331,7 @@ type ArchiveConfig struct {
 func (x *ArchiveConfig) Reset() {
 	*x = ArchiveConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2265,7 +2344,7 @@ func (x *ArchiveConfig) String() string {
 func (*ArchiveConfig) ProtoMessage() {}
 
 func (x *ArchiveConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[35]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2357,7 @@ func (x *ArchiveConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveConfig.ProtoReflect.Descriptor instead.
 func (*ArchiveConfig) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{35}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ArchiveConfig) GetRepFactor() int32 {
@@ -2356,7 +2435,7 @@ type Archives struct {
 func (x *Archives) Reset() {
 	*x = Archives{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2369,7 +2448,7 @@ func (x *Archives) String() string {
 func (*Archives) ProtoMessage() {}
 
 func (x *Archives) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[36]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,7 +2461,7 @@ func (x *Archives) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Archives.ProtoReflect.Descriptor instead.
 func (*Archives) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{36}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Archives) GetCurrent() *Archive {
@@ -2417,7 +2496,7 @@ type Archive struct {
 func (x *Archive) Reset() {
 	*x = Archive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2430,7 +2509,7 @@ func (x *Archive) String() string {
 func (*Archive) ProtoMessage() {}
 
 func (x *Archive) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[37]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,7 +2522,7 @@ func (x *Archive) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Archive.ProtoReflect.Descriptor instead.
 func (*Archive) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{37}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Archive) GetCid() string {
@@ -2524,7 +2603,7 @@ type DealInfo struct {
 func (x *DealInfo) Reset() {
 	*x = DealInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2537,7 +2616,7 @@ func (x *DealInfo) String() string {
 func (*DealInfo) ProtoMessage() {}
 
 func (x *DealInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[38]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2550,7 +2629,7 @@ func (x *DealInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealInfo.ProtoReflect.Descriptor instead.
 func (*DealInfo) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{38}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DealInfo) GetProposalCid() string {
@@ -2649,7 +2728,7 @@ type ArchiveRenew struct {
 func (x *ArchiveRenew) Reset() {
 	*x = ArchiveRenew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2662,7 +2741,7 @@ func (x *ArchiveRenew) String() string {
 func (*ArchiveRenew) ProtoMessage() {}
 
 func (x *ArchiveRenew) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[39]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,7 +2754,7 @@ func (x *ArchiveRenew) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRenew.ProtoReflect.Descriptor instead.
 func (*ArchiveRenew) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{39}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ArchiveRenew) GetEnabled() bool {
@@ -2703,7 +2782,7 @@ type DefaultArchiveConfigRequest struct {
 func (x *DefaultArchiveConfigRequest) Reset() {
 	*x = DefaultArchiveConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2716,7 +2795,7 @@ func (x *DefaultArchiveConfigRequest) String() string {
 func (*DefaultArchiveConfigRequest) ProtoMessage() {}
 
 func (x *DefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[40]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,7 +2808,7 @@ func (x *DefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultArchiveConfigRequest.ProtoReflect.Descriptor instead.
 func (*DefaultArchiveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{40}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DefaultArchiveConfigRequest) GetKey() string {
@@ -2750,7 +2829,7 @@ type DefaultArchiveConfigResponse struct {
 func (x *DefaultArchiveConfigResponse) Reset() {
 	*x = DefaultArchiveConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2763,7 +2842,7 @@ func (x *DefaultArchiveConfigResponse) String() string {
 func (*DefaultArchiveConfigResponse) ProtoMessage() {}
 
 func (x *DefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[41]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +2855,7 @@ func (x *DefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultArchiveConfigResponse.ProtoReflect.Descriptor instead.
 func (*DefaultArchiveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{41}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DefaultArchiveConfigResponse) GetArchiveConfig() *ArchiveConfig {
@@ -2798,7 +2877,7 @@ type SetDefaultArchiveConfigRequest struct {
 func (x *SetDefaultArchiveConfigRequest) Reset() {
 	*x = SetDefaultArchiveConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2811,7 +2890,7 @@ func (x *SetDefaultArchiveConfigRequest) String() string {
 func (*SetDefaultArchiveConfigRequest) ProtoMessage() {}
 
 func (x *SetDefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[42]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2824,7 +2903,7 @@ func (x *SetDefaultArchiveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultArchiveConfigRequest.ProtoReflect.Descriptor instead.
 func (*SetDefaultArchiveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{42}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SetDefaultArchiveConfigRequest) GetKey() string {
@@ -2850,7 +2929,7 @@ type SetDefaultArchiveConfigResponse struct {
 func (x *SetDefaultArchiveConfigResponse) Reset() {
 	*x = SetDefaultArchiveConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2863,7 +2942,7 @@ func (x *SetDefaultArchiveConfigResponse) String() string {
 func (*SetDefaultArchiveConfigResponse) ProtoMessage() {}
 
 func (x *SetDefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[43]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2876,7 +2955,7 @@ func (x *SetDefaultArchiveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultArchiveConfigResponse.ProtoReflect.Descriptor instead.
 func (*SetDefaultArchiveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{43}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{44}
 }
 
 type ArchiveRequest struct {
@@ -2891,7 +2970,7 @@ type ArchiveRequest struct {
 func (x *ArchiveRequest) Reset() {
 	*x = ArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2904,7 +2983,7 @@ func (x *ArchiveRequest) String() string {
 func (*ArchiveRequest) ProtoMessage() {}
 
 func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[44]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,7 +2996,7 @@ func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{44}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ArchiveRequest) GetKey() string {
@@ -2943,7 +3022,7 @@ type ArchiveResponse struct {
 func (x *ArchiveResponse) Reset() {
 	*x = ArchiveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2956,7 +3035,7 @@ func (x *ArchiveResponse) String() string {
 func (*ArchiveResponse) ProtoMessage() {}
 
 func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[45]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2969,7 +3048,7 @@ func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{45}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{46}
 }
 
 type ArchivesRequest struct {
@@ -2983,7 +3062,7 @@ type ArchivesRequest struct {
 func (x *ArchivesRequest) Reset() {
 	*x = ArchivesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2996,7 +3075,7 @@ func (x *ArchivesRequest) String() string {
 func (*ArchivesRequest) ProtoMessage() {}
 
 func (x *ArchivesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[46]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,7 +3088,7 @@ func (x *ArchivesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesRequest.ProtoReflect.Descriptor instead.
 func (*ArchivesRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{46}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ArchivesRequest) GetKey() string {
@@ -3032,7 +3111,7 @@ type ArchivesResponse struct {
 func (x *ArchivesResponse) Reset() {
 	*x = ArchivesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3045,7 +3124,7 @@ func (x *ArchivesResponse) String() string {
 func (*ArchivesResponse) ProtoMessage() {}
 
 func (x *ArchivesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[47]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3137,7 @@ func (x *ArchivesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchivesResponse.ProtoReflect.Descriptor instead.
 func (*ArchivesResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{47}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ArchivesResponse) GetCurrent() *Archive {
@@ -3086,7 +3165,7 @@ type ArchiveWatchRequest struct {
 func (x *ArchiveWatchRequest) Reset() {
 	*x = ArchiveWatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3099,7 +3178,7 @@ func (x *ArchiveWatchRequest) String() string {
 func (*ArchiveWatchRequest) ProtoMessage() {}
 
 func (x *ArchiveWatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[48]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3112,7 +3191,7 @@ func (x *ArchiveWatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveWatchRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveWatchRequest) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{48}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ArchiveWatchRequest) GetKey() string {
@@ -3133,7 +3212,7 @@ type ArchiveWatchResponse struct {
 func (x *ArchiveWatchResponse) Reset() {
 	*x = ArchiveWatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3146,7 +3225,7 @@ func (x *ArchiveWatchResponse) String() string {
 func (*ArchiveWatchResponse) ProtoMessage() {}
 
 func (x *ArchiveWatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[49]
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3159,7 +3238,7 @@ func (x *ArchiveWatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveWatchResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveWatchResponse) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{49}
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ArchiveWatchResponse) GetMsg() string {
@@ -3169,33 +3248,38 @@ func (x *ArchiveWatchResponse) GetMsg() string {
 	return ""
 }
 
-type PushPathRequest_Header struct {
+type Domain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Root string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key            string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Verified       bool   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	ChallengeName  string `protobuf:"bytes,4,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	ChallengeValue string `protobuf:"bytes,5,opt,name=challenge_value,json=challengeValue,proto3" json:"challenge_value,omitempty"`
+	Target         string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VerifiedAt     int64  `protobuf:"varint,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
 }
 
-func (x *PushPathRequest_Header) Reset() {
-	*x = PushPathRequest_Header{}
+func (x *Domain) Reset() {
+	*x = Domain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushPathRequest_Header) String() string {
+func (x *Domain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPathRequest_Header) ProtoMessage() {}
+func (*Domain) ProtoMessage() {}
 
-func (x *PushPathRequest_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,62 +3290,93 @@ func (x *PushPathRequest_Header) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPathRequest_Header.ProtoReflect.Descriptor instead.
-func (*PushPathRequest_Header) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PushPathRequest_Header) GetKey() string {
+func (x *Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Domain) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-func (x *PushPathRequest_Header) GetPath() string {
+func (x *Domain) GetVerified() bool {
 	if x != nil {
-		return x.Path
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Domain) GetChallengeName() string {
+	if x != nil {
+		return x.ChallengeName
 	}
 	return ""
 }
 
-func (x *PushPathRequest_Header) GetRoot() string {
+func (x *Domain) GetChallengeValue() string {
 	if x != nil {
-		return x.Root
+		return x.ChallengeValue
 	}
 	return ""
 }
 
-type PushPathResponse_Event struct {
+func (x *Domain) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Domain) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Domain) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+type AddDomainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Bytes  int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Size   string `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
-	Root   *Root  `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
-	Pinned int64  `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *PushPathResponse_Event) Reset() {
-	*x = PushPathResponse_Event{}
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushPathResponse_Event) String() string {
+func (x *AddDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPathResponse_Event) ProtoMessage() {}
+func (*AddDomainRequest) ProtoMessage() {}
 
-func (x *PushPathResponse_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3272,64 +3387,83 @@ func (x *PushPathResponse_Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPathResponse_Event.ProtoReflect.Descriptor instead.
-func (*PushPathResponse_Event) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *PushPathResponse_Event) GetName() string {
+func (x *AddDomainRequest) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-func (x *PushPathResponse_Event) GetPath() string {
+func (x *AddDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.Path
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *PushPathResponse_Event) GetBytes() int64 {
-	if x != nil {
-		return x.Bytes
-	}
-	return 0
+type AddDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *PushPathResponse_Event) GetSize() string {
-	if x != nil {
-		return x.Size
+func (x *AddDomainResponse) Reset() {
+	*x = AddDomainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PushPathResponse_Event) GetRoot() *Root {
-	if x != nil {
-		return x.Root
+func (x *AddDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainResponse) ProtoMessage() {}
+
+func (x *AddDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PushPathResponse_Event) GetPinned() int64 {
+// Deprecated: Use AddDomainResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddDomainResponse) GetDomain() *Domain {
 	if x != nil {
-		return x.Pinned
+		return x.Domain
 	}
-	return 0
+	return nil
 }
 
-type PushPathsRequest_Header struct {
+type VerifyDomainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *PushPathsRequest_Header) Reset() {
-	*x = PushPathsRequest_Header{}
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,13 +3471,13 @@ func (x *PushPathsRequest_Header) Reset() {
 	}
 }
 
-func (x *PushPathsRequest_Header) String() string {
+func (x *VerifyDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPathsRequest_Header) ProtoMessage() {}
+func (*VerifyDomainRequest) ProtoMessage() {}
 
-func (x *PushPathsRequest_Header) ProtoReflect() protoreflect.Message {
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3355,36 +3489,35 @@ func (x *PushPathsRequest_Header) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPathsRequest_Header.ProtoReflect.Descriptor instead.
-func (*PushPathsRequest_Header) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *PushPathsRequest_Header) GetKey() string {
+func (x *VerifyDomainRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-func (x *PushPathsRequest_Header) GetRoot() string {
+func (x *VerifyDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.Root
+		return x.Domain
 	}
 	return ""
 }
 
-type PushPathsRequest_Chunk struct {
+type VerifyDomainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Domain *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
-func (x *PushPathsRequest_Chunk) Reset() {
-	*x = PushPathsRequest_Chunk{}
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3392,13 +3525,13 @@ func (x *PushPathsRequest_Chunk) Reset() {
 	}
 }
 
-func (x *PushPathsRequest_Chunk) String() string {
+func (x *VerifyDomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushPathsRequest_Chunk) ProtoMessage() {}
+func (*VerifyDomainResponse) ProtoMessage() {}
 
-func (x *PushPathsRequest_Chunk) ProtoReflect() protoreflect.Message {
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3410,90 +3543,933 @@ func (x *PushPathsRequest_Chunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushPathsRequest_Chunk.ProtoReflect.Descriptor instead.
-func (*PushPathsRequest_Chunk) Descriptor() ([]byte, []int) {
-	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{17, 1}
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *PushPathsRequest_Chunk) GetPath() string {
+func (x *VerifyDomainResponse) GetDomain() *Domain {
 	if x != nil {
-		return x.Path
+		return x.Domain
 	}
-	return ""
+	return nil
 }
 
-func (x *PushPathsRequest_Chunk) GetData() []byte {
-	if x != nil {
-		return x.Data
+type ListDomainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-var File_api_bucketsd_pb_bucketsd_proto protoreflect.FileDescriptor
+func (x *ListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_api_bucketsd_pb_bucketsd_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70,
-	0x62, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0f, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x22, 0xd2, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a,
-	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x59, 0x0a, 0x0a, 0x52,
-	0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74,
-	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x03, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x35, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x4b, 0x65,
-	0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x2e,
-	0x50, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x35, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x08, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x7e,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x5f, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0xdb,
-	0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+func (*ListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDomainsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains []*Domain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListDomainsResponse) GetDomains() []*Domain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type RemoveDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *RemoveDomainRequest) Reset() {
+	*x = RemoveDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainRequest) ProtoMessage() {}
+
+func (x *RemoveDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDomainRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemoveDomainRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RemoveDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type RemoveDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveDomainResponse) Reset() {
+	*x = RemoveDomainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainResponse) ProtoMessage() {}
+
+func (x *RemoveDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDomainResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{59}
+}
+
+type IPNSSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disabled                bool  `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	LifetimeSeconds         int64 `protobuf:"varint,2,opt,name=lifetime_seconds,json=lifetimeSeconds,proto3" json:"lifetime_seconds,omitempty"`
+	TtlSeconds              int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	CoalesceIntervalSeconds int64 `protobuf:"varint,4,opt,name=coalesce_interval_seconds,json=coalesceIntervalSeconds,proto3" json:"coalesce_interval_seconds,omitempty"`
+}
+
+func (x *IPNSSettings) Reset() {
+	*x = IPNSSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPNSSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPNSSettings) ProtoMessage() {}
+
+func (x *IPNSSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPNSSettings.ProtoReflect.Descriptor instead.
+func (*IPNSSettings) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IPNSSettings) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *IPNSSettings) GetLifetimeSeconds() int64 {
+	if x != nil {
+		return x.LifetimeSeconds
+	}
+	return 0
+}
+
+func (x *IPNSSettings) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *IPNSSettings) GetCoalesceIntervalSeconds() int64 {
+	if x != nil {
+		return x.CoalesceIntervalSeconds
+	}
+	return 0
+}
+
+type GetIPNSSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetIPNSSettingsRequest) Reset() {
+	*x = GetIPNSSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIPNSSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIPNSSettingsRequest) ProtoMessage() {}
+
+func (x *GetIPNSSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIPNSSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetIPNSSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetIPNSSettingsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetIPNSSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *IPNSSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetIPNSSettingsResponse) Reset() {
+	*x = GetIPNSSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIPNSSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIPNSSettingsResponse) ProtoMessage() {}
+
+func (x *GetIPNSSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIPNSSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetIPNSSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetIPNSSettingsResponse) GetSettings() *IPNSSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetIPNSSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Settings *IPNSSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetIPNSSettingsRequest) Reset() {
+	*x = SetIPNSSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIPNSSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIPNSSettingsRequest) ProtoMessage() {}
+
+func (x *SetIPNSSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIPNSSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetIPNSSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetIPNSSettingsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetIPNSSettingsRequest) GetSettings() *IPNSSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetIPNSSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *IPNSSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetIPNSSettingsResponse) Reset() {
+	*x = SetIPNSSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetIPNSSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIPNSSettingsResponse) ProtoMessage() {}
+
+func (x *SetIPNSSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIPNSSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetIPNSSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetIPNSSettingsResponse) GetSettings() *IPNSSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ExportIPNSKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (x *ExportIPNSKeyRequest) Reset() {
+	*x = ExportIPNSKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportIPNSKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportIPNSKeyRequest) ProtoMessage() {}
+
+func (x *ExportIPNSKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportIPNSKeyRequest.ProtoReflect.Descriptor instead.
+func (*ExportIPNSKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExportIPNSKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExportIPNSKeyRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ExportIPNSKeyRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type ExportIPNSKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportIPNSKeyResponse) Reset() {
+	*x = ExportIPNSKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportIPNSKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportIPNSKeyResponse) ProtoMessage() {}
+
+func (x *ExportIPNSKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportIPNSKeyResponse.ProtoReflect.Descriptor instead.
+func (*ExportIPNSKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportIPNSKeyResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PushPathRequest_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Root string    `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Info *FileInfo `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *PushPathRequest_Header) Reset() {
+	*x = PushPathRequest_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPathRequest_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPathRequest_Header) ProtoMessage() {}
+
+func (x *PushPathRequest_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPathRequest_Header.ProtoReflect.Descriptor instead.
+func (*PushPathRequest_Header) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *PushPathRequest_Header) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PushPathRequest_Header) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PushPathRequest_Header) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *PushPathRequest_Header) GetInfo() *FileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type PushPathResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Bytes  int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Size   string `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Root   *Root  `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	Pinned int64  `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (x *PushPathResponse_Event) Reset() {
+	*x = PushPathResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPathResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPathResponse_Event) ProtoMessage() {}
+
+func (x *PushPathResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPathResponse_Event.ProtoReflect.Descriptor instead.
+func (*PushPathResponse_Event) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *PushPathResponse_Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PushPathResponse_Event) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PushPathResponse_Event) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *PushPathResponse_Event) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *PushPathResponse_Event) GetRoot() *Root {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *PushPathResponse_Event) GetPinned() int64 {
+	if x != nil {
+		return x.Pinned
+	}
+	return 0
+}
+
+type PushPathsRequest_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *PushPathsRequest_Header) Reset() {
+	*x = PushPathsRequest_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPathsRequest_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPathsRequest_Header) ProtoMessage() {}
+
+func (x *PushPathsRequest_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPathsRequest_Header.ProtoReflect.Descriptor instead.
+func (*PushPathsRequest_Header) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *PushPathsRequest_Header) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PushPathsRequest_Header) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type PushPathsRequest_Chunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string    `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte    `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Info *FileInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *PushPathsRequest_Chunk) Reset() {
+	*x = PushPathsRequest_Chunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPathsRequest_Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPathsRequest_Chunk) ProtoMessage() {}
+
+func (x *PushPathsRequest_Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bucketsd_pb_bucketsd_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPathsRequest_Chunk.ProtoReflect.Descriptor instead.
+func (*PushPathsRequest_Chunk) Descriptor() ([]byte, []int) {
+	return file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *PushPathsRequest_Chunk) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PushPathsRequest_Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PushPathsRequest_Chunk) GetInfo() *FileInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+var File_api_bucketsd_pb_bucketsd_proto protoreflect.FileDescriptor
+
+var file_api_bucketsd_pb_bucketsd_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70,
+	0x62, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x22, 0x81, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x59, 0x0a, 0x0a, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x53, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xf9, 0x03, 0x0a, 0x04, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69,
+	0x6e, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x6f, 0x6f, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73,
+	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x11, 0x50, 0x61,
+	0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x72, 0x6f, 0x6f,
+	0x74, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x70, 0x6e, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x70, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x22, 0xdb,
+	0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
 	0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x34, 0x0a, 0x05,
@@ -3553,409 +4529,543 @@ var file_api_bucketsd_pb_bucketsd_proto_rawDesc = []byte{
 	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04,
 	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74,
-	0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xbb, 0x01, 0x0a, 0x0f,
+	0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xea, 0x01, 0x0a, 0x0f,
 	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x41, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
 	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x42, 0x0a, 0x06, 0x48, 0x65,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x71, 0x0a, 0x06, 0x48, 0x65,
 	0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x42, 0x09,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x50, 0x75,
-	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x9c, 0x01,
-	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04,
-	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x83, 0x02, 0x0a,
-	0x10, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x42, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52,
-	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x1a, 0x2f, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52,
-	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x28,
-	0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x29, 0x0a, 0x13, 0x50, 0x75, 0x6c, 0x6c,
-	0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x53,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
-	0x6f, 0x74, 0x22, 0x57, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x2d,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73,
+	0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x9c, 0x01, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
 	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72,
-	0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x1a,
-	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x4c, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x36, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x59,
-	0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x6f, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xb2, 0x02, 0x0a, 0x10,
+	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x42, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x1a, 0x5e, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0x90, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x28, 0x0a, 0x10,
+	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x29, 0x0a, 0x13, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70,
+	0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22,
+	0x48, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x22, 0x57, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x1a, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x4c,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x75, 0x73,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x22, 0x42, 0x0a, 0x1a, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f,
-	0x6c, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x76,
-	0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xf4, 0x02,
-	0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a,
-	0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x4d,
-	0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x33, 0x0a, 0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x05, 0x72,
-	0x65, 0x6e, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73,
-	0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x07, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x45, 0x0a,
-	0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x73, 0x67,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x36, 0x0a, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64,
-	0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65,
-	0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63,
-	0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
-	0x07, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x64, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x0a,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x75, 0x73, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x42,
+	0x0a, 0x1a, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x1a, 0x59, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x76, 0x0a, 0x1c,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xf4, 0x02, 0x0a, 0x0d,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x72, 0x65, 0x70, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11,
+	0x64, 0x65, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x4d, 0x69, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x05, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x79, 0x0a, 0x1e, 0x53,
-	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x05, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x64, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x72, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a,
+	0x09, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x64, 0x65,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x65, 0x0a, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x79, 0x0a, 0x1e, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a,
 	0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
 	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x7a, 0x0a, 0x10,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x27, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x22, 0x28, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x88, 0x01, 0x0a, 0x0e,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x1c, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f,
-	0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a,
-	0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c,
-	0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41,
-	0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41,
-	0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0xbc, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43, 0x48,
-	0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48,
-	0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
-	0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x10, 0x05, 0x32, 0x9d, 0x0f, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x48, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x7a, 0x0a, 0x10, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x27, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x28, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xf2, 0x01, 0x0a, 0x06, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3c,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x44, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x22, 0x3f, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x22, 0x47, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x26, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x3f,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22,
+	0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0c, 0x49, 0x50, 0x4e, 0x53,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49,
+	0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x65,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49,
+	0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x54, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x2b, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x50,
+	0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x41, 0x54, 0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x54,
+	0x48, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44,
+	0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0xbc, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43, 0x48, 0x49,
+	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49,
+	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41,
+	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52, 0x43, 0x48,
+	0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x10, 0x05, 0x32, 0xbf, 0x14, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
 	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x08, 0x50,
-	0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x08, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x58, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x08, 0x50,
+	0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61,
 	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68,
-	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x58, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x08,
-	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c,
-	0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x5f, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70,
-	0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x4e, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x5f, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x70, 0x66,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x4e, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
+	0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x57, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68,
-	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13,
-	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x78, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x50,
+	0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x78, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
 	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
 	0x74, 0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x7e, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x7e, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
 	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4e, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65,
-	0x78, 0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4e,
+	0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x50,
+	0x4e, 0x53, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50,
+	0x4e, 0x53, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50,
+	0x4e, 0x53, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x50, 0x4e, 0x53, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74,
+	0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -3971,154 +5081,194 @@ func file_api_bucketsd_pb_bucketsd_proto_rawDescGZIP() []byte {
 }
 
 var file_api_bucketsd_pb_bucketsd_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_bucketsd_pb_bucketsd_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_api_bucketsd_pb_bucketsd_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_api_bucketsd_pb_bucketsd_proto_goTypes = []interface{}{
 	(PathAccessRole)(0),                     // 0: api.bucketsd.pb.PathAccessRole
 	(ArchiveStatus)(0),                      // 1: api.bucketsd.pb.ArchiveStatus
 	(*Metadata)(nil),                        // 2: api.bucketsd.pb.Metadata
-	(*Root)(nil),                            // 3: api.bucketsd.pb.Root
-	(*ListRequest)(nil),                     // 4: api.bucketsd.pb.ListRequest
-	(*ListResponse)(nil),                    // 5: api.bucketsd.pb.ListResponse
-	(*CreateRequest)(nil),                   // 6: api.bucketsd.pb.CreateRequest
-	(*CreateResponse)(nil),                  // 7: api.bucketsd.pb.CreateResponse
-	(*RootRequest)(nil),                     // 8: api.bucketsd.pb.RootRequest
-	(*RootResponse)(nil),                    // 9: api.bucketsd.pb.RootResponse
-	(*LinksRequest)(nil),                    // 10: api.bucketsd.pb.LinksRequest
-	(*LinksResponse)(nil),                   // 11: api.bucketsd.pb.LinksResponse
-	(*ListPathRequest)(nil),                 // 12: api.bucketsd.pb.ListPathRequest
-	(*ListPathResponse)(nil),                // 13: api.bucketsd.pb.ListPathResponse
-	(*PathItem)(nil),                        // 14: api.bucketsd.pb.PathItem
-	(*ListIpfsPathRequest)(nil),             // 15: api.bucketsd.pb.ListIpfsPathRequest
-	(*ListIpfsPathResponse)(nil),            // 16: api.bucketsd.pb.ListIpfsPathResponse
-	(*PushPathRequest)(nil),                 // 17: api.bucketsd.pb.PushPathRequest
-	(*PushPathResponse)(nil),                // 18: api.bucketsd.pb.PushPathResponse
-	(*PushPathsRequest)(nil),                // 19: api.bucketsd.pb.PushPathsRequest
-	(*PushPathsResponse)(nil),               // 20: api.bucketsd.pb.PushPathsResponse
-	(*PullPathRequest)(nil),                 // 21: api.bucketsd.pb.PullPathRequest
-	(*PullPathResponse)(nil),                // 22: api.bucketsd.pb.PullPathResponse
-	(*PullIpfsPathRequest)(nil),             // 23: api.bucketsd.pb.PullIpfsPathRequest
-	(*PullIpfsPathResponse)(nil),            // 24: api.bucketsd.pb.PullIpfsPathResponse
-	(*SetPathRequest)(nil),                  // 25: api.bucketsd.pb.SetPathRequest
-	(*SetPathResponse)(nil),                 // 26: api.bucketsd.pb.SetPathResponse
-	(*RemoveRequest)(nil),                   // 27: api.bucketsd.pb.RemoveRequest
-	(*RemoveResponse)(nil),                  // 28: api.bucketsd.pb.RemoveResponse
-	(*RemovePathRequest)(nil),               // 29: api.bucketsd.pb.RemovePathRequest
-	(*RemovePathResponse)(nil),              // 30: api.bucketsd.pb.RemovePathResponse
-	(*PushPathAccessRolesRequest)(nil),      // 31: api.bucketsd.pb.PushPathAccessRolesRequest
-	(*PushPathAccessRolesResponse)(nil),     // 32: api.bucketsd.pb.PushPathAccessRolesResponse
-	(*PullPathAccessRolesRequest)(nil),      // 33: api.bucketsd.pb.PullPathAccessRolesRequest
-	(*PullPathAccessRolesResponse)(nil),     // 34: api.bucketsd.pb.PullPathAccessRolesResponse
-	(*AcceptPathAccessRolesRequest)(nil),    // 35: api.bucketsd.pb.AcceptPathAccessRolesRequest
-	(*AcceptPathAccessRolesResponse)(nil),   // 36: api.bucketsd.pb.AcceptPathAccessRolesResponse
-	(*ArchiveConfig)(nil),                   // 37: api.bucketsd.pb.ArchiveConfig
-	(*Archives)(nil),                        // 38: api.bucketsd.pb.Archives
-	(*Archive)(nil),                         // 39: api.bucketsd.pb.Archive
-	(*DealInfo)(nil),                        // 40: api.bucketsd.pb.DealInfo
-	(*ArchiveRenew)(nil),                    // 41: api.bucketsd.pb.ArchiveRenew
-	(*DefaultArchiveConfigRequest)(nil),     // 42: api.bucketsd.pb.DefaultArchiveConfigRequest
-	(*DefaultArchiveConfigResponse)(nil),    // 43: api.bucketsd.pb.DefaultArchiveConfigResponse
-	(*SetDefaultArchiveConfigRequest)(nil),  // 44: api.bucketsd.pb.SetDefaultArchiveConfigRequest
-	(*SetDefaultArchiveConfigResponse)(nil), // 45: api.bucketsd.pb.SetDefaultArchiveConfigResponse
-	(*ArchiveRequest)(nil),                  // 46: api.bucketsd.pb.ArchiveRequest
-	(*ArchiveResponse)(nil),                 // 47: api.bucketsd.pb.ArchiveResponse
-	(*ArchivesRequest)(nil),                 // 48: api.bucketsd.pb.ArchivesRequest
-	(*ArchivesResponse)(nil),                // 49: api.bucketsd.pb.ArchivesResponse
-	(*ArchiveWatchRequest)(nil),             // 50: api.bucketsd.pb.ArchiveWatchRequest
-	(*ArchiveWatchResponse)(nil),            // 51: api.bucketsd.pb.ArchiveWatchResponse
-	nil,                                     // 52: api.bucketsd.pb.Metadata.RolesEntry
-	nil,                                     // 53: api.bucketsd.pb.Root.PathMetadataEntry
-	(*PushPathRequest_Header)(nil),          // 54: api.bucketsd.pb.PushPathRequest.Header
-	(*PushPathResponse_Event)(nil),          // 55: api.bucketsd.pb.PushPathResponse.Event
-	(*PushPathsRequest_Header)(nil),         // 56: api.bucketsd.pb.PushPathsRequest.Header
-	(*PushPathsRequest_Chunk)(nil),          // 57: api.bucketsd.pb.PushPathsRequest.Chunk
-	nil,                                     // 58: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
-	nil,                                     // 59: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
+	(*FileInfo)(nil),                        // 3: api.bucketsd.pb.FileInfo
+	(*Root)(nil),                            // 4: api.bucketsd.pb.Root
+	(*ListRequest)(nil),                     // 5: api.bucketsd.pb.ListRequest
+	(*ListResponse)(nil),                    // 6: api.bucketsd.pb.ListResponse
+	(*CreateRequest)(nil),                   // 7: api.bucketsd.pb.CreateRequest
+	(*CreateResponse)(nil),                  // 8: api.bucketsd.pb.CreateResponse
+	(*RootRequest)(nil),                     // 9: api.bucketsd.pb.RootRequest
+	(*RootResponse)(nil),                    // 10: api.bucketsd.pb.RootResponse
+	(*LinksRequest)(nil),                    // 11: api.bucketsd.pb.LinksRequest
+	(*LinksResponse)(nil),                   // 12: api.bucketsd.pb.LinksResponse
+	(*ListPathRequest)(nil),                 // 13: api.bucketsd.pb.ListPathRequest
+	(*ListPathResponse)(nil),                // 14: api.bucketsd.pb.ListPathResponse
+	(*PathItem)(nil),                        // 15: api.bucketsd.pb.PathItem
+	(*ListIpfsPathRequest)(nil),             // 16: api.bucketsd.pb.ListIpfsPathRequest
+	(*ListIpfsPathResponse)(nil),            // 17: api.bucketsd.pb.ListIpfsPathResponse
+	(*PushPathRequest)(nil),                 // 18: api.bucketsd.pb.PushPathRequest
+	(*PushPathResponse)(nil),                // 19: api.bucketsd.pb.PushPathResponse
+	(*PushPathsRequest)(nil),                // 20: api.bucketsd.pb.PushPathsRequest
+	(*PushPathsResponse)(nil),               // 21: api.bucketsd.pb.PushPathsResponse
+	(*PullPathRequest)(nil),                 // 22: api.bucketsd.pb.PullPathRequest
+	(*PullPathResponse)(nil),                // 23: api.bucketsd.pb.PullPathResponse
+	(*PullIpfsPathRequest)(nil),             // 24: api.bucketsd.pb.PullIpfsPathRequest
+	(*PullIpfsPathResponse)(nil),            // 25: api.bucketsd.pb.PullIpfsPathResponse
+	(*SetPathRequest)(nil),                  // 26: api.bucketsd.pb.SetPathRequest
+	(*SetPathResponse)(nil),                 // 27: api.bucketsd.pb.SetPathResponse
+	(*RemoveRequest)(nil),                   // 28: api.bucketsd.pb.RemoveRequest
+	(*RemoveResponse)(nil),                  // 29: api.bucketsd.pb.RemoveResponse
+	(*RemovePathRequest)(nil),               // 30: api.bucketsd.pb.RemovePathRequest
+	(*RemovePathResponse)(nil),              // 31: api.bucketsd.pb.RemovePathResponse
+	(*PushPathAccessRolesRequest)(nil),      // 32: api.bucketsd.pb.PushPathAccessRolesRequest
+	(*PushPathAccessRolesResponse)(nil),     // 33: api.bucketsd.pb.PushPathAccessRolesResponse
+	(*PullPathAccessRolesRequest)(nil),      // 34: api.bucketsd.pb.PullPathAccessRolesRequest
+	(*PullPathAccessRolesResponse)(nil),     // 35: api.bucketsd.pb.PullPathAccessRolesResponse
+	(*AcceptPathAccessRolesRequest)(nil),    // 36: api.bucketsd.pb.AcceptPathAccessRolesRequest
+	(*AcceptPathAccessRolesResponse)(nil),   // 37: api.bucketsd.pb.AcceptPathAccessRolesResponse
+	(*ArchiveConfig)(nil),                   // 38: api.bucketsd.pb.ArchiveConfig
+	(*Archives)(nil),                        // 39: api.bucketsd.pb.Archives
+	(*Archive)(nil),                         // 40: api.bucketsd.pb.Archive
+	(*DealInfo)(nil),                        // 41: api.bucketsd.pb.DealInfo
+	(*ArchiveRenew)(nil),                    // 42: api.bucketsd.pb.ArchiveRenew
+	(*DefaultArchiveConfigRequest)(nil),     // 43: api.bucketsd.pb.DefaultArchiveConfigRequest
+	(*DefaultArchiveConfigResponse)(nil),    // 44: api.bucketsd.pb.DefaultArchiveConfigResponse
+	(*SetDefaultArchiveConfigRequest)(nil),  // 45: api.bucketsd.pb.SetDefaultArchiveConfigRequest
+	(*SetDefaultArchiveConfigResponse)(nil), // 46: api.bucketsd.pb.SetDefaultArchiveConfigResponse
+	(*ArchiveRequest)(nil),                  // 47: api.bucketsd.pb.ArchiveRequest
+	(*ArchiveResponse)(nil),                 // 48: api.bucketsd.pb.ArchiveResponse
+	(*ArchivesRequest)(nil),                 // 49: api.bucketsd.pb.ArchivesRequest
+	(*ArchivesResponse)(nil),                // 50: api.bucketsd.pb.ArchivesResponse
+	(*ArchiveWatchRequest)(nil),             // 51: api.bucketsd.pb.ArchiveWatchRequest
+	(*ArchiveWatchResponse)(nil),            // 52: api.bucketsd.pb.ArchiveWatchResponse
+	(*Domain)(nil),                          // 53: api.bucketsd.pb.Domain
+	(*AddDomainRequest)(nil),                // 54: api.bucketsd.pb.AddDomainRequest
+	(*AddDomainResponse)(nil),               // 55: api.bucketsd.pb.AddDomainResponse
+	(*VerifyDomainRequest)(nil),             // 56: api.bucketsd.pb.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),            // 57: api.bucketsd.pb.VerifyDomainResponse
+	(*ListDomainsRequest)(nil),              // 58: api.bucketsd.pb.ListDomainsRequest
+	(*ListDomainsResponse)(nil),             // 59: api.bucketsd.pb.ListDomainsResponse
+	(*RemoveDomainRequest)(nil),             // 60: api.bucketsd.pb.RemoveDomainRequest
+	(*RemoveDomainResponse)(nil),            // 61: api.bucketsd.pb.RemoveDomainResponse
+	(*IPNSSettings)(nil),                    // 62: api.bucketsd.pb.IPNSSettings
+	(*GetIPNSSettingsRequest)(nil),          // 63: api.bucketsd.pb.GetIPNSSettingsRequest
+	(*GetIPNSSettingsResponse)(nil),         // 64: api.bucketsd.pb.GetIPNSSettingsResponse
+	(*SetIPNSSettingsRequest)(nil),          // 65: api.bucketsd.pb.SetIPNSSettingsRequest
+	(*SetIPNSSettingsResponse)(nil),         // 66: api.bucketsd.pb.SetIPNSSettingsResponse
+	(*ExportIPNSKeyRequest)(nil),            // 67: api.bucketsd.pb.ExportIPNSKeyRequest
+	(*ExportIPNSKeyResponse)(nil),           // 68: api.bucketsd.pb.ExportIPNSKeyResponse
+	nil,                                     // 69: api.bucketsd.pb.Metadata.RolesEntry
+	nil,                                     // 70: api.bucketsd.pb.Root.PathMetadataEntry
+	(*PushPathRequest_Header)(nil),          // 71: api.bucketsd.pb.PushPathRequest.Header
+	(*PushPathResponse_Event)(nil),          // 72: api.bucketsd.pb.PushPathResponse.Event
+	(*PushPathsRequest_Header)(nil),         // 73: api.bucketsd.pb.PushPathsRequest.Header
+	(*PushPathsRequest_Chunk)(nil),          // 74: api.bucketsd.pb.PushPathsRequest.Chunk
+	nil,                                     // 75: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
+	nil,                                     // 76: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
 }
 var file_api_bucketsd_pb_bucketsd_proto_depIdxs = []int32{
-	52, // 0: api.bucketsd.pb.Metadata.roles:type_name -> api.bucketsd.pb.Metadata.RolesEntry
-	2,  // 1: api.bucketsd.pb.Root.metadata:type_name -> api.bucketsd.pb.Metadata
-	53, // 2: api.bucketsd.pb.Root.path_metadata:type_name -> api.bucketsd.pb.Root.PathMetadataEntry
-	38, // 3: api.bucketsd.pb.Root.archives:type_name -> api.bucketsd.pb.Archives
-	3,  // 4: api.bucketsd.pb.ListResponse.roots:type_name -> api.bucketsd.pb.Root
-	3,  // 5: api.bucketsd.pb.CreateResponse.root:type_name -> api.bucketsd.pb.Root
-	11, // 6: api.bucketsd.pb.CreateResponse.links:type_name -> api.bucketsd.pb.LinksResponse
-	3,  // 7: api.bucketsd.pb.RootResponse.root:type_name -> api.bucketsd.pb.Root
-	14, // 8: api.bucketsd.pb.ListPathResponse.item:type_name -> api.bucketsd.pb.PathItem
-	3,  // 9: api.bucketsd.pb.ListPathResponse.root:type_name -> api.bucketsd.pb.Root
-	14, // 10: api.bucketsd.pb.PathItem.items:type_name -> api.bucketsd.pb.PathItem
-	2,  // 11: api.bucketsd.pb.PathItem.metadata:type_name -> api.bucketsd.pb.Metadata
-	14, // 12: api.bucketsd.pb.ListIpfsPathResponse.item:type_name -> api.bucketsd.pb.PathItem
-	54, // 13: api.bucketsd.pb.PushPathRequest.header:type_name -> api.bucketsd.pb.PushPathRequest.Header
-	55, // 14: api.bucketsd.pb.PushPathResponse.event:type_name -> api.bucketsd.pb.PushPathResponse.Event
-	56, // 15: api.bucketsd.pb.PushPathsRequest.header:type_name -> api.bucketsd.pb.PushPathsRequest.Header
-	57, // 16: api.bucketsd.pb.PushPathsRequest.chunk:type_name -> api.bucketsd.pb.PushPathsRequest.Chunk
-	3,  // 17: api.bucketsd.pb.PushPathsResponse.root:type_name -> api.bucketsd.pb.Root
-	3,  // 18: api.bucketsd.pb.RemovePathResponse.root:type_name -> api.bucketsd.pb.Root
-	58, // 19: api.bucketsd.pb.PushPathAccessRolesRequest.roles:type_name -> api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
-	59, // 20: api.bucketsd.pb.PullPathAccessRolesResponse.roles:type_name -> api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
-	0,  // 21: api.bucketsd.pb.AcceptPathAccessRolesResponse.accepted_role:type_name -> api.bucketsd.pb.PathAccessRole
-	41, // 22: api.bucketsd.pb.ArchiveConfig.renew:type_name -> api.bucketsd.pb.ArchiveRenew
-	39, // 23: api.bucketsd.pb.Archives.current:type_name -> api.bucketsd.pb.Archive
-	39, // 24: api.bucketsd.pb.Archives.history:type_name -> api.bucketsd.pb.Archive
-	1,  // 25: api.bucketsd.pb.Archive.archive_status:type_name -> api.bucketsd.pb.ArchiveStatus
-	40, // 26: api.bucketsd.pb.Archive.deal_info:type_name -> api.bucketsd.pb.DealInfo
-	37, // 27: api.bucketsd.pb.DefaultArchiveConfigResponse.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	37, // 28: api.bucketsd.pb.SetDefaultArchiveConfigRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	37, // 29: api.bucketsd.pb.ArchiveRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
-	39, // 30: api.bucketsd.pb.ArchivesResponse.current:type_name -> api.bucketsd.pb.Archive
-	39, // 31: api.bucketsd.pb.ArchivesResponse.history:type_name -> api.bucketsd.pb.Archive
-	0,  // 32: api.bucketsd.pb.Metadata.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
-	2,  // 33: api.bucketsd.pb.Root.PathMetadataEntry.value:type_name -> api.bucketsd.pb.Metadata
-	3,  // 34: api.bucketsd.pb.PushPathResponse.Event.root:type_name -> api.bucketsd.pb.Root
-	0,  // 35: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
-	0,  // 36: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
-	4,  // 37: api.bucketsd.pb.APIService.List:input_type -> api.bucketsd.pb.ListRequest
-	6,  // 38: api.bucketsd.pb.APIService.Create:input_type -> api.bucketsd.pb.CreateRequest
-	8,  // 39: api.bucketsd.pb.APIService.Root:input_type -> api.bucketsd.pb.RootRequest
-	10, // 40: api.bucketsd.pb.APIService.Links:input_type -> api.bucketsd.pb.LinksRequest
-	12, // 41: api.bucketsd.pb.APIService.ListPath:input_type -> api.bucketsd.pb.ListPathRequest
-	15, // 42: api.bucketsd.pb.APIService.ListIpfsPath:input_type -> api.bucketsd.pb.ListIpfsPathRequest
-	17, // 43: api.bucketsd.pb.APIService.PushPath:input_type -> api.bucketsd.pb.PushPathRequest
-	19, // 44: api.bucketsd.pb.APIService.PushPaths:input_type -> api.bucketsd.pb.PushPathsRequest
-	21, // 45: api.bucketsd.pb.APIService.PullPath:input_type -> api.bucketsd.pb.PullPathRequest
-	23, // 46: api.bucketsd.pb.APIService.PullIpfsPath:input_type -> api.bucketsd.pb.PullIpfsPathRequest
-	25, // 47: api.bucketsd.pb.APIService.SetPath:input_type -> api.bucketsd.pb.SetPathRequest
-	27, // 48: api.bucketsd.pb.APIService.Remove:input_type -> api.bucketsd.pb.RemoveRequest
-	29, // 49: api.bucketsd.pb.APIService.RemovePath:input_type -> api.bucketsd.pb.RemovePathRequest
-	31, // 50: api.bucketsd.pb.APIService.PushPathAccessRoles:input_type -> api.bucketsd.pb.PushPathAccessRolesRequest
-	33, // 51: api.bucketsd.pb.APIService.PullPathAccessRoles:input_type -> api.bucketsd.pb.PullPathAccessRolesRequest
-	35, // 52: api.bucketsd.pb.APIService.AcceptPathAccessRoles:input_type -> api.bucketsd.pb.AcceptPathAccessRolesRequest
-	42, // 53: api.bucketsd.pb.APIService.DefaultArchiveConfig:input_type -> api.bucketsd.pb.DefaultArchiveConfigRequest
-	44, // 54: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:input_type -> api.bucketsd.pb.SetDefaultArchiveConfigRequest
-	46, // 55: api.bucketsd.pb.APIService.Archive:input_type -> api.bucketsd.pb.ArchiveRequest
-	48, // 56: api.bucketsd.pb.APIService.Archives:input_type -> api.bucketsd.pb.ArchivesRequest
-	50, // 57: api.bucketsd.pb.APIService.ArchiveWatch:input_type -> api.bucketsd.pb.ArchiveWatchRequest
-	5,  // 58: api.bucketsd.pb.APIService.List:output_type -> api.bucketsd.pb.ListResponse
-	7,  // 59: api.bucketsd.pb.APIService.Create:output_type -> api.bucketsd.pb.CreateResponse
-	9,  // 60: api.bucketsd.pb.APIService.Root:output_type -> api.bucketsd.pb.RootResponse
-	11, // 61: api.bucketsd.pb.APIService.Links:output_type -> api.bucketsd.pb.LinksResponse
-	13, // 62: api.bucketsd.pb.APIService.ListPath:output_type -> api.bucketsd.pb.ListPathResponse
-	16, // 63: api.bucketsd.pb.APIService.ListIpfsPath:output_type -> api.bucketsd.pb.ListIpfsPathResponse
-	18, // 64: api.bucketsd.pb.APIService.PushPath:output_type -> api.bucketsd.pb.PushPathResponse
-	20, // 65: api.bucketsd.pb.APIService.PushPaths:output_type -> api.bucketsd.pb.PushPathsResponse
-	22, // 66: api.bucketsd.pb.APIService.PullPath:output_type -> api.bucketsd.pb.PullPathResponse
-	24, // 67: api.bucketsd.pb.APIService.PullIpfsPath:output_type -> api.bucketsd.pb.PullIpfsPathResponse
-	26, // 68: api.bucketsd.pb.APIService.SetPath:output_type -> api.bucketsd.pb.SetPathResponse
-	28, // 69: api.bucketsd.pb.APIService.Remove:output_type -> api.bucketsd.pb.RemoveResponse
-	30, // 70: api.bucketsd.pb.APIService.RemovePath:output_type -> api.bucketsd.pb.RemovePathResponse
-	32, // 71: api.bucketsd.pb.APIService.PushPathAccessRoles:output_type -> api.bucketsd.pb.PushPathAccessRolesResponse
-	34, // 72: api.bucketsd.pb.APIService.PullPathAccessRoles:output_type -> api.bucketsd.pb.PullPathAccessRolesResponse
-	36, // 73: api.bucketsd.pb.APIService.AcceptPathAccessRoles:output_type -> api.bucketsd.pb.AcceptPathAccessRolesResponse
-	43, // 74: api.bucketsd.pb.APIService.DefaultArchiveConfig:output_type -> api.bucketsd.pb.DefaultArchiveConfigResponse
-	45, // 75: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:output_type -> api.bucketsd.pb.SetDefaultArchiveConfigResponse
-	47, // 76: api.bucketsd.pb.APIService.Archive:output_type -> api.bucketsd.pb.ArchiveResponse
-	49, // 77: api.bucketsd.pb.APIService.Archives:output_type -> api.bucketsd.pb.ArchivesResponse
-	51, // 78: api.bucketsd.pb.APIService.ArchiveWatch:output_type -> api.bucketsd.pb.ArchiveWatchResponse
-	58, // [58:79] is the sub-list for method output_type
-	37, // [37:58] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	69, // 0: api.bucketsd.pb.Metadata.roles:type_name -> api.bucketsd.pb.Metadata.RolesEntry
+	3,  // 1: api.bucketsd.pb.Metadata.info:type_name -> api.bucketsd.pb.FileInfo
+	2,  // 2: api.bucketsd.pb.Root.metadata:type_name -> api.bucketsd.pb.Metadata
+	70, // 3: api.bucketsd.pb.Root.path_metadata:type_name -> api.bucketsd.pb.Root.PathMetadataEntry
+	39, // 4: api.bucketsd.pb.Root.archives:type_name -> api.bucketsd.pb.Archives
+	4,  // 5: api.bucketsd.pb.ListResponse.roots:type_name -> api.bucketsd.pb.Root
+	4,  // 6: api.bucketsd.pb.CreateResponse.root:type_name -> api.bucketsd.pb.Root
+	12, // 7: api.bucketsd.pb.CreateResponse.links:type_name -> api.bucketsd.pb.LinksResponse
+	4,  // 8: api.bucketsd.pb.RootResponse.root:type_name -> api.bucketsd.pb.Root
+	15, // 9: api.bucketsd.pb.ListPathResponse.item:type_name -> api.bucketsd.pb.PathItem
+	4,  // 10: api.bucketsd.pb.ListPathResponse.root:type_name -> api.bucketsd.pb.Root
+	15, // 11: api.bucketsd.pb.PathItem.items:type_name -> api.bucketsd.pb.PathItem
+	2,  // 12: api.bucketsd.pb.PathItem.metadata:type_name -> api.bucketsd.pb.Metadata
+	15, // 13: api.bucketsd.pb.ListIpfsPathResponse.item:type_name -> api.bucketsd.pb.PathItem
+	71, // 14: api.bucketsd.pb.PushPathRequest.header:type_name -> api.bucketsd.pb.PushPathRequest.Header
+	72, // 15: api.bucketsd.pb.PushPathResponse.event:type_name -> api.bucketsd.pb.PushPathResponse.Event
+	73, // 16: api.bucketsd.pb.PushPathsRequest.header:type_name -> api.bucketsd.pb.PushPathsRequest.Header
+	74, // 17: api.bucketsd.pb.PushPathsRequest.chunk:type_name -> api.bucketsd.pb.PushPathsRequest.Chunk
+	4,  // 18: api.bucketsd.pb.PushPathsResponse.root:type_name -> api.bucketsd.pb.Root
+	4,  // 19: api.bucketsd.pb.RemovePathResponse.root:type_name -> api.bucketsd.pb.Root
+	75, // 20: api.bucketsd.pb.PushPathAccessRolesRequest.roles:type_name -> api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry
+	76, // 21: api.bucketsd.pb.PullPathAccessRolesResponse.roles:type_name -> api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry
+	0,  // 22: api.bucketsd.pb.AcceptPathAccessRolesResponse.accepted_role:type_name -> api.bucketsd.pb.PathAccessRole
+	42, // 23: api.bucketsd.pb.ArchiveConfig.renew:type_name -> api.bucketsd.pb.ArchiveRenew
+	40, // 24: api.bucketsd.pb.Archives.current:type_name -> api.bucketsd.pb.Archive
+	40, // 25: api.bucketsd.pb.Archives.history:type_name -> api.bucketsd.pb.Archive
+	1,  // 26: api.bucketsd.pb.Archive.archive_status:type_name -> api.bucketsd.pb.ArchiveStatus
+	41, // 27: api.bucketsd.pb.Archive.deal_info:type_name -> api.bucketsd.pb.DealInfo
+	38, // 28: api.bucketsd.pb.DefaultArchiveConfigResponse.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	38, // 29: api.bucketsd.pb.SetDefaultArchiveConfigRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	38, // 30: api.bucketsd.pb.ArchiveRequest.archive_config:type_name -> api.bucketsd.pb.ArchiveConfig
+	40, // 31: api.bucketsd.pb.ArchivesResponse.current:type_name -> api.bucketsd.pb.Archive
+	40, // 32: api.bucketsd.pb.ArchivesResponse.history:type_name -> api.bucketsd.pb.Archive
+	53, // 33: api.bucketsd.pb.AddDomainResponse.domain:type_name -> api.bucketsd.pb.Domain
+	53, // 34: api.bucketsd.pb.VerifyDomainResponse.domain:type_name -> api.bucketsd.pb.Domain
+	53, // 35: api.bucketsd.pb.ListDomainsResponse.domains:type_name -> api.bucketsd.pb.Domain
+	62, // 36: api.bucketsd.pb.GetIPNSSettingsResponse.settings:type_name -> api.bucketsd.pb.IPNSSettings
+	62, // 37: api.bucketsd.pb.SetIPNSSettingsRequest.settings:type_name -> api.bucketsd.pb.IPNSSettings
+	62, // 38: api.bucketsd.pb.SetIPNSSettingsResponse.settings:type_name -> api.bucketsd.pb.IPNSSettings
+	0,  // 39: api.bucketsd.pb.Metadata.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
+	2,  // 40: api.bucketsd.pb.Root.PathMetadataEntry.value:type_name -> api.bucketsd.pb.Metadata
+	3,  // 41: api.bucketsd.pb.PushPathRequest.Header.info:type_name -> api.bucketsd.pb.FileInfo
+	4,  // 42: api.bucketsd.pb.PushPathResponse.Event.root:type_name -> api.bucketsd.pb.Root
+	3,  // 43: api.bucketsd.pb.PushPathsRequest.Chunk.info:type_name -> api.bucketsd.pb.FileInfo
+	0,  // 44: api.bucketsd.pb.PushPathAccessRolesRequest.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
+	0,  // 45: api.bucketsd.pb.PullPathAccessRolesResponse.RolesEntry.value:type_name -> api.bucketsd.pb.PathAccessRole
+	5,  // 46: api.bucketsd.pb.APIService.List:input_type -> api.bucketsd.pb.ListRequest
+	7,  // 47: api.bucketsd.pb.APIService.Create:input_type -> api.bucketsd.pb.CreateRequest
+	9,  // 48: api.bucketsd.pb.APIService.Root:input_type -> api.bucketsd.pb.RootRequest
+	11, // 49: api.bucketsd.pb.APIService.Links:input_type -> api.bucketsd.pb.LinksRequest
+	13, // 50: api.bucketsd.pb.APIService.ListPath:input_type -> api.bucketsd.pb.ListPathRequest
+	16, // 51: api.bucketsd.pb.APIService.ListIpfsPath:input_type -> api.bucketsd.pb.ListIpfsPathRequest
+	18, // 52: api.bucketsd.pb.APIService.PushPath:input_type -> api.bucketsd.pb.PushPathRequest
+	20, // 53: api.bucketsd.pb.APIService.PushPaths:input_type -> api.bucketsd.pb.PushPathsRequest
+	22, // 54: api.bucketsd.pb.APIService.PullPath:input_type -> api.bucketsd.pb.PullPathRequest
+	24, // 55: api.bucketsd.pb.APIService.PullIpfsPath:input_type -> api.bucketsd.pb.PullIpfsPathRequest
+	26, // 56: api.bucketsd.pb.APIService.SetPath:input_type -> api.bucketsd.pb.SetPathRequest
+	28, // 57: api.bucketsd.pb.APIService.Remove:input_type -> api.bucketsd.pb.RemoveRequest
+	30, // 58: api.bucketsd.pb.APIService.RemovePath:input_type -> api.bucketsd.pb.RemovePathRequest
+	32, // 59: api.bucketsd.pb.APIService.PushPathAccessRoles:input_type -> api.bucketsd.pb.PushPathAccessRolesRequest
+	34, // 60: api.bucketsd.pb.APIService.PullPathAccessRoles:input_type -> api.bucketsd.pb.PullPathAccessRolesRequest
+	36, // 61: api.bucketsd.pb.APIService.AcceptPathAccessRoles:input_type -> api.bucketsd.pb.AcceptPathAccessRolesRequest
+	43, // 62: api.bucketsd.pb.APIService.DefaultArchiveConfig:input_type -> api.bucketsd.pb.DefaultArchiveConfigRequest
+	45, // 63: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:input_type -> api.bucketsd.pb.SetDefaultArchiveConfigRequest
+	47, // 64: api.bucketsd.pb.APIService.Archive:input_type -> api.bucketsd.pb.ArchiveRequest
+	49, // 65: api.bucketsd.pb.APIService.Archives:input_type -> api.bucketsd.pb.ArchivesRequest
+	51, // 66: api.bucketsd.pb.APIService.ArchiveWatch:input_type -> api.bucketsd.pb.ArchiveWatchRequest
+	54, // 67: api.bucketsd.pb.APIService.AddDomain:input_type -> api.bucketsd.pb.AddDomainRequest
+	56, // 68: api.bucketsd.pb.APIService.VerifyDomain:input_type -> api.bucketsd.pb.VerifyDomainRequest
+	58, // 69: api.bucketsd.pb.APIService.ListDomains:input_type -> api.bucketsd.pb.ListDomainsRequest
+	60, // 70: api.bucketsd.pb.APIService.RemoveDomain:input_type -> api.bucketsd.pb.RemoveDomainRequest
+	63, // 71: api.bucketsd.pb.APIService.GetIPNSSettings:input_type -> api.bucketsd.pb.GetIPNSSettingsRequest
+	65, // 72: api.bucketsd.pb.APIService.SetIPNSSettings:input_type -> api.bucketsd.pb.SetIPNSSettingsRequest
+	67, // 73: api.bucketsd.pb.APIService.ExportIPNSKey:input_type -> api.bucketsd.pb.ExportIPNSKeyRequest
+	6,  // 74: api.bucketsd.pb.APIService.List:output_type -> api.bucketsd.pb.ListResponse
+	8,  // 75: api.bucketsd.pb.APIService.Create:output_type -> api.bucketsd.pb.CreateResponse
+	10, // 76: api.bucketsd.pb.APIService.Root:output_type -> api.bucketsd.pb.RootResponse
+	12, // 77: api.bucketsd.pb.APIService.Links:output_type -> api.bucketsd.pb.LinksResponse
+	14, // 78: api.bucketsd.pb.APIService.ListPath:output_type -> api.bucketsd.pb.ListPathResponse
+	17, // 79: api.bucketsd.pb.APIService.ListIpfsPath:output_type -> api.bucketsd.pb.ListIpfsPathResponse
+	19, // 80: api.bucketsd.pb.APIService.PushPath:output_type -> api.bucketsd.pb.PushPathResponse
+	21, // 81: api.bucketsd.pb.APIService.PushPaths:output_type -> api.bucketsd.pb.PushPathsResponse
+	23, // 82: api.bucketsd.pb.APIService.PullPath:output_type -> api.bucketsd.pb.PullPathResponse
+	25, // 83: api.bucketsd.pb.APIService.PullIpfsPath:output_type -> api.bucketsd.pb.PullIpfsPathResponse
+	27, // 84: api.bucketsd.pb.APIService.SetPath:output_type -> api.bucketsd.pb.SetPathResponse
+	29, // 85: api.bucketsd.pb.APIService.Remove:output_type -> api.bucketsd.pb.RemoveResponse
+	31, // 86: api.bucketsd.pb.APIService.RemovePath:output_type -> api.bucketsd.pb.RemovePathResponse
+	33, // 87: api.bucketsd.pb.APIService.PushPathAccessRoles:output_type -> api.bucketsd.pb.PushPathAccessRolesResponse
+	35, // 88: api.bucketsd.pb.APIService.PullPathAccessRoles:output_type -> api.bucketsd.pb.PullPathAccessRolesResponse
+	37, // 89: api.bucketsd.pb.APIService.AcceptPathAccessRoles:output_type -> api.bucketsd.pb.AcceptPathAccessRolesResponse
+	44, // 90: api.bucketsd.pb.APIService.DefaultArchiveConfig:output_type -> api.bucketsd.pb.DefaultArchiveConfigResponse
+	46, // 91: api.bucketsd.pb.APIService.SetDefaultArchiveConfig:output_type -> api.bucketsd.pb.SetDefaultArchiveConfigResponse
+	48, // 92: api.bucketsd.pb.APIService.Archive:output_type -> api.bucketsd.pb.ArchiveResponse
+	50, // 93: api.bucketsd.pb.APIService.Archives:output_type -> api.bucketsd.pb.ArchivesResponse
+	52, // 94: api.bucketsd.pb.APIService.ArchiveWatch:output_type -> api.bucketsd.pb.ArchiveWatchResponse
+	55, // 95: api.bucketsd.pb.APIService.AddDomain:output_type -> api.bucketsd.pb.AddDomainResponse
+	57, // 96: api.bucketsd.pb.APIService.VerifyDomain:output_type -> api.bucketsd.pb.VerifyDomainResponse
+	59, // 97: api.bucketsd.pb.APIService.ListDomains:output_type -> api.bucketsd.pb.ListDomainsResponse
+	61, // 98: api.bucketsd.pb.APIService.RemoveDomain:output_type -> api.bucketsd.pb.RemoveDomainResponse
+	64, // 99: api.bucketsd.pb.APIService.GetIPNSSettings:output_type -> api.bucketsd.pb.GetIPNSSettingsResponse
+	66, // 100: api.bucketsd.pb.APIService.SetIPNSSettings:output_type -> api.bucketsd.pb.SetIPNSSettingsResponse
+	68, // 101: api.bucketsd.pb.APIService.ExportIPNSKey:output_type -> api.bucketsd.pb.ExportIPNSKeyResponse
+	74, // [74:102] is the sub-list for method output_type
+	46, // [46:74] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_api_bucketsd_pb_bucketsd_proto_init() }
@@ -4140,7 +5290,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Root); i {
+			switch v := v.(*FileInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4152,7 +5302,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRequest); i {
+			switch v := v.(*Root); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4164,7 +5314,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResponse); i {
+			switch v := v.(*ListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4176,7 +5326,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRequest); i {
+			switch v := v.(*ListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4188,7 +5338,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
+			switch v := v.(*CreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4200,7 +5350,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RootRequest); i {
+			switch v := v.(*CreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4212,7 +5362,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RootResponse); i {
+			switch v := v.(*RootRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4224,7 +5374,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinksRequest); i {
+			switch v := v.(*RootResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4236,7 +5386,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinksResponse); i {
+			switch v := v.(*LinksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4248,7 +5398,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPathRequest); i {
+			switch v := v.(*LinksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4260,7 +5410,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPathResponse); i {
+			switch v := v.(*ListPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4272,7 +5422,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathItem); i {
+			switch v := v.(*ListPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4284,7 +5434,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIpfsPathRequest); i {
+			switch v := v.(*PathItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4296,7 +5446,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIpfsPathResponse); i {
+			switch v := v.(*ListIpfsPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4308,7 +5458,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathRequest); i {
+			switch v := v.(*ListIpfsPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4320,7 +5470,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathResponse); i {
+			switch v := v.(*PushPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4332,7 +5482,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathsRequest); i {
+			switch v := v.(*PushPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4344,6 +5494,18 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 		file_api_bucketsd_pb_bucketsd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushPathsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathsResponse); i {
 			case 0:
 				return &v.state
@@ -4355,8 +5517,200 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullIpfsPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullIpfsPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushPathAccessRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushPathAccessRolesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullPathAccessRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullPathAccessRolesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPathAccessRolesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPathAccessRolesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4367,8 +5721,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Archives); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4379,8 +5733,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullIpfsPathRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Archive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4391,8 +5745,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullIpfsPathResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DealInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4403,8 +5757,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPathRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRenew); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4415,8 +5769,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPathResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultArchiveConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4427,8 +5781,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultArchiveConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4439,8 +5793,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultArchiveConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4451,8 +5805,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePathRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDefaultArchiveConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4463,8 +5817,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePathResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4475,8 +5829,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathAccessRolesRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4487,8 +5841,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPathAccessRolesResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4499,8 +5853,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathAccessRolesRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4511,8 +5865,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullPathAccessRolesResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4523,8 +5877,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptPathAccessRolesRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4535,8 +5889,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptPathAccessRolesResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Domain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4547,8 +5901,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveConfig); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDomainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4559,8 +5913,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Archives); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDomainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4571,8 +5925,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Archive); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4583,8 +5937,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DealInfo); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4595,8 +5949,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRenew); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDomainsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4607,8 +5961,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultArchiveConfigRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDomainsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4619,8 +5973,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultArchiveConfigResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDomainRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4631,8 +5985,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultArchiveConfigRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDomainResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4643,8 +5997,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDefaultArchiveConfigResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPNSSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4655,8 +6009,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIPNSSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4667,8 +6021,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIPNSSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4679,8 +6033,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIPNSSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4691,8 +6045,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchivesResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetIPNSSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4703,8 +6057,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveWatchRequest); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportIPNSKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4715,8 +6069,8 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveWatchResponse); i {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportIPNSKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4727,7 +6081,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathRequest_Header); i {
 			case 0:
 				return &v.state
@@ -4739,7 +6093,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathResponse_Event); i {
 			case 0:
 				return &v.state
@@ -4751,7 +6105,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathsRequest_Header); i {
 			case 0:
 				return &v.state
@@ -4763,7 +6117,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 				return nil
 			}
 		}
-		file_api_bucketsd_pb_bucketsd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_api_bucketsd_pb_bucketsd_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushPathsRequest_Chunk); i {
 			case 0:
 				return &v.state
@@ -4776,11 +6130,11 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			}
 		}
 	}
-	file_api_bucketsd_pb_bucketsd_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_api_bucketsd_pb_bucketsd_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*PushPathRequest_Header_)(nil),
 		(*PushPathRequest_Chunk)(nil),
 	}
-	file_api_bucketsd_pb_bucketsd_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_api_bucketsd_pb_bucketsd_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*PushPathsRequest_Header_)(nil),
 		(*PushPathsRequest_Chunk_)(nil),
 	}
@@ -4790,7 +6144,7 @@ func file_api_bucketsd_pb_bucketsd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_bucketsd_pb_bucketsd_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   58,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4839,6 +6193,15 @@ type APIServiceClient interface {
 	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveResponse, error)
 	Archives(ctx context.Context, in *ArchivesRequest, opts ...grpc.CallOption) (*ArchivesResponse, error)
 	ArchiveWatch(ctx context.Context, in *ArchiveWatchRequest, opts ...grpc.CallOption) (APIService_ArchiveWatchClient, error)
+	// Domains
+	AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainResponse, error)
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
+	ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsResponse, error)
+	RemoveDomain(ctx context.Context, in *RemoveDomainRequest, opts ...grpc.CallOption) (*RemoveDomainResponse, error)
+	// IPNS
+	GetIPNSSettings(ctx context.Context, in *GetIPNSSettingsRequest, opts ...grpc.CallOption) (*GetIPNSSettingsResponse, error)
+	SetIPNSSettings(ctx context.Context, in *SetIPNSSettingsRequest, opts ...grpc.CallOption) (*SetIPNSSettingsResponse, error)
+	ExportIPNSKey(ctx context.Context, in *ExportIPNSKeyRequest, opts ...grpc.CallOption) (*ExportIPNSKeyResponse, error)
 }
 
 type aPIServiceClient struct {
@@ -5151,6 +6514,69 @@ func (x *aPIServiceArchiveWatchClient) Recv() (*ArchiveWatchResponse, error) {
 	return m, nil
 }
 
+func (c *aPIServiceClient) AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainResponse, error) {
+	out := new(AddDomainResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/AddDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/VerifyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsResponse, error) {
+	out := new(ListDomainsResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/ListDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RemoveDomain(ctx context.Context, in *RemoveDomainRequest, opts ...grpc.CallOption) (*RemoveDomainResponse, error) {
+	out := new(RemoveDomainResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/RemoveDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetIPNSSettings(ctx context.Context, in *GetIPNSSettingsRequest, opts ...grpc.CallOption) (*GetIPNSSettingsResponse, error) {
+	out := new(GetIPNSSettingsResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/GetIPNSSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SetIPNSSettings(ctx context.Context, in *SetIPNSSettingsRequest, opts ...grpc.CallOption) (*SetIPNSSettingsResponse, error) {
+	out := new(SetIPNSSettingsResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/SetIPNSSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ExportIPNSKey(ctx context.Context, in *ExportIPNSKeyRequest, opts ...grpc.CallOption) (*ExportIPNSKeyResponse, error) {
+	out := new(ExportIPNSKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.bucketsd.pb.APIService/ExportIPNSKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 type APIServiceServer interface {
 	List(context.Context, *ListRequest) (*ListResponse, error)
@@ -5175,6 +6601,15 @@ type APIServiceServer interface {
 	Archive(context.Context, *ArchiveRequest) (*ArchiveResponse, error)
 	Archives(context.Context, *ArchivesRequest) (*ArchivesResponse, error)
 	ArchiveWatch(*ArchiveWatchRequest, APIService_ArchiveWatchServer) error
+	// Domains
+	AddDomain(context.Context, *AddDomainRequest) (*AddDomainResponse, error)
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	RemoveDomain(context.Context, *RemoveDomainRequest) (*RemoveDomainResponse, error)
+	// IPNS
+	GetIPNSSettings(context.Context, *GetIPNSSettingsRequest) (*GetIPNSSettingsResponse, error)
+	SetIPNSSettings(context.Context, *SetIPNSSettingsRequest) (*SetIPNSSettingsResponse, error)
+	ExportIPNSKey(context.Context, *ExportIPNSKeyRequest) (*ExportIPNSKeyResponse, error)
 }
 
 // UnimplementedAPIServiceServer can be embedded to have forward compatible implementations.
@@ -5244,6 +6679,27 @@ func (*UnimplementedAPIServiceServer) Archives(context.Context, *ArchivesRequest
 func (*UnimplementedAPIServiceServer) ArchiveWatch(*ArchiveWatchRequest, APIService_ArchiveWatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method ArchiveWatch not implemented")
 }
+func (*UnimplementedAPIServiceServer) AddDomain(context.Context, *AddDomainRequest) (*AddDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomain not implemented")
+}
+func (*UnimplementedAPIServiceServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomains not implemented")
+}
+func (*UnimplementedAPIServiceServer) RemoveDomain(context.Context, *RemoveDomainRequest) (*RemoveDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDomain not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetIPNSSettings(context.Context, *GetIPNSSettingsRequest) (*GetIPNSSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIPNSSettings not implemented")
+}
+func (*UnimplementedAPIServiceServer) SetIPNSSettings(context.Context, *SetIPNSSettingsRequest) (*SetIPNSSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIPNSSettings not implemented")
+}
+func (*UnimplementedAPIServiceServer) ExportIPNSKey(context.Context, *ExportIPNSKeyRequest) (*ExportIPNSKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportIPNSKey not implemented")
+}
 
 func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
 	s.RegisterService(&_APIService_serviceDesc, srv)
@@ -5652,6 +7108,132 @@ func (x *aPIServiceArchiveWatchServer) Send(m *ArchiveWatchResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _APIService_AddDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).AddDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/AddDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).AddDomain(ctx, req.(*AddDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/VerifyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/ListDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListDomains(ctx, req.(*ListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RemoveDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RemoveDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/RemoveDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RemoveDomain(ctx, req.(*RemoveDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetIPNSSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPNSSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetIPNSSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/GetIPNSSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetIPNSSettings(ctx, req.(*GetIPNSSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SetIPNSSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIPNSSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SetIPNSSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/SetIPNSSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SetIPNSSettings(ctx, req.(*SetIPNSSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ExportIPNSKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportIPNSKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ExportIPNSKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.bucketsd.pb.APIService/ExportIPNSKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ExportIPNSKey(ctx, req.(*ExportIPNSKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _APIService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.bucketsd.pb.APIService",
 	HandlerType: (*APIServiceServer)(nil),
@@ -5720,6 +7302,34 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Archives",
 			Handler:    _APIService_Archives_Handler,
 		},
+		{
+			MethodName: "AddDomain",
+			Handler:    _APIService_AddDomain_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _APIService_VerifyDomain_Handler,
+		},
+		{
+			MethodName: "ListDomains",
+			Handler:    _APIService_ListDomains_Handler,
+		},
+		{
+			MethodName: "RemoveDomain",
+			Handler:    _APIService_RemoveDomain_Handler,
+		},
+		{
+			MethodName: "GetIPNSSettings",
+			Handler:    _APIService_GetIPNSSettings_Handler,
+		},
+		{
+			MethodName: "SetIPNSSettings",
+			Handler:    _APIService_SetIPNSSettings_Handler,
+		},
+		{
+			MethodName: "ExportIPNSKey",
+			Handler:    _APIService_ExportIPNSKey_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
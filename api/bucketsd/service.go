@@ -27,6 +27,7 @@ import (
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/textileio/dcrypto"
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
@@ -39,6 +40,7 @@ import (
 	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/buckets/archive/retrieval"
 	"github.com/textileio/textile/v2/buckets/archive/tracker"
+	"github.com/textileio/textile/v2/domains"
 	"github.com/textileio/textile/v2/ipns"
 	mdb "github.com/textileio/textile/v2/mongodb"
 	tdb "github.com/textileio/textile/v2/threaddb"
@@ -105,6 +107,7 @@ type Service struct {
 	GatewayBucketsHost        string
 	IPFSClient                iface.CoreAPI
 	IPNSManager               *ipns.Manager
+	Domains                   *domains.Manager
 	PowergateClient           *pow.Client
 	PowergateAdminToken       string
 	ArchiveTracker            *tracker.Tracker
@@ -200,13 +203,33 @@ func metadataToPb(md tdb.Metadata) (*pb.Metadata, error) {
 		}
 		roles[k] = pr
 	}
+	var info *pb.FileInfo
+	if md.Info != nil {
+		info = &pb.FileInfo{
+			Mode:    md.Info.Mode,
+			ModTime: md.Info.ModTime,
+			Symlink: md.Info.Symlink,
+		}
+	}
 	return &pb.Metadata{
 		Key:       md.Key,
 		Roles:     roles,
+		Info:      info,
 		UpdatedAt: md.UpdatedAt,
 	}, nil
 }
 
+func fileInfoFromPb(info *pb.FileInfo) *tdb.FileInfo {
+	if info == nil {
+		return nil
+	}
+	return &tdb.FileInfo{
+		Mode:    info.Mode,
+		ModTime: info.ModTime,
+		Symlink: info.Symlink,
+	}
+}
+
 func archivesToPb(archives tdb.Archives) *pb.Archives {
 	pba := &pb.Archives{
 		Current: &pb.Archive{
@@ -253,6 +276,18 @@ func (s *Service) Create(ctx context.Context, req *pb.CreateRequest) (*pb.Create
 		}
 	}
 
+	var ipnsKey crypto.PrivKey
+	if len(req.IpnsKey) > 0 {
+		if req.Unfreeze {
+			return nil, status.Error(codes.InvalidArgument, "ipns key can't be imported when unfreezing")
+		}
+		var err error
+		ipnsKey, err = crypto.UnmarshalPrivateKey(req.IpnsKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ipns key: %v", err)
+		}
+	}
+
 	// If the bucket is created from some imported archive,
 	// create the retrieval request, and let all the process
 	// happend async in the background.
@@ -272,9 +307,9 @@ func (s *Service) Create(ctx context.Context, req *pb.CreateRequest) (*pb.Create
 	}
 
 	// If not created with --unfreeze, just do the normal case.
-	ctx, buck, seed, err := s.createBucket(ctx, dbID, dbToken, req.Name, req.Private, bootCid)
+	ctx, buck, seed, err := s.createBucket(ctx, dbID, dbToken, req.Name, req.Private, bootCid, ipnsKey)
 	if err != nil {
-		return nil, err
+		return nil, ipnsKeyError(err)
 	}
 	var seedData []byte
 	if buck.IsPrivate() {
@@ -316,11 +351,12 @@ func (s *Service) CreateBucket(ctx context.Context,
 	buckName string,
 	buckPrivate bool,
 	dataCid cid.Cid) error {
-	_, _, _, err := s.createBucket(ctx, threadID, threadToken, buckName, buckPrivate, dataCid)
+	_, _, _, err := s.createBucket(ctx, threadID, threadToken, buckName, buckPrivate, dataCid, nil)
 	return err
 }
 
 // createBucket returns a new bucket and seed node.
+// If ipnsKey is not nil, it's imported and used as the bucket's IPNS key.
 func (s *Service) createBucket(
 	ctx context.Context,
 	dbID thread.ID,
@@ -328,6 +364,7 @@ func (s *Service) createBucket(
 	name string,
 	private bool,
 	bootCid cid.Cid,
+	ipnsKey crypto.PrivKey,
 ) (nctx context.Context, buck *tdb.Bucket, seed ipld.Node, err error) {
 	var owner thread.PubKey
 	if dbToken.Defined() {
@@ -381,8 +418,13 @@ func (s *Service) createBucket(
 		},
 	}
 
-	// Create a new IPNS key
-	buckKey, err := s.IPNSManager.CreateKey(ctx, dbID, buckPath)
+	// Create a new IPNS key or import the provided one
+	var buckKey string
+	if ipnsKey != nil {
+		buckKey, err = s.IPNSManager.ImportKey(ctx, dbID, buckPath, ipnsKey)
+	} else {
+		buckKey, err = s.IPNSManager.CreateKey(ctx, dbID, buckPath)
+	}
 	if err != nil {
 		return
 	}
@@ -400,6 +442,10 @@ func (s *Service) createBucket(
 		tdb.WithNewBucketKey(linkKey),
 		tdb.WithNewBucketToken(dbToken))
 	if err != nil {
+		// Remove the key so that it can be imported again
+		if rerr := s.IPNSManager.RemoveKey(ctx, buckKey); rerr != nil {
+			log.Errorf("removing ipns key %s: %v", buckKey, rerr)
+		}
 		return
 	}
 
@@ -1260,10 +1306,13 @@ func (s *Service) nodeToItem(
 	var pmd *pb.Metadata
 	if buck != nil {
 		name := cleanPath(strings.TrimPrefix(pth, buck.Path))
-		md, _, ok := buck.GetMetadataForPath(name, false)
+		md, at, ok := buck.GetMetadataForPath(name, false)
 		if !ok {
 			return nil, fmt.Errorf("could not resolve path: %s", pth)
 		}
+		if at != name {
+			md.Info = nil // File info is not inherited from parents
+		}
 		var err error
 		pmd, err = metadataToPb(md)
 		if err != nil {
@@ -1380,11 +1429,13 @@ func (s *Service) PushPath(server pb.APIService_PushPathServer) (err error) {
 		return err
 	}
 	var buckKey, headerPath, root string
+	var info *pb.FileInfo
 	switch payload := req.Payload.(type) {
 	case *pb.PushPathRequest_Header_:
 		buckKey = payload.Header.Key
 		headerPath = payload.Header.Path
 		root = payload.Header.Root
+		info = payload.Header.Info
 	default:
 		return fmt.Errorf("push bucket path header is required")
 	}
@@ -1408,6 +1459,7 @@ func (s *Service) PushPath(server pb.APIService_PushPathServer) (err error) {
 
 	buck.UpdatedAt = time.Now().UnixNano()
 	buck.SetMetadataAtPath(filePath, tdb.Metadata{
+		Info:      fileInfoFromPb(info),
 		UpdatedAt: buck.UpdatedAt,
 	})
 	buck.UnsetMetadataWithPrefix(filePath + "/")
@@ -1719,6 +1771,7 @@ func (s *Service) PushPaths(server pb.APIService_PushPathsServer) error {
 					wg.Add(1)
 					buck.UpdatedAt = time.Now().UnixNano()
 					buck.SetMetadataAtPath(pth, tdb.Metadata{
+						Info:      fileInfoFromPb(payload.Chunk.Info),
 						UpdatedAt: buck.UpdatedAt,
 					})
 					buck.UnsetMetadataWithPrefix(pth + "/")
@@ -2304,6 +2357,11 @@ func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.Remove
 	if err = s.IPNSManager.RemoveKey(ctx, buck.Key); err != nil {
 		return nil, err
 	}
+	if s.Domains != nil {
+		if err = s.Domains.RemoveAll(ctx, buck.Key); err != nil {
+			return nil, err
+		}
+	}
 
 	log.Debugf("removed bucket: %s", buck.Key)
 	return &pb.RemoveResponse{
@@ -2311,6 +2369,20 @@ func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.Remove
 	}, nil
 }
 
+// getBucket returns the bucket if the caller has access to it.
+func (s *Service) getBucket(ctx context.Context, key string) (*tdb.Bucket, error) {
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, errDBRequired
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.GetSafe(ctx, dbID, key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	return buck, nil
+}
+
 // unpinNodeAndBranch unpins a node and its entire branch, accounting for sum bytes pinned for context.
 func (s *Service) unpinNodeAndBranch(ctx context.Context, pth path.Resolved, key []byte) (context.Context, error) {
 	ctx, err := s.unpinBranch(ctx, pth, key)
@@ -59,6 +59,30 @@ func OrgSlugFromMD(ctx context.Context) (slug string, ok bool) {
 	return
 }
 
+// NewTwoFactorCodeContext adds a two-factor authentication code to a context.
+// Sensitive methods require a code if the user has enabled two-factor authentication.
+func NewTwoFactorCodeContext(ctx context.Context, code string) context.Context {
+	if code == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("twoFactorCode"), code)
+}
+
+// TwoFactorCodeFromContext returns a two-factor authentication code from a context.
+func TwoFactorCodeFromContext(ctx context.Context) (string, bool) {
+	code, ok := ctx.Value(ctxKey("twoFactorCode")).(string)
+	return code, ok
+}
+
+// TwoFactorCodeFromMD returns a two-factor authentication code from context metadata.
+func TwoFactorCodeFromMD(ctx context.Context) (code string, ok bool) {
+	code = metautils.ExtractIncoming(ctx).Get("x-textile-2fa-code")
+	if code != "" {
+		ok = true
+	}
+	return
+}
+
 // NewAPIKeyContext adds an API key to a context.
 func NewAPIKeyContext(ctx context.Context, key string) context.Context {
 	if key == "" {
@@ -238,6 +262,10 @@ func (c Credentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[s
 	if ok {
 		md["x-textile-org"] = orgSlug
 	}
+	twoFactorCode, ok := TwoFactorCodeFromContext(ctx)
+	if ok {
+		md["x-textile-2fa-code"] = twoFactorCode
+	}
 	apiKey, ok := APIKeyFromContext(ctx)
 	if ok {
 		md["x-textile-api-key"] = apiKey
@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/textileio/textile/v2/api/common"
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"google.golang.org/grpc"
 )
@@ -37,6 +40,7 @@ func (c *Client) BuildInfo(ctx context.Context) (*pb.BuildInfoResponse, error) {
 
 // Signup creates a new user and returns a session.
 // This method will block and wait for email-based verification.
+// Prefer StartSignup, which doesn't hold a connection open while waiting.
 func (c *Client) Signup(ctx context.Context, username, email string) (*pb.SignupResponse, error) {
 	return c.c.Signup(ctx, &pb.SignupRequest{
 		Username: username,
@@ -46,9 +50,84 @@ func (c *Client) Signup(ctx context.Context, username, email string) (*pb.Signup
 
 // Signin returns a session for an existing username or email.
 // This method will block and wait for email-based verification.
+// Prefer StartSignin, which doesn't hold a connection open while waiting.
+// If the user has enabled two-factor authentication, add a code to ctx with common.NewTwoFactorCodeContext.
+// The code is checked once the email is verified, so it must still be valid then.
 func (c *Client) Signin(ctx context.Context, usernameOrEmail string) (*pb.SigninResponse, error) {
+	code, _ := common.TwoFactorCodeFromContext(ctx)
 	return c.c.Signin(ctx, &pb.SigninRequest{
 		UsernameOrEmail: usernameOrEmail,
+		TwoFactorCode:   code,
+	})
+}
+
+// StartSignup starts creating a new user and sends a verification email.
+// Use PollLogin with the returned login ID to get a session once the email is verified.
+func (c *Client) StartSignup(ctx context.Context, username, email string) (*pb.StartSignupResponse, error) {
+	return c.c.StartSignup(ctx, &pb.StartSignupRequest{
+		Username: username,
+		Email:    email,
+	})
+}
+
+// StartSignin starts a signin for an existing username or email and sends a verification email.
+// Use PollLogin with the returned login ID to get a session once the email is verified.
+func (c *Client) StartSignin(ctx context.Context, usernameOrEmail string) (*pb.StartSigninResponse, error) {
+	return c.c.StartSignin(ctx, &pb.StartSigninRequest{
+		UsernameOrEmail: usernameOrEmail,
+	})
+}
+
+// PollLogin returns the status of a pending login.
+// Once the email is verified, the login is complete and the response contains a new session.
+// A login can only be completed once.
+// If the user has enabled two-factor authentication, the status is LOGIN_STATUS_TWO_FACTOR_REQUIRED
+// until a code is added to ctx with common.NewTwoFactorCodeContext.
+func (c *Client) PollLogin(ctx context.Context, loginID string) (*pb.PollLoginResponse, error) {
+	code, _ := common.TwoFactorCodeFromContext(ctx)
+	return c.c.PollLogin(ctx, &pb.PollLoginRequest{
+		LoginId:       loginID,
+		TwoFactorCode: code,
+	})
+}
+
+// GetSSOURL returns a single sign-on URL for the hub's identity provider, or an org's if org is not empty.
+// If redirectURI is empty, the hub receives the provider callback and challenge is ignored.
+// Otherwise, redirectURI must be a loopback address and challenge is the PKCE code challenge.
+// username is used if sign on creates a new account.
+func (c *Client) GetSSOURL(ctx context.Context, org, redirectURI, challenge, username string) (*pb.GetSSOURLResponse, error) {
+	return c.c.GetSSOURL(ctx, &pb.GetSSOURLRequest{
+		Org:           org,
+		RedirectUri:   redirectURI,
+		CodeChallenge: challenge,
+		Username:      username,
+	})
+}
+
+// GetSSOLinkURL returns a URL for the session user to sign on with an identity provider
+// in order to link the provider account. Linked provider accounts can be used to sign in with SSOSignin.
+// Arguments are the same as GetSSOURL.
+func (c *Client) GetSSOLinkURL(ctx context.Context, org, redirectURI, challenge string) (*pb.GetSSOURLResponse, error) {
+	return c.c.GetSSOURL(ctx, &pb.GetSSOURLRequest{
+		Org:           org,
+		RedirectUri:   redirectURI,
+		CodeChallenge: challenge,
+		Link:          true,
+	})
+}
+
+// SSOSignin returns a session for the user who completed single sign-on with state.
+// Accounts are matched by the linked provider account and created if needed.
+// If the sign on was started with GetSSOLinkURL, the provider account is linked and no session is returned.
+// If code is empty, the hub receives the provider callback, and the response is pending until it does.
+// Call SSOSignin again with the same state until the response is no longer pending.
+// If the user has enabled two-factor authentication, a login ID is returned instead of a session.
+// Complete the login with a code using PollLogin.
+func (c *Client) SSOSignin(ctx context.Context, state, code, verifier string) (*pb.SSOSigninResponse, error) {
+	return c.c.SSOSignin(ctx, &pb.SSOSigninRequest{
+		State:        state,
+		Code:         code,
+		CodeVerifier: verifier,
 	})
 }
 
@@ -63,16 +142,65 @@ func (c *Client) GetSessionInfo(ctx context.Context) (*pb.GetSessionInfoResponse
 	return c.c.GetSessionInfo(ctx, &pb.GetSessionInfoRequest{})
 }
 
+// ListSessions returns the active sessions of the session user.
+func (c *Client) ListSessions(ctx context.Context) (*pb.ListSessionsResponse, error) {
+	return c.c.ListSessions(ctx, &pb.ListSessionsRequest{})
+}
+
+// RevokeSession revokes a session of the session user by ID, as returned by ListSessions.
+func (c *Client) RevokeSession(ctx context.Context, id string) error {
+	_, err := c.c.RevokeSession(ctx, &pb.RevokeSessionRequest{Id: id})
+	return err
+}
+
+// RevokeAllSessions signs the session user out everywhere.
+// If keepCurrent is true, the current session stays active.
+func (c *Client) RevokeAllSessions(ctx context.Context, keepCurrent bool) error {
+	_, err := c.c.RevokeAllSessions(ctx, &pb.RevokeAllSessionsRequest{KeepCurrent: keepCurrent})
+	return err
+}
+
+// EnableTwoFactor starts two-factor authentication enrollment for the session user.
+// Add the returned secret or URL to an authenticator app and confirm with ConfirmTwoFactor.
+func (c *Client) EnableTwoFactor(ctx context.Context) (*pb.EnableTwoFactorResponse, error) {
+	return c.c.EnableTwoFactor(ctx, &pb.EnableTwoFactorRequest{})
+}
+
+// ConfirmTwoFactor enables two-factor authentication with a code from the authenticator app.
+// The returned recovery codes can each be used once in place of a code.
+func (c *Client) ConfirmTwoFactor(ctx context.Context, code string) (*pb.ConfirmTwoFactorResponse, error) {
+	return c.c.ConfirmTwoFactor(ctx, &pb.ConfirmTwoFactorRequest{Code: code})
+}
+
+// DisableTwoFactor disables two-factor authentication with a code or recovery code.
+func (c *Client) DisableTwoFactor(ctx context.Context, code string) error {
+	_, err := c.c.DisableTwoFactor(ctx, &pb.DisableTwoFactorRequest{Code: code})
+	return err
+}
+
 // GetIdentity returns the identity of the current session.
 func (c *Client) GetIdentity(ctx context.Context) (*pb.GetIdentityResponse, error) {
 	return c.c.GetIdentity(ctx, &pb.GetIdentityRequest{})
 }
 
 // CreateKey creates a new key for the current session.
-func (c *Client) CreateKey(ctx context.Context, keyType pb.KeyType, secure bool) (*pb.CreateKeyResponse, error) {
+// Use opts to limit what the key can access, when it expires, and where it can be used from.
+func (c *Client) CreateKey(ctx context.Context, keyType pb.KeyType, secure bool, opts ...KeyOption) (*pb.CreateKeyResponse, error) {
+	args := &keyOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var expiresAt int64
+	if !args.expiresAt.IsZero() {
+		expiresAt = args.expiresAt.Unix()
+	}
 	return c.c.CreateKey(ctx, &pb.CreateKeyRequest{
-		Type:   keyType,
-		Secure: secure,
+		Type:           keyType,
+		Secure:         secure,
+		Scopes:         args.scopes,
+		ExpiresAt:      expiresAt,
+		AllowedOrigins: args.allowedOrigins,
+		AllowedIps:     args.allowedIPs,
 	})
 }
 
@@ -83,6 +211,15 @@ func (c *Client) InvalidateKey(ctx context.Context, key string) error {
 	return err
 }
 
+// RotateKey issues a new secret for a key.
+// The old secret stays valid for grace so that deployed clients can be updated.
+func (c *Client) RotateKey(ctx context.Context, key string, grace time.Duration) (*pb.RotateKeyResponse, error) {
+	return c.c.RotateKey(ctx, &pb.RotateKeyRequest{
+		Key:         key,
+		GracePeriod: int64(grace / time.Second),
+	})
+}
+
 // ListKeys returns a list of keys for the current session.
 func (c *Client) ListKeys(ctx context.Context) (*pb.ListKeysResponse, error) {
 	return c.c.ListKeys(ctx, &pb.ListKeysRequest{})
@@ -116,12 +253,89 @@ func (c *Client) InviteToOrg(ctx context.Context, email string) (*pb.InviteToOrg
 	})
 }
 
+// InviteUserToOrg invites an existing user to an org by username.
+// The invite is delivered to the user's mailbox.
+func (c *Client) InviteUserToOrg(ctx context.Context, username string) (*pb.InviteToOrgResponse, error) {
+	return c.c.InviteToOrg(ctx, &pb.InviteToOrgRequest{
+		Username: username,
+	})
+}
+
+// ListInvites returns all invites to an org, including expired and accepted invites.
+func (c *Client) ListInvites(ctx context.Context) (*pb.ListInvitesResponse, error) {
+	return c.c.ListInvites(ctx, &pb.ListInvitesRequest{})
+}
+
+// ResendInvite sends an invite again and extends its expiry.
+func (c *Client) ResendInvite(ctx context.Context, token string) (*pb.ResendInviteResponse, error) {
+	return c.c.ResendInvite(ctx, &pb.ResendInviteRequest{Token: token})
+}
+
+// RevokeInvite deletes an invite so that it can't be accepted.
+func (c *Client) RevokeInvite(ctx context.Context, token string) error {
+	_, err := c.c.RevokeInvite(ctx, &pb.RevokeInviteRequest{Token: token})
+	return err
+}
+
 // LeaveOrg removes the current session dev from an org.
 func (c *Client) LeaveOrg(ctx context.Context) error {
 	_, err := c.c.LeaveOrg(ctx, &pb.LeaveOrgRequest{})
 	return err
 }
 
+// SetOrgMemberRole changes the role of an org member by username.
+// Roles are owner, admin, member, developer, billing-manager, and read-only.
+func (c *Client) SetOrgMemberRole(ctx context.Context, username, role string) error {
+	_, err := c.c.SetOrgMemberRole(ctx, &pb.SetOrgMemberRoleRequest{
+		Username: username,
+		Role:     role,
+	})
+	return err
+}
+
+// RemoveOrgMember removes a member from an org by username.
+func (c *Client) RemoveOrgMember(ctx context.Context, username string) error {
+	_, err := c.c.RemoveOrgMember(ctx, &pb.RemoveOrgMemberRequest{Username: username})
+	return err
+}
+
+// TransferOrgOwnership offers ownership of the org to username.
+// The transfer is complete when the recipient accepts it with AcceptOrgOwnership.
+// An empty username cancels a pending transfer.
+func (c *Client) TransferOrgOwnership(ctx context.Context, username string) (*pb.TransferOrgOwnershipResponse, error) {
+	return c.c.TransferOrgOwnership(ctx, &pb.TransferOrgOwnershipRequest{Username: username})
+}
+
+// AcceptOrgOwnership accepts a pending ownership transfer of org to the session user.
+// The previous owner becomes an org admin.
+func (c *Client) AcceptOrgOwnership(ctx context.Context, org string) (*pb.AcceptOrgOwnershipResponse, error) {
+	return c.c.AcceptOrgOwnership(ctx, &pb.AcceptOrgOwnershipRequest{Org: org})
+}
+
+// SetOrgTwoFactor sets whether org members must use two-factor authentication.
+func (c *Client) SetOrgTwoFactor(ctx context.Context, required bool) error {
+	_, err := c.c.SetOrgTwoFactor(ctx, &pb.SetOrgTwoFactorRequest{Required: required})
+	return err
+}
+
+// SetOrgSSO sets the org's single sign-on identity provider.
+// If required is true, members can only access the org with sessions signed on with the provider.
+// An empty issuer removes the provider.
+func (c *Client) SetOrgSSO(ctx context.Context, issuer, clientID, clientSecret string, required bool) error {
+	_, err := c.c.SetOrgSSO(ctx, &pb.SetOrgSSORequest{
+		Issuer:       issuer,
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+		Required:     required,
+	})
+	return err
+}
+
+// GetOrgSSO returns the org's single sign-on identity provider.
+func (c *Client) GetOrgSSO(ctx context.Context) (*pb.GetOrgSSOResponse, error) {
+	return c.c.GetOrgSSO(ctx, &pb.GetOrgSSORequest{})
+}
+
 // SetupBilling (re-)enables billing for an account, enabling
 // usage beyond the free quotas.
 func (c *Client) SetupBilling(ctx context.Context) error {
@@ -162,6 +376,51 @@ func (c *Client) IsOrgNameAvailable(ctx context.Context, name string) (*pb.IsOrg
 	})
 }
 
+// ExportAccount starts exporting the account's data to a downloadable archive.
+// The export runs in the background; use GetExport to check its progress.
+// If an export is already running, it's returned instead of starting another.
+func (c *Client) ExportAccount(ctx context.Context) (*pb.ExportInfo, error) {
+	res, err := c.c.ExportAccount(ctx, &pb.ExportAccountRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Export, nil
+}
+
+// GetExport returns an account export by id.
+func (c *Client) GetExport(ctx context.Context, id string) (*pb.ExportInfo, error) {
+	res, err := c.c.GetExport(ctx, &pb.GetExportRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Export, nil
+}
+
+// DownloadExport writes a complete account export archive to writer.
+// The archive is a gzipped tarball.
+func (c *Client) DownloadExport(ctx context.Context, id string, writer io.Writer) error {
+	stream, err := c.c.DownloadExport(ctx, &pb.DownloadExportRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		rep, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if _, err := writer.Write(rep.Chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DestroyAccount completely deletes an account and all associated data.
 func (c *Client) DestroyAccount(ctx context.Context) error {
 	_, err := c.c.DestroyAccount(ctx, &pb.DestroyAccountRequest{})
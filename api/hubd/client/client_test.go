@@ -1,13 +1,19 @@
 package client_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +25,9 @@ import (
 	c "github.com/textileio/textile/v2/api/hubd/client"
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/core"
+	"github.com/textileio/textile/v2/oidc"
+	"github.com/textileio/textile/v2/oidc/oidctest"
+	"github.com/textileio/textile/v2/totp"
 	"google.golang.org/grpc"
 )
 
@@ -63,6 +72,136 @@ func TestClient_Signin(t *testing.T) {
 	assert.NotEmpty(t, res.Session)
 }
 
+func TestClient_PollLogin(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	ctx := context.Background()
+
+	email := apitest.NewEmail()
+	login, err := client.StartSignup(ctx, apitest.NewUsername(), email)
+	require.NoError(t, err)
+	res, err := client.PollLogin(ctx, login.LoginId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.LoginStatus_LOGIN_STATUS_PENDING, res.Status)
+
+	apitest.ConfirmEmail(t, conf.AddrGatewayURL, apitest.SessionSecret)
+	res, err = client.PollLogin(ctx, login.LoginId)
+	require.NoError(t, err)
+	assert.Equal(t, pb.LoginStatus_LOGIN_STATUS_COMPLETE, res.Status)
+	assert.NotEmpty(t, res.Key)
+	assert.NotEmpty(t, res.Session)
+
+	t.Run("complete login", func(t *testing.T) {
+		_, err := client.PollLogin(ctx, login.LoginId)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown login", func(t *testing.T) {
+		_, err := client.PollLogin(ctx, "foo")
+		require.Error(t, err)
+	})
+
+	t.Run("signin", func(t *testing.T) {
+		login, err := client.StartSignin(ctx, email)
+		require.NoError(t, err)
+		apitest.ConfirmEmail(t, conf.AddrGatewayURL, apitest.SessionSecret)
+		res2, err := client.PollLogin(ctx, login.LoginId)
+		require.NoError(t, err)
+		assert.Equal(t, pb.LoginStatus_LOGIN_STATUS_COMPLETE, res2.Status)
+		assert.Equal(t, res.Key, res2.Key)
+		assert.NotEqual(t, res.Session, res2.Session)
+	})
+}
+
+func TestClient_SSOSignin(t *testing.T) {
+	t.Parallel()
+	email := apitest.NewEmail()
+	provider := setupSSOProvider(t, email)
+	conf := apitest.DefaultTextileConfig(t)
+	conf.SSOIssuer = provider.Issuer()
+	conf.SSOClientID = oidctest.ClientID
+	conf.SSOClientSecret = oidctest.ClientSecret
+	conf, client, _ := setup(t, &conf)
+	ctx := context.Background()
+
+	res := ssoSignin(t, client, provider, "")
+	assert.NotEmpty(t, res.Key)
+	assert.NotEmpty(t, res.Session)
+
+	t.Run("without code", func(t *testing.T) {
+		u, err := client.GetSSOURL(ctx, "", ssoRedirectURI, oidc.Challenge(oidc.NewVerifier()), "")
+		require.NoError(t, err)
+		_, err = client.SSOSignin(ctx, u.State, "", "")
+		require.Error(t, err)
+	})
+
+	t.Run("non-loopback redirect", func(t *testing.T) {
+		_, err := client.GetSSOURL(ctx, "", "https://example.com/callback", oidc.Challenge(oidc.NewVerifier()), "")
+		require.Error(t, err)
+	})
+
+	t.Run("with gateway callback", func(t *testing.T) {
+		u, err := client.GetSSOURL(ctx, "", "", "", "")
+		require.NoError(t, err)
+		pending, err := client.SSOSignin(ctx, u.State, "", "")
+		require.NoError(t, err)
+		assert.True(t, pending.Pending)
+		assert.Empty(t, pending.Session)
+		redirect, err := provider.Authorize(u.Url)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(redirect.String(), conf.AddrGatewayURL+oidc.CallbackPath))
+		_, err = http.Get(redirect.String())
+		require.NoError(t, err)
+		res2, err := client.SSOSignin(ctx, u.State, "", "")
+		require.NoError(t, err)
+		assert.False(t, res2.Pending)
+		assert.Equal(t, res.Key, res2.Key)
+	})
+
+	t.Run("existing account", func(t *testing.T) {
+		email2 := apitest.NewEmail()
+		user := apitest.Signup(t, client, conf, apitest.NewUsername(), email2)
+		provider.SetUser(oidctest.User{Subject: "2", Email: email2, EmailVerified: true})
+
+		// Accounts aren't matched by email
+		verifier := oidc.NewVerifier()
+		u, err := client.GetSSOURL(ctx, "", ssoRedirectURI, oidc.Challenge(verifier), "")
+		require.NoError(t, err)
+		redirect, err := provider.Authorize(u.Url)
+		require.NoError(t, err)
+		_, err = client.SSOSignin(ctx, u.State, redirect.Query().Get("code"), verifier)
+		require.Error(t, err)
+
+		// Linking requires a session
+		_, err = client.GetSSOLinkURL(ctx, "", ssoRedirectURI, oidc.Challenge(verifier))
+		require.Error(t, err)
+
+		verifier = oidc.NewVerifier()
+		u, err = client.GetSSOLinkURL(common.NewSessionContext(ctx, user.Session), "", ssoRedirectURI, oidc.Challenge(verifier))
+		require.NoError(t, err)
+		redirect, err = provider.Authorize(u.Url)
+		require.NoError(t, err)
+		linked, err := client.SSOSignin(ctx, u.State, redirect.Query().Get("code"), verifier)
+		require.NoError(t, err)
+		assert.True(t, linked.Linked)
+		assert.Empty(t, linked.Session)
+
+		res2 := ssoSignin(t, client, provider, "")
+		assert.Equal(t, user.Key, res2.Key)
+		assert.NotEmpty(t, res2.Session)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		provider.SetUser(oidctest.User{Subject: "3", Email: apitest.NewEmail()})
+		u, err := client.GetSSOURL(ctx, "", ssoRedirectURI, oidc.Challenge(oidc.NewVerifier()), "")
+		require.NoError(t, err)
+		redirect, err := provider.Authorize(u.Url)
+		require.NoError(t, err)
+		_, err = client.SSOSignin(ctx, u.State, redirect.Query().Get("code"), oidc.NewVerifier())
+		require.Error(t, err)
+	})
+}
+
 func TestClient_Signout(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t, nil)
@@ -104,6 +243,244 @@ func TestClient_GetSessionInfo(t *testing.T) {
 	})
 }
 
+func TestClient_ListSessions(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	ctx := context.Background()
+
+	t.Run("without session", func(t *testing.T) {
+		_, err := client.ListSessions(ctx)
+		require.Error(t, err)
+	})
+
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	other := apitest.Signin(t, client, conf, username)
+
+	t.Run("with session", func(t *testing.T) {
+		res, err := client.ListSessions(common.NewSessionContext(ctx, user.Session))
+		require.NoError(t, err)
+		require.Len(t, res.List, 2)
+		var current int
+		for _, s := range res.List {
+			assert.NotEqual(t, user.Session, s.Id)
+			assert.NotEqual(t, other.Session, s.Id)
+			assert.NotEmpty(t, s.UserAgent)
+			assert.NotEmpty(t, s.Ip)
+			if s.Current {
+				current++
+			}
+		}
+		assert.Equal(t, 1, current)
+	})
+}
+
+func TestClient_RevokeSession(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	ctx := context.Background()
+
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	userCtx := common.NewSessionContext(ctx, user.Session)
+	other := apitest.Signin(t, client, conf, username)
+	otherCtx := common.NewSessionContext(ctx, other.Session)
+
+	t.Run("bad id", func(t *testing.T) {
+		err := client.RevokeSession(userCtx, "bad")
+		require.Error(t, err)
+	})
+
+	t.Run("good id", func(t *testing.T) {
+		res, err := client.ListSessions(userCtx)
+		require.NoError(t, err)
+		for _, s := range res.List {
+			if !s.Current {
+				err = client.RevokeSession(userCtx, s.Id)
+				require.NoError(t, err)
+			}
+		}
+		_, err = client.GetSessionInfo(otherCtx)
+		require.Error(t, err)
+		_, err = client.GetSessionInfo(userCtx)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_RevokeAllSessions(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	ctx := context.Background()
+
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	userCtx := common.NewSessionContext(ctx, user.Session)
+	other := apitest.Signin(t, client, conf, username)
+	otherCtx := common.NewSessionContext(ctx, other.Session)
+
+	t.Run("keep current", func(t *testing.T) {
+		err := client.RevokeAllSessions(userCtx, true)
+		require.NoError(t, err)
+		_, err = client.GetSessionInfo(otherCtx)
+		require.Error(t, err)
+		_, err = client.GetSessionInfo(userCtx)
+		require.NoError(t, err)
+	})
+
+	t.Run("everywhere", func(t *testing.T) {
+		err := client.RevokeAllSessions(userCtx, false)
+		require.NoError(t, err)
+		_, err = client.GetSessionInfo(userCtx)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_TwoFactor(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+
+	t.Run("confirm without enable", func(t *testing.T) {
+		_, err := client.ConfirmTwoFactor(ctx, "123456")
+		require.Error(t, err)
+	})
+
+	enabled, err := client.EnableTwoFactor(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, enabled.Secret)
+	assert.Contains(t, enabled.Url, "otpauth://totp/")
+	step := totp.Step(time.Now())
+
+	t.Run("confirm with bad code", func(t *testing.T) {
+		_, err := client.ConfirmTwoFactor(ctx, "abcdef")
+		require.Error(t, err)
+	})
+
+	code, err := totp.Code(enabled.Secret, step)
+	require.NoError(t, err)
+	confirmed, err := client.ConfirmTwoFactor(ctx, code)
+	require.NoError(t, err)
+	require.Len(t, confirmed.RecoveryCodes, 10)
+	info, err := client.GetSessionInfo(ctx)
+	require.NoError(t, err)
+	assert.True(t, info.TwoFactorEnabled)
+
+	t.Run("sensitive method without code", func(t *testing.T) {
+		_, err := client.CreateKey(ctx, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.Error(t, err)
+	})
+
+	t.Run("sensitive method with code", func(t *testing.T) {
+		code, err := totp.Code(enabled.Secret, step+1)
+		require.NoError(t, err)
+		key, err := client.CreateKey(common.NewTwoFactorCodeContext(ctx, code), pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.NoError(t, err)
+
+		// Codes can only be used once
+		_, err = client.CreateKey(common.NewTwoFactorCodeContext(ctx, code), pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.Error(t, err)
+
+		// Rotating a key issues a new secret, so it also requires a code
+		_, err = client.RotateKey(ctx, key.KeyInfo.Key, time.Hour)
+		require.Error(t, err)
+		_, err = client.RotateKey(common.NewTwoFactorCodeContext(ctx, confirmed.RecoveryCodes[2]), key.KeyInfo.Key, time.Hour)
+		require.NoError(t, err)
+	})
+
+	t.Run("signin without code", func(t *testing.T) {
+		// The code is checked after the email is verified
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err = client.Signin(context.Background(), username)
+		}()
+		apitest.ConfirmEmail(t, conf.AddrGatewayURL, apitest.SessionSecret)
+		wg.Wait()
+		require.Error(t, err)
+	})
+
+	t.Run("poll login", func(t *testing.T) {
+		login, err := client.StartSignin(context.Background(), username)
+		require.NoError(t, err)
+		apitest.ConfirmEmail(t, conf.AddrGatewayURL, apitest.SessionSecret)
+		res, err := client.PollLogin(context.Background(), login.LoginId)
+		require.NoError(t, err)
+		assert.Equal(t, pb.LoginStatus_LOGIN_STATUS_TWO_FACTOR_REQUIRED, res.Status)
+
+		_, err = client.PollLogin(common.NewTwoFactorCodeContext(context.Background(), "bad"), login.LoginId)
+		require.Error(t, err)
+		res, err = client.PollLogin(
+			common.NewTwoFactorCodeContext(context.Background(), confirmed.RecoveryCodes[0]),
+			login.LoginId,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, pb.LoginStatus_LOGIN_STATUS_COMPLETE, res.Status)
+		assert.NotEmpty(t, res.Session)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		// Recovery codes can only be used once
+		err := client.DisableTwoFactor(ctx, confirmed.RecoveryCodes[0])
+		require.Error(t, err)
+		err = client.DisableTwoFactor(ctx, confirmed.RecoveryCodes[1])
+		require.NoError(t, err)
+		_, err = client.CreateKey(ctx, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_SetOrgTwoFactor(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, apitest.NewUsername())
+	require.NoError(t, err)
+	octx := common.NewOrgSlugContext(ctx, res.OrgInfo.Slug)
+
+	user2Email := apitest.NewEmail()
+	user2 := apitest.Signup(t, client, conf, apitest.NewUsername(), user2Email)
+	ctx2 := common.NewSessionContext(context.Background(), user2.Session)
+	invite, err := client.InviteToOrg(octx, user2Email)
+	require.NoError(t, err)
+	_, err = http.Get(fmt.Sprintf("%s/consent/%s", conf.AddrGatewayURL, invite.Token))
+	require.NoError(t, err)
+
+	t.Run("without two-factor", func(t *testing.T) {
+		err := client.SetOrgTwoFactor(octx, true)
+		require.Error(t, err)
+	})
+
+	enabled, err := client.EnableTwoFactor(ctx)
+	require.NoError(t, err)
+	code, err := totp.Code(enabled.Secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	_, err = client.ConfirmTwoFactor(ctx, code)
+	require.NoError(t, err)
+
+	t.Run("as member", func(t *testing.T) {
+		err := client.SetOrgTwoFactor(common.NewOrgSlugContext(ctx2, res.OrgInfo.Slug), true)
+		require.Error(t, err)
+	})
+
+	t.Run("as owner", func(t *testing.T) {
+		err := client.SetOrgTwoFactor(octx, true)
+		require.NoError(t, err)
+		got, err := client.GetOrg(octx)
+		require.NoError(t, err)
+		assert.True(t, got.OrgInfo.TwoFactorRequired)
+
+		// Members without two-factor authentication are locked out of the org
+		_, err = client.GetOrg(common.NewOrgSlugContext(ctx2, res.OrgInfo.Slug))
+		require.Error(t, err)
+	})
+}
+
 func TestClient_GetIdentity(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t, nil)
@@ -151,6 +528,34 @@ func TestClient_CreateKey(t *testing.T) {
 		assert.Equal(t, pb.KeyType_KEY_TYPE_ACCOUNT, res.KeyInfo.Type)
 		assert.True(t, res.KeyInfo.Secure)
 	})
+
+	t.Run("with options", func(t *testing.T) {
+		expiry := time.Now().Add(time.Hour)
+		res, err := client.CreateKey(
+			common.NewSessionContext(ctx, user.Session),
+			pb.KeyType_KEY_TYPE_ACCOUNT,
+			true,
+			c.WithScopes("buckets:read", "threads:write"),
+			c.WithExpiry(expiry),
+			c.WithAllowedOrigins("https://example.com"),
+			c.WithAllowedIPs("10.0.0.0/8"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"buckets:read", "threads:write"}, res.KeyInfo.Scopes)
+		assert.Equal(t, expiry.Unix(), res.KeyInfo.ExpiresAt)
+		assert.Equal(t, []string{"https://example.com"}, res.KeyInfo.AllowedOrigins)
+		assert.Equal(t, []string{"10.0.0.0/8"}, res.KeyInfo.AllowedIps)
+	})
+
+	t.Run("with bad options", func(t *testing.T) {
+		sctx := common.NewSessionContext(ctx, user.Session)
+		_, err := client.CreateKey(sctx, pb.KeyType_KEY_TYPE_ACCOUNT, true, c.WithScopes("buckets:delete"))
+		require.Error(t, err)
+		_, err = client.CreateKey(sctx, pb.KeyType_KEY_TYPE_ACCOUNT, true, c.WithExpiry(time.Now().Add(-time.Hour)))
+		require.Error(t, err)
+		_, err = client.CreateKey(sctx, pb.KeyType_KEY_TYPE_ACCOUNT, true, c.WithAllowedIPs("not-an-ip"))
+		require.Error(t, err)
+	})
 }
 
 func TestClient_InvalidateKey(t *testing.T) {
@@ -179,6 +584,43 @@ func TestClient_InvalidateKey(t *testing.T) {
 	})
 }
 
+func TestClient_RotateKey(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	ctx := context.Background()
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	sctx := common.NewSessionContext(ctx, user.Session)
+	res, err := client.CreateKey(sctx, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+	require.NoError(t, err)
+
+	t.Run("without session", func(t *testing.T) {
+		_, err := client.RotateKey(ctx, res.KeyInfo.Key, time.Hour)
+		require.Error(t, err)
+	})
+
+	t.Run("with session", func(t *testing.T) {
+		rotated, err := client.RotateKey(sctx, res.KeyInfo.Key, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, res.KeyInfo.Key, rotated.KeyInfo.Key)
+		assert.NotEqual(t, res.KeyInfo.Secret, rotated.KeyInfo.Secret)
+		assert.NotEmpty(t, rotated.KeyInfo.RotatedAt)
+		assert.Greater(t, rotated.KeyInfo.PreviousSecretExpiresAt, time.Now().Unix())
+	})
+
+	t.Run("with long grace period", func(t *testing.T) {
+		_, err := client.RotateKey(sctx, res.KeyInfo.Key, 365*24*time.Hour)
+		require.Error(t, err)
+	})
+
+	t.Run("insecure key", func(t *testing.T) {
+		insecure, err := client.CreateKey(sctx, pb.KeyType_KEY_TYPE_ACCOUNT, false)
+		require.NoError(t, err)
+		_, err = client.RotateKey(sctx, insecure.KeyInfo.Key, time.Hour)
+		require.Error(t, err)
+	})
+}
+
 func TestClient_ListKeys(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t, nil)
@@ -326,6 +768,108 @@ func TestClient_InviteToOrg(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, res.Token)
 	})
+
+	t.Run("unknown username", func(t *testing.T) {
+		_, err := client.InviteUserToOrg(ctx, apitest.NewUsername())
+		require.Error(t, err)
+	})
+
+	t.Run("username without mailbox", func(t *testing.T) {
+		username := apitest.NewUsername()
+		apitest.Signup(t, client, conf, username, apitest.NewEmail())
+		_, err := client.InviteUserToOrg(ctx, username)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_ListInvites(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	t.Run("empty", func(t *testing.T) {
+		list, err := client.ListInvites(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list.List)
+	})
+
+	email := apitest.NewEmail()
+	invite, err := client.InviteToOrg(ctx, email)
+	require.NoError(t, err)
+
+	t.Run("not empty", func(t *testing.T) {
+		list, err := client.ListInvites(ctx)
+		require.NoError(t, err)
+		require.Len(t, list.List, 1)
+		assert.Equal(t, invite.Token, list.List[0].Token)
+		assert.Equal(t, email, list.List[0].EmailTo)
+		assert.False(t, list.List[0].Accepted)
+	})
+}
+
+func TestClient_ResendInvite(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	invite, err := client.InviteToOrg(ctx, apitest.NewEmail())
+	require.NoError(t, err)
+
+	t.Run("bad token", func(t *testing.T) {
+		_, err := client.ResendInvite(ctx, "bad")
+		require.Error(t, err)
+	})
+
+	t.Run("good token", func(t *testing.T) {
+		res, err := client.ResendInvite(ctx, invite.Token)
+		require.NoError(t, err)
+		assert.Equal(t, invite.Token, res.Invite.Token)
+		assert.Greater(t, res.Invite.ExpiresAt, time.Now().Unix())
+	})
+}
+
+func TestClient_RevokeInvite(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	invite, err := client.InviteToOrg(ctx, apitest.NewEmail())
+	require.NoError(t, err)
+
+	t.Run("good token", func(t *testing.T) {
+		err := client.RevokeInvite(ctx, invite.Token)
+		require.NoError(t, err)
+		list, err := client.ListInvites(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list.List)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		err := client.RevokeInvite(ctx, invite.Token)
+		require.Error(t, err)
+		res, err := http.Get(fmt.Sprintf("%s/consent/%s", conf.AddrGatewayURL, invite.Token))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
 }
 
 func TestClient_LeaveOrg(t *testing.T) {
@@ -364,6 +908,290 @@ func TestClient_LeaveOrg(t *testing.T) {
 	})
 }
 
+func TestClient_SetOrgMemberRole(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	user2Email := apitest.NewEmail()
+	user2Username := apitest.NewUsername()
+	user2 := apitest.Signup(t, client, conf, user2Username, user2Email)
+	ctx2 := common.NewOrgSlugContext(common.NewSessionContext(context.Background(), user2.Session), res.OrgInfo.Name)
+	invite, err := client.InviteToOrg(ctx, user2Email)
+	require.NoError(t, err)
+	_, err = http.Get(fmt.Sprintf("%s/consent/%s", conf.AddrGatewayURL, invite.Token))
+	require.NoError(t, err)
+
+	t.Run("as member", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx2, username, "read-only")
+		require.Error(t, err)
+	})
+
+	t.Run("bad role", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx, user2Username, "superuser")
+		require.Error(t, err)
+	})
+
+	t.Run("as owner", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx, user2Username, "read-only")
+		require.NoError(t, err)
+		org, err := client.GetOrg(ctx)
+		require.NoError(t, err)
+		for _, m := range org.OrgInfo.Members {
+			if m.Username == user2Username {
+				assert.Equal(t, "read-only", m.Role)
+			}
+		}
+	})
+
+	t.Run("read-only role", func(t *testing.T) {
+		_, err := client.CreateKey(ctx2, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.Error(t, err)
+		// Members who can't manage keys can't see their secrets
+		_, err = client.CreateKey(ctx, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.NoError(t, err)
+		_, err = client.ListKeys(ctx2)
+		require.Error(t, err)
+		_, err = client.InviteToOrg(ctx2, apitest.NewEmail())
+		require.Error(t, err)
+	})
+
+	t.Run("developer role", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx, user2Username, "developer")
+		require.NoError(t, err)
+		_, err = client.CreateKey(ctx2, pb.KeyType_KEY_TYPE_ACCOUNT, true)
+		require.NoError(t, err)
+		_, err = client.InviteToOrg(ctx2, apitest.NewEmail())
+		require.Error(t, err)
+	})
+
+	t.Run("admin role", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx, user2Username, "admin")
+		require.NoError(t, err)
+		err = client.SetOrgMemberRole(ctx2, username, "member")
+		require.Error(t, err) // Admins can't change owners
+		err = client.SetOrgMemberRole(ctx2, user2Username, "owner")
+		require.Error(t, err) // Admins can't make owners
+		err = client.RemoveOrg(ctx2)
+		require.Error(t, err)
+	})
+
+	t.Run("last owner", func(t *testing.T) {
+		err := client.SetOrgMemberRole(ctx, username, "admin")
+		require.Error(t, err)
+	})
+}
+
+func TestClient_RemoveOrgMember(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	user2Email := apitest.NewEmail()
+	user2Username := apitest.NewUsername()
+	user2 := apitest.Signup(t, client, conf, user2Username, user2Email)
+	ctx2 := common.NewOrgSlugContext(common.NewSessionContext(context.Background(), user2.Session), res.OrgInfo.Name)
+	invite, err := client.InviteToOrg(ctx, user2Email)
+	require.NoError(t, err)
+	_, err = http.Get(fmt.Sprintf("%s/consent/%s", conf.AddrGatewayURL, invite.Token))
+	require.NoError(t, err)
+
+	t.Run("as member", func(t *testing.T) {
+		err := client.RemoveOrgMember(ctx2, username)
+		require.Error(t, err)
+	})
+
+	t.Run("missing member", func(t *testing.T) {
+		err := client.RemoveOrgMember(ctx, apitest.NewUsername())
+		require.Error(t, err)
+	})
+
+	t.Run("as owner", func(t *testing.T) {
+		err := client.RemoveOrgMember(ctx, user2Username)
+		require.NoError(t, err)
+		_, err = client.GetOrg(ctx2)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_TransferOrgOwnership(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+
+	name := apitest.NewUsername()
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	user2Username := apitest.NewUsername()
+	user2 := apitest.Signup(t, client, conf, user2Username, apitest.NewEmail())
+	ctx2 := common.NewSessionContext(context.Background(), user2.Session)
+
+	t.Run("missing user", func(t *testing.T) {
+		_, err := client.TransferOrgOwnership(ctx, apitest.NewUsername())
+		require.Error(t, err)
+	})
+
+	t.Run("to self", func(t *testing.T) {
+		_, err := client.TransferOrgOwnership(ctx, username)
+		require.Error(t, err)
+	})
+
+	t.Run("accept without transfer", func(t *testing.T) {
+		_, err := client.AcceptOrgOwnership(ctx2, res.OrgInfo.Slug)
+		require.Error(t, err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		_, err := client.TransferOrgOwnership(ctx, user2Username)
+		require.NoError(t, err)
+		_, err = client.TransferOrgOwnership(ctx, "")
+		require.NoError(t, err)
+		_, err = client.AcceptOrgOwnership(ctx2, res.OrgInfo.Slug)
+		require.Error(t, err)
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		tres, err := client.TransferOrgOwnership(ctx, user2Username)
+		require.NoError(t, err)
+		assert.Greater(t, tres.ExpiresAt, time.Now().Unix())
+		org, err := client.GetOrg(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, org.OrgInfo.Transfer)
+		assert.Equal(t, username, org.OrgInfo.Transfer.FromUsername)
+		assert.Equal(t, user2Username, org.OrgInfo.Transfer.ToUsername)
+
+		ares, err := client.AcceptOrgOwnership(ctx2, res.OrgInfo.Slug)
+		require.NoError(t, err)
+		assert.Nil(t, ares.OrgInfo.Transfer)
+		roles := make(map[string]string)
+		for _, m := range ares.OrgInfo.Members {
+			roles[m.Username] = m.Role
+		}
+		assert.Equal(t, "owner", roles[user2Username])
+		assert.Equal(t, "admin", roles[username])
+	})
+
+	t.Run("last owner leave", func(t *testing.T) {
+		err := client.LeaveOrg(common.NewOrgSlugContext(ctx2, res.OrgInfo.Slug))
+		require.Error(t, err)
+	})
+
+	t.Run("previous owner leave", func(t *testing.T) {
+		err := client.LeaveOrg(ctx)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_SetOrgSSO(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t, nil)
+	provider := setupSSOProvider(t, apitest.NewEmail())
+
+	name := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	res, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, res.OrgInfo.Name)
+
+	err = client.SetOrgSSO(ctx, provider.Issuer(), oidctest.ClientID, oidctest.ClientSecret, true)
+	require.NoError(t, err)
+	got, err := client.GetOrgSSO(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, provider.Issuer(), got.Issuer)
+	assert.Equal(t, oidctest.ClientID, got.ClientId)
+	assert.True(t, got.Required)
+
+	t.Run("as owner without sso", func(t *testing.T) {
+		_, err := client.GetOrg(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("without invite", func(t *testing.T) {
+		provider.SetUser(oidctest.User{Subject: "2", Email: apitest.NewEmail(), EmailVerified: true})
+		u, err := client.GetSSOURL(context.Background(), name, ssoRedirectURI, oidc.Challenge(oidc.NewVerifier()), "")
+		require.NoError(t, err)
+		redirect, err := provider.Authorize(u.Url)
+		require.NoError(t, err)
+		_, err = client.SSOSignin(context.Background(), u.State, redirect.Query().Get("code"), oidc.NewVerifier())
+		require.Error(t, err)
+	})
+
+	t.Run("with invite", func(t *testing.T) {
+		email := apitest.NewEmail()
+		_, err := client.InviteToOrg(ctx, email)
+		require.NoError(t, err)
+		provider.SetUser(oidctest.User{Subject: "3", Email: email, EmailVerified: true})
+		res := ssoSignin(t, client, provider, name)
+		mctx := common.NewOrgSlugContext(common.NewSessionContext(context.Background(), res.Session), name)
+		_, err = client.GetOrg(mctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("as member without sso", func(t *testing.T) {
+		email := apitest.NewEmail()
+		member := apitest.Signup(t, client, conf, apitest.NewUsername(), email)
+		invite, err := client.InviteToOrg(ctx, email)
+		require.NoError(t, err)
+		_, err = http.Get(fmt.Sprintf("%s/consent/%s", conf.AddrGatewayURL, invite.Token))
+		require.NoError(t, err)
+		mctx := common.NewOrgSlugContext(common.NewSessionContext(context.Background(), member.Session), name)
+		_, err = client.GetOrg(mctx)
+		require.Error(t, err)
+
+		// The org's provider can't sign on to an existing account that didn't link it
+		provider.SetUser(oidctest.User{Subject: "4", Email: email, EmailVerified: true})
+		verifier := oidc.NewVerifier()
+		u, err := client.GetSSOURL(context.Background(), name, ssoRedirectURI, oidc.Challenge(verifier), "")
+		require.NoError(t, err)
+		redirect, err := provider.Authorize(u.Url)
+		require.NoError(t, err)
+		_, err = client.SSOSignin(context.Background(), u.State, redirect.Query().Get("code"), verifier)
+		require.Error(t, err)
+
+		verifier = oidc.NewVerifier()
+		sctx := common.NewSessionContext(context.Background(), member.Session)
+		u, err = client.GetSSOLinkURL(sctx, name, ssoRedirectURI, oidc.Challenge(verifier))
+		require.NoError(t, err)
+		redirect, err = provider.Authorize(u.Url)
+		require.NoError(t, err)
+		_, err = client.SSOSignin(context.Background(), u.State, redirect.Query().Get("code"), verifier)
+		require.NoError(t, err)
+
+		res := ssoSignin(t, client, provider, name)
+		assert.Equal(t, member.Key, res.Key)
+		mctx = common.NewOrgSlugContext(common.NewSessionContext(context.Background(), res.Session), name)
+		_, err = client.GetOrg(mctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := client.SetOrgSSO(ctx, "", "", "", false)
+		require.NoError(t, err)
+		got, err := client.GetOrgSSO(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, got.Issuer)
+	})
+}
+
 func TestClient_SetupBilling(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setupWithBilling(t)
@@ -439,6 +1267,80 @@ func TestClient_IsOrgNameAvailable(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestClient_ExportAccount(t *testing.T) {
+	t.Parallel()
+	conf, client, threadsclient := setup(t, nil)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+
+	// Threads created with an account key are tracked by both owner and key
+	key, err := client.CreateKey(ctx, pb.KeyType_KEY_TYPE_ACCOUNT, false)
+	require.NoError(t, err)
+	kctx := common.NewAPIKeyContext(context.Background(), key.KeyInfo.Key)
+	dbID := thread.NewIDV1(thread.Raw, 32)
+	err = threadsclient.NewDB(kctx, dbID)
+	require.NoError(t, err)
+
+	export, err := client.ExportAccount(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, export.Id)
+
+	id := export.Id
+	require.Eventually(t, func() bool {
+		export, err = client.GetExport(ctx, id)
+		require.NoError(t, err)
+		return export.Status == pb.ExportStatus_EXPORT_STATUS_COMPLETE
+	}, time.Minute, time.Second)
+	assert.Equal(t, export.Done, export.Total)
+	assert.NotZero(t, export.Size)
+
+	var buf bytes.Buffer
+	err = client.DownloadExport(ctx, export.Id, &buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, export.Size, buf.Len())
+
+	gr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	var names []string
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.NotContains(t, names, h.Name)
+		names = append(names, h.Name)
+		if h.Name == "threads.json" {
+			var threads []struct {
+				ID string `json:"id"`
+			}
+			err = json.NewDecoder(tr).Decode(&threads)
+			require.NoError(t, err)
+			var found int
+			for _, th := range threads {
+				if th.ID == dbID.String() {
+					found++
+				}
+			}
+			assert.Equal(t, 1, found)
+		}
+	}
+	assert.Contains(t, names, "account.json")
+	assert.Contains(t, names, "threads.json")
+	assert.Contains(t, names, "archives.json")
+
+	t.Run("other accounts", func(t *testing.T) {
+		other := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+		octx := common.NewSessionContext(context.Background(), other.Session)
+		_, err := client.GetExport(octx, export.Id)
+		require.Error(t, err)
+		err = client.DownloadExport(octx, export.Id, &bytes.Buffer{})
+		require.Error(t, err)
+	})
+}
+
 func TestClient_DestroyAccount(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t, nil)
@@ -506,3 +1408,29 @@ func setupWithBilling(t *testing.T) (core.Config, *c.Client, *tc.Client) {
 	conf.AddrBillingAPI = billingApi
 	return setup(t, &conf)
 }
+
+const ssoRedirectURI = "http://127.0.0.1:9999/callback"
+
+func setupSSOProvider(t *testing.T, email string) *oidctest.Server {
+	provider, err := oidctest.NewServer(oidctest.User{
+		Subject:       "1",
+		Email:         email,
+		EmailVerified: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(provider.Close)
+	return provider
+}
+
+func ssoSignin(t *testing.T, client *c.Client, provider *oidctest.Server, org string) *pb.SSOSigninResponse {
+	ctx := context.Background()
+	verifier := oidc.NewVerifier()
+	u, err := client.GetSSOURL(ctx, org, ssoRedirectURI, oidc.Challenge(verifier), "")
+	require.NoError(t, err)
+	redirect, err := provider.Authorize(u.Url)
+	require.NoError(t, err)
+	require.Equal(t, u.State, redirect.Query().Get("state"))
+	res, err := client.SSOSignin(ctx, u.State, redirect.Query().Get("code"), verifier)
+	require.NoError(t, err)
+	return res
+}
@@ -1,5 +1,7 @@
 package client
 
+import "time"
+
 type listOptions struct {
 	offset int64
 	limit  int64
@@ -20,3 +22,40 @@ func WithLimit(limit int64) ListOption {
 		args.limit = limit
 	}
 }
+
+type keyOptions struct {
+	scopes         []string
+	expiresAt      time.Time
+	allowedOrigins []string
+	allowedIPs     []string
+}
+
+type KeyOption func(*keyOptions)
+
+// WithScopes limits a key to some resources and actions, e.g., "buckets:read" or "buckets:write:<bucket-key>".
+func WithScopes(scopes ...string) KeyOption {
+	return func(args *keyOptions) {
+		args.scopes = scopes
+	}
+}
+
+// WithExpiry sets a time after which a key can no longer be used.
+func WithExpiry(t time.Time) KeyOption {
+	return func(args *keyOptions) {
+		args.expiresAt = t
+	}
+}
+
+// WithAllowedOrigins limits a key to requests from some browser origins, e.g., "https://example.com".
+func WithAllowedOrigins(origins ...string) KeyOption {
+	return func(args *keyOptions) {
+		args.allowedOrigins = origins
+	}
+}
+
+// WithAllowedIPs limits a key to requests from some IP addresses or CIDR ranges.
+func WithAllowedIPs(ips ...string) KeyOption {
+	return func(args *keyOptions) {
+		args.allowedIPs = ips
+	}
+}
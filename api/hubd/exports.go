@@ -0,0 +1,751 @@
+package hubd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+	"github.com/textileio/textile/v2/api/common"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	"github.com/textileio/textile/v2/buckets"
+	"github.com/textileio/textile/v2/car"
+	"github.com/textileio/textile/v2/mail"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// exportTimeout is the maximum duration of an export job.
+	exportTimeout = time.Hour
+	// exportFinishTimeout is the maximum duration of recording an export job's result.
+	exportFinishTimeout = time.Minute
+	// exportStaleAfter is how long an unfinished export can go without progress before its job
+	// is considered interrupted. A job can't take longer than its timeout to record progress or its result.
+	exportStaleAfter = exportTimeout + exportFinishTimeout
+	// exportChunkSize is the size of chunks sent when downloading an export.
+	exportChunkSize = 1024 * 32
+	// exportExt is the file extension of export archives.
+	exportExt = ".tar.gz"
+	// exportTmpExt is appended to export archives while they're written.
+	exportTmpExt = ".tmp"
+	// exportInterrupted is the error of exports whose job was interrupted.
+	exportInterrupted = "Export was interrupted"
+)
+
+func (s *Service) ExportAccount(ctx context.Context, _ *pb.ExportAccountRequest) (*pb.ExportAccountResponse, error) {
+	log.Debugf("received export account request")
+
+	account, err := s.getExportAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTwoFactor(ctx, account); err != nil {
+		return nil, err
+	}
+	owner := account.Owner()
+
+	// Only one export is kept per account, so running exports are reused and finished ones are replaced
+	exports, err := s.Collections.Exports.ListByOwner(ctx, owner.Key)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range exports {
+		if !e.Finished() && !exportStale(&e) {
+			return &pb.ExportAccountResponse{Export: exportToPb(&e)}, nil
+		}
+	}
+	for _, e := range exports {
+		if err := os.RemoveAll(s.exportPath(e.ID)); err != nil {
+			return nil, err
+		}
+		if err := s.Collections.Exports.Delete(ctx, e.ID); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+	}
+	s.pruneExports(ctx)
+
+	export, err := s.Collections.Exports.Create(ctx, owner.Key, s.Replica)
+	if err != nil {
+		return nil, err
+	}
+	go s.runExport(export.ID, owner)
+	return &pb.ExportAccountResponse{Export: exportToPb(export)}, nil
+}
+
+func (s *Service) GetExport(ctx context.Context, req *pb.GetExportRequest) (*pb.GetExportResponse, error) {
+	log.Debugf("received get export request")
+
+	export, err := s.getExport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetExportResponse{Export: exportToPb(export)}, nil
+}
+
+func (s *Service) DownloadExport(req *pb.DownloadExportRequest, server pb.APIService_DownloadExportServer) error {
+	log.Debugf("received download export request")
+
+	export, err := s.getExport(server.Context(), req.Id)
+	if err != nil {
+		return err
+	}
+	if export.Status != mdb.ExportComplete {
+		return status.Error(codes.FailedPrecondition, "Export is not complete")
+	}
+	file, err := os.Open(s.exportPath(export.ID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The archive was written to the export directory of the replica that ran the job
+			if export.Replica != s.Replica {
+				return status.Error(codes.FailedPrecondition, "Export is stored by another replica; the export directory must be shared by all replicas")
+			}
+			return status.Error(codes.NotFound, "Export not found")
+		}
+		return err
+	}
+	defer file.Close()
+
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := server.Send(&pb.DownloadExportResponse{
+				Chunk: buf[:n],
+			}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getExportAccount returns the session account if it can be exported.
+// Devs can export their own account, and org owners can export the org.
+func (s *Service) getExportAccount(ctx context.Context) (*mdb.AccountCtx, error) {
+	if s.ExportDir == "" {
+		return nil, status.Error(codes.Unimplemented, "Account exports are not enabled")
+	}
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.User == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	if account.Org != nil {
+		return s.getOrgOwner(ctx)
+	}
+	return account, nil
+}
+
+// getExport returns the export with id if it belongs to the session account.
+func (s *Service) getExport(ctx context.Context, id string) (*mdb.Export, error) {
+	account, err := s.getExportAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export, err := s.Collections.Exports.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Export not found")
+		}
+		return nil, err
+	}
+	if !account.Owner().Key.Equals(export.Owner) {
+		return nil, status.Error(codes.NotFound, "Export not found")
+	}
+	if exportStale(export) {
+		err := s.Collections.Exports.Fail(ctx, export.ID, exportInterrupted)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Another replica finished the export in the meantime
+			return s.Collections.Exports.Get(ctx, export.ID)
+		} else if err != nil {
+			return nil, err
+		}
+		export.Status = mdb.ExportFailed
+		export.Error = exportInterrupted
+	}
+	return export, nil
+}
+
+// exportStale returns whether export is unfinished but its job was interrupted.
+func exportStale(export *mdb.Export) bool {
+	return !export.Finished() && time.Since(export.UpdatedAt) > exportStaleAfter
+}
+
+// exportPath returns the archive path of the export with id.
+func (s *Service) exportPath(id string) string {
+	return filepath.Join(s.ExportDir, id+exportExt)
+}
+
+// pruneExports removes archives of exports that have expired
+// and partial archives left by interrupted jobs.
+func (s *Service) pruneExports(ctx context.Context) {
+	entries, err := ioutil.ReadDir(s.ExportDir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		var id string
+		tmp := strings.HasSuffix(e.Name(), exportExt+exportTmpExt)
+		if tmp {
+			id = strings.TrimSuffix(e.Name(), exportExt+exportTmpExt)
+		} else if strings.HasSuffix(e.Name(), exportExt) {
+			id = strings.TrimSuffix(e.Name(), exportExt)
+		} else {
+			continue
+		}
+		export, err := s.Collections.Exports.Get(ctx, id)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		// Partial archives are only kept while their job is running
+		if err == nil && (!tmp || !export.Finished() && !exportStale(export)) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.ExportDir, e.Name())); err != nil {
+			log.Errorf("removing expired export %s: %v", id, err)
+		}
+	}
+}
+
+// runExport writes the export archive for owner, recording progress as it goes.
+func (s *Service) runExport(id string, owner *mdb.Account) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	ctx = common.NewSessionContext(ctx, s.InternalSession)
+
+	size, err := s.writeExport(ctx, id, owner)
+
+	// The job's context may have timed out, so the result is recorded with a fresh one
+	fctx, fcancel := context.WithTimeout(context.Background(), exportFinishTimeout)
+	defer fcancel()
+	if err != nil {
+		log.Errorf("exporting %s: %v", owner.Username, err)
+		if err := s.Collections.Exports.Fail(fctx, id, err.Error()); err != nil {
+			log.Errorf("failing export %s: %v", id, err)
+		}
+		return
+	}
+	if err := s.Collections.Exports.Complete(fctx, id, size); err != nil {
+		log.Errorf("completing export %s: %v", id, err)
+		// The export was failed as stale by another replica, so its archive won't be downloaded
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			_ = os.Remove(s.exportPath(id))
+		}
+	}
+}
+
+// writeExport writes the export archive and returns its size.
+func (s *Service) writeExport(ctx context.Context, id string, owner *mdb.Account) (int64, error) {
+	if err := os.MkdirAll(s.ExportDir, os.ModePerm); err != nil {
+		return 0, err
+	}
+	tmp := s.exportPath(id) + exportTmpExt
+	file, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		file.Close()
+		_ = os.Remove(tmp)
+	}()
+	gw := gzip.NewWriter(file)
+	ew := &exportWriter{
+		tw:    tar.NewWriter(gw),
+		dir:   s.ExportDir,
+		start: time.Now(),
+	}
+
+	// Fixed steps are the account, threads, mail, and archives; each bucket is another step.
+	total := 4
+	done := 0
+	progress := func(stage string) error {
+		return s.Collections.Exports.SetProgress(ctx, id, stage, done, total)
+	}
+
+	if err := progress("Exporting account"); err != nil {
+		return 0, err
+	}
+	if err := s.exportAccount(ctx, ew, owner); err != nil {
+		return 0, fmt.Errorf("exporting account: %v", err)
+	}
+	done++
+
+	if err := progress("Exporting threads"); err != nil {
+		return 0, err
+	}
+	bucks, err := s.exportThreads(ctx, ew, owner)
+	if err != nil {
+		return 0, fmt.Errorf("exporting threads: %v", err)
+	}
+	done++
+	total += len(bucks)
+
+	for _, b := range bucks {
+		if err := progress(fmt.Sprintf("Exporting bucket %s", b.Name)); err != nil {
+			return 0, err
+		}
+		if err := s.exportBucket(ctx, ew, b); err != nil {
+			return 0, fmt.Errorf("exporting bucket %s: %v", b.Key, err)
+		}
+		done++
+	}
+
+	if err := progress("Exporting mail"); err != nil {
+		return 0, err
+	}
+	if err := s.exportMail(ctx, ew, owner); err != nil {
+		return 0, fmt.Errorf("exporting mail: %v", err)
+	}
+	done++
+
+	if err := progress("Exporting archives"); err != nil {
+		return 0, err
+	}
+	if err := s.exportArchives(ctx, ew, bucks); err != nil {
+		return 0, fmt.Errorf("exporting archives: %v", err)
+	}
+	done++
+
+	if err := ew.tw.Close(); err != nil {
+		return 0, err
+	}
+	if err := gw.Close(); err != nil {
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, s.exportPath(id)); err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+type exportProfile struct {
+	Key              string         `json:"key"`
+	Type             string         `json:"type"`
+	Name             string         `json:"name,omitempty"`
+	Username         string         `json:"username"`
+	Email            string         `json:"email,omitempty"`
+	Members          []exportMember `json:"members,omitempty"`
+	Orgs             []exportOrg    `json:"orgs,omitempty"`
+	Keys             []exportAPIKey `json:"keys"`
+	TwoFactorEnabled bool           `json:"two_factor_enabled,omitempty"`
+	RequireTwoFactor bool           `json:"require_two_factor,omitempty"`
+	SSO              *exportSSO     `json:"sso,omitempty"`
+	PowInfo          *exportPowInfo `json:"pow_info,omitempty"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+type exportMember struct {
+	Key      string `json:"key"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+type exportOrg struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Role string `json:"role"`
+}
+
+type exportAPIKey struct {
+	Key            string    `json:"key"`
+	Type           string    `json:"type"`
+	Secure         bool      `json:"secure"`
+	Valid          bool      `json:"valid"`
+	Scopes         []string  `json:"scopes,omitempty"`
+	AllowedOrigins []string  `json:"allowed_origins,omitempty"`
+	AllowedIPs     []string  `json:"allowed_ips,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at,omitempty"`
+	LastUsedAt     time.Time `json:"last_used_at,omitempty"`
+}
+
+type exportSSO struct {
+	Issuer   string `json:"issuer"`
+	ClientID string `json:"client_id"`
+	Required bool   `json:"required"`
+}
+
+type exportPowInfo struct {
+	ID string `json:"id"`
+}
+
+type exportThread struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name,omitempty"`
+	Key       string    `json:"key,omitempty"`
+	IsDB      bool      `json:"is_db"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// exportBucket is a bucket and the thread it belongs to.
+type exportBucket struct {
+	tdb.Bucket
+	Thread string `json:"thread"`
+}
+
+type exportArchive struct {
+	BucketKey string             `json:"bucket_key"`
+	Current   *exportArchiveJob  `json:"current,omitempty"`
+	History   []exportArchiveJob `json:"history,omitempty"`
+	Config    *mdb.ArchiveConfig `json:"default_archive_config,omitempty"`
+}
+
+type exportArchiveJob struct {
+	Cid        string         `json:"cid"`
+	JobID      string         `json:"job_id"`
+	Status     int            `json:"status"`
+	Aborted    bool           `json:"aborted,omitempty"`
+	AbortedMsg string         `json:"aborted_msg,omitempty"`
+	FailureMsg string         `json:"failure_msg,omitempty"`
+	CreatedAt  int64          `json:"created_at"`
+	DealInfo   []mdb.DealInfo `json:"deal_info,omitempty"`
+}
+
+// exportAccount writes the account profile, org memberships, and API key metadata.
+// Secrets, including API key secrets and thread tokens, are not exported.
+func (s *Service) exportAccount(ctx context.Context, ew *exportWriter, a *mdb.Account) error {
+	profile := exportProfile{
+		Key:              a.Key.String(),
+		Name:             a.Name,
+		Username:         a.Username,
+		Email:            a.Email,
+		TwoFactorEnabled: a.TwoFactorEnabled(),
+		RequireTwoFactor: a.RequireTwoFactor,
+		CreatedAt:        a.CreatedAt,
+	}
+	switch a.Type {
+	case mdb.Dev:
+		profile.Type = "developer"
+		orgs, err := s.Collections.Accounts.ListByMember(ctx, a.Key)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			role, _ := org.MemberRole(a.Key)
+			profile.Orgs = append(profile.Orgs, exportOrg{
+				Key:  org.Key.String(),
+				Name: org.Name,
+				Slug: org.Username,
+				Role: role.String(),
+			})
+		}
+	case mdb.Org:
+		profile.Type = "organization"
+		for _, m := range a.Members {
+			profile.Members = append(profile.Members, exportMember{
+				Key:      m.Key.String(),
+				Username: m.Username,
+				Role:     m.Role.String(),
+			})
+		}
+	}
+	if a.SSO != nil {
+		profile.SSO = &exportSSO{
+			Issuer:   a.SSO.Issuer,
+			ClientID: a.SSO.ClientID,
+			Required: a.SSO.Required,
+		}
+	}
+	if a.PowInfo != nil {
+		profile.PowInfo = &exportPowInfo{ID: a.PowInfo.ID}
+	}
+
+	keys, err := s.Collections.APIKeys.ListByOwner(ctx, a.Key)
+	if err != nil {
+		return err
+	}
+	profile.Keys = make([]exportAPIKey, len(keys))
+	for i, k := range keys {
+		t, err := keyTypeToPb(k.Type)
+		if err != nil {
+			return err
+		}
+		profile.Keys[i] = exportAPIKey{
+			Key:            k.Key,
+			Type:           strings.ToLower(strings.TrimPrefix(t.String(), "KEY_TYPE_")),
+			Secure:         k.Secure,
+			Valid:          k.Valid,
+			Scopes:         k.Scopes,
+			AllowedOrigins: k.AllowedOrigins,
+			AllowedIPs:     k.AllowedIPs,
+			CreatedAt:      k.CreatedAt,
+			ExpiresAt:      k.ExpiresAt,
+			LastUsedAt:     k.LastUsedAt,
+		}
+	}
+	return ew.addJSON("account.json", profile)
+}
+
+// exportThreads writes the thread list and returns the buckets in thread DBs.
+func (s *Service) exportThreads(ctx context.Context, ew *exportWriter, a *mdb.Account) ([]exportBucket, error) {
+	// Collect threads owned directly or via an API key
+	ts, err := s.Collections.Threads.ListByOwner(ctx, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.Collections.APIKeys.ListByOwner(ctx, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	// Threads created with an account key are listed by both owner and key
+	seen := make(map[thread.ID]struct{}, len(ts))
+	for _, t := range ts {
+		seen[t.ID] = struct{}{}
+	}
+	for _, k := range keys {
+		kts, err := s.Collections.Threads.ListByKey(ctx, k.Key)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range kts {
+			if _, ok := seen[t.ID]; ok {
+				continue
+			}
+			seen[t.ID] = struct{}{}
+			ts = append(ts, t)
+		}
+	}
+
+	list := make([]exportThread, len(ts))
+	var bucks []exportBucket
+	for i, t := range ts {
+		list[i] = exportThread{
+			ID:        t.ID.String(),
+			Name:      t.Name,
+			Key:       t.Key,
+			IsDB:      t.IsDB,
+			CreatedAt: t.CreatedAt,
+		}
+		if !t.IsDB || t.Name == mail.ThreadName {
+			continue
+		}
+		res, err := s.Threads.Find(
+			ctx,
+			t.ID,
+			buckets.CollectionName,
+			&db.Query{},
+			&tdb.Bucket{},
+			db.WithTxnToken(a.Token),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range res.([]*tdb.Bucket) {
+			bucks = append(bucks, exportBucket{Bucket: *b, Thread: t.ID.String()})
+		}
+	}
+	if err := ew.addJSON("threads.json", list); err != nil {
+		return nil, err
+	}
+	return bucks, nil
+}
+
+// exportBucket writes a bucket's instance and its DAG as a CAR.
+// Encrypted buckets are exported as is; the bucket's key is in the instance.
+func (s *Service) exportBucket(ctx context.Context, ew *exportWriter, b exportBucket) error {
+	if err := ew.addJSON(fmt.Sprintf("buckets/%s.json", b.Key), b); err != nil {
+		return err
+	}
+	resolved, err := s.IPFSClient.ResolvePath(ctx, path.New(b.Path))
+	if err != nil {
+		return err
+	}
+	return ew.addFile(fmt.Sprintf("buckets/%s.car", b.Key), func(w io.Writer) error {
+		return car.Write(ctx, w, s.IPFSClient.Dag(), resolved.Cid())
+	})
+}
+
+// exportMail writes the account's inbox and sentbox messages, if it has a mailbox.
+func (s *Service) exportMail(ctx context.Context, ew *exportWriter, a *mdb.Account) error {
+	thrd, err := s.Collections.Threads.GetByName(ctx, mail.ThreadName, a.Key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+	inbox, err := s.Threads.Find(
+		ctx,
+		thrd.ID,
+		mail.InboxCollectionName,
+		&db.Query{},
+		&tdb.InboxMessage{},
+		db.WithTxnToken(a.Token),
+	)
+	if err != nil {
+		return err
+	}
+	if err := ew.addJSON("mail/inbox.json", inbox); err != nil {
+		return err
+	}
+	sentbox, err := s.Threads.Find(
+		ctx,
+		thrd.ID,
+		mail.SentboxCollectionName,
+		&db.Query{},
+		&tdb.SentboxMessage{},
+		db.WithTxnToken(a.Token),
+	)
+	if err != nil {
+		return err
+	}
+	return ew.addJSON("mail/sentbox.json", sentbox)
+}
+
+// exportArchives writes the Filecoin archive and deal history of bucks.
+func (s *Service) exportArchives(ctx context.Context, ew *exportWriter, bucks []exportBucket) error {
+	list := []exportArchive{}
+	for _, b := range bucks {
+		ba, err := s.Collections.BucketArchives.Get(ctx, b.Key)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			return err
+		}
+		a := exportArchive{
+			BucketKey: ba.BucketKey,
+			Config:    ba.DefaultArchiveConfig,
+		}
+		if len(ba.Archives.Current.JobID) != 0 {
+			current := exportArchiveJobFromArchive(ba.Archives.Current)
+			a.Current = &current
+		}
+		for _, h := range ba.Archives.History {
+			a.History = append(a.History, exportArchiveJobFromArchive(h))
+		}
+		list = append(list, a)
+	}
+	return ew.addJSON("archives.json", list)
+}
+
+func exportArchiveJobFromArchive(a mdb.Archive) exportArchiveJob {
+	var c string
+	if _, id, err := cid.CidFromBytes(a.Cid); err == nil {
+		c = id.String()
+	}
+	return exportArchiveJob{
+		Cid:        c,
+		JobID:      a.JobID,
+		Status:     a.Status,
+		Aborted:    a.Aborted,
+		AbortedMsg: a.AbortedMsg,
+		FailureMsg: a.FailureMsg,
+		CreatedAt:  a.CreatedAt,
+		DealInfo:   a.DealInfo,
+	}
+}
+
+// exportWriter adds files to an export archive.
+type exportWriter struct {
+	tw    *tar.Writer
+	dir   string
+	start time.Time
+}
+
+// addJSON adds v as an indented JSON file.
+func (w *exportWriter) addJSON(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := w.tw.WriteHeader(w.header(name, int64(len(data)))); err != nil {
+		return err
+	}
+	_, err = w.tw.Write(data)
+	return err
+}
+
+// addFile adds a file written by write.
+// Tar headers need the file size, so the file is staged on disk first.
+func (w *exportWriter) addFile(name string, write func(io.Writer) error) error {
+	tmp, err := ioutil.TempFile(w.dir, "export-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	if err := write(tmp); err != nil {
+		return err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := w.tw.WriteHeader(w.header(name, size)); err != nil {
+		return err
+	}
+	_, err = io.Copy(w.tw, tmp)
+	return err
+}
+
+func (w *exportWriter) header(name string, size int64) *tar.Header {
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  w.start,
+	}
+}
+
+func exportToPb(e *mdb.Export) *pb.ExportInfo {
+	var s pb.ExportStatus
+	switch e.Status {
+	case mdb.ExportPending:
+		s = pb.ExportStatus_EXPORT_STATUS_PENDING
+	case mdb.ExportRunning:
+		s = pb.ExportStatus_EXPORT_STATUS_RUNNING
+	case mdb.ExportComplete:
+		s = pb.ExportStatus_EXPORT_STATUS_COMPLETE
+	case mdb.ExportFailed:
+		s = pb.ExportStatus_EXPORT_STATUS_FAILED
+	}
+	return &pb.ExportInfo{
+		Id:        e.ID,
+		Status:    s,
+		Stage:     e.Stage,
+		Done:      int32(e.Done),
+		Total:     int32(e.Total),
+		Size:      e.Size,
+		Error:     e.Error,
+		CreatedAt: e.CreatedAt.Unix(),
+		ExpiresAt: e.ExpiresAt.Unix(),
+	}
+}
@@ -0,0 +1,263 @@
+package hubd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	coredb "github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	"github.com/textileio/textile/v2/mail"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	tdb "github.com/textileio/textile/v2/threaddb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inviteMessageType identifies org invites in a user's mailbox.
+const inviteMessageType = "org_invite"
+
+// inviteMessage is the body of an org invite sent to a user's mailbox.
+type inviteMessage struct {
+	Type      string `json:"type"`
+	Org       string `json:"org"`
+	OrgName   string `json:"org_name"`
+	From      string `json:"from"`
+	Link      string `json:"link"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+func (s *Service) ListInvites(ctx context.Context, _ *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	log.Debugf("received list invites request")
+
+	account, err := getInviteAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := s.Collections.Invites.ListByOrg(ctx, account.Org.Username)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.InviteInfo, len(invites))
+	for i := range invites {
+		list[i], err = inviteToPb(&invites[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &pb.ListInvitesResponse{List: list}, nil
+}
+
+func (s *Service) ResendInvite(ctx context.Context, req *pb.ResendInviteRequest) (*pb.ResendInviteResponse, error) {
+	log.Debugf("received resend invite request")
+
+	account, err := getInviteAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invite, err := s.getOrgInvite(ctx, account, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if invite.Accepted {
+		return nil, status.Error(codes.FailedPrecondition, "Invite was already accepted")
+	}
+	invite, err = s.Collections.Invites.Renew(ctx, invite.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sendInvite(ctx, account, invite); err != nil {
+		return nil, err
+	}
+	info, err := inviteToPb(invite)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResendInviteResponse{Invite: info}, nil
+}
+
+func (s *Service) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	log.Debugf("received revoke invite request")
+
+	account, err := getInviteAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invite, err := s.getOrgInvite(ctx, account, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	// Members can revoke their own invites, but it takes a member manager to revoke others
+	if !invite.From.Equals(account.User.Key) {
+		if err := requireOrgPermission(account, mdb.PermManageMembers); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeInviteResponse{}, nil
+}
+
+// getInviteAccount returns the account context if the user can invite members to the org.
+func getInviteAccount(ctx context.Context) (*mdb.AccountCtx, error) {
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.User == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	if account.Org == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
+	}
+	if err := requireOrgPermission(account, mdb.PermInviteMembers); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// getOrgInvite returns the invite with token if it belongs to the account's org.
+func (s *Service) getOrgInvite(ctx context.Context, account *mdb.AccountCtx, token string) (*mdb.Invite, error) {
+	invite, err := s.Collections.Invites.Get(ctx, token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Invite not found")
+		}
+		return nil, err
+	}
+	if invite.Org != account.Org.Username {
+		return nil, status.Error(codes.NotFound, "Invite not found")
+	}
+	return invite, nil
+}
+
+// createUsernameInvite creates an invite for an existing user who can receive it in their mailbox.
+func (s *Service) createUsernameInvite(ctx context.Context, account *mdb.AccountCtx, username string) (*mdb.Invite, error) {
+	dev, err := s.getDevByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	isMember, err := s.Collections.Accounts.IsMember(ctx, account.Org.Username, dev.Key)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, status.Error(codes.AlreadyExists, "User is already an org member")
+	}
+	if _, err := s.getMailbox(ctx, dev.Key); err != nil {
+		return nil, err
+	}
+	return s.Collections.Invites.CreateForUsername(ctx, account.User.Key, account.Org.Username, dev.Username)
+}
+
+// sendInvite delivers an invite by email, or to the user's mailbox for username invites.
+func (s *Service) sendInvite(ctx context.Context, account *mdb.AccountCtx, invite *mdb.Invite) error {
+	if invite.UsernameTo == "" {
+		ectx, cancel := context.WithTimeout(ctx, emailTimeout)
+		defer cancel()
+		return s.EmailClient.InviteAddress(
+			ectx,
+			account.User.Key.String(),
+			account.Org.Name,
+			account.User.Email,
+			invite.EmailTo,
+			s.GatewayURL,
+			invite.Token,
+		)
+	}
+
+	dev, err := s.getDevByUsername(ctx, invite.UsernameTo)
+	if err != nil {
+		return err
+	}
+	inbox, err := s.getMailbox(ctx, dev.Key)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(&inviteMessage{
+		Type:      inviteMessageType,
+		Org:       account.Org.Username,
+		OrgName:   account.Org.Name,
+		From:      account.User.Username,
+		Link:      fmt.Sprintf("%s/consent/%s", s.GatewayURL, invite.Token),
+		ExpiresAt: invite.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	// The message is from the org so that the user can verify it with the org's key
+	toBody, err := dev.Key.Encrypt(body)
+	if err != nil {
+		return err
+	}
+	toSig, err := account.Org.Secret.Sign(ctx, toBody)
+	if err != nil {
+		return err
+	}
+	msg := tdb.InboxMessage{
+		ID:        coredb.NewInstanceID().String(),
+		From:      account.Org.Key.String(),
+		To:        dev.Key.String(),
+		Body:      base64.StdEncoding.EncodeToString(toBody),
+		Signature: base64.StdEncoding.EncodeToString(toSig),
+		CreatedAt: time.Now().UnixNano(),
+	}
+	_, err = s.Mail.Inbox.Create(ctx, inbox, msg, tdb.WithToken(account.Org.Token))
+	return err
+}
+
+// getDevByUsername returns the developer account with username.
+func (s *Service) getDevByUsername(ctx context.Context, username string) (*mdb.Account, error) {
+	dev, err := s.Collections.Accounts.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		return nil, err
+	}
+	if dev.Type != mdb.Dev {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	return dev, nil
+}
+
+// getMailbox returns the mailbox thread for key.
+func (s *Service) getMailbox(ctx context.Context, key thread.PubKey) (thread.ID, error) {
+	if s.Mail == nil {
+		return thread.Undef, status.Error(codes.Unimplemented, "Mail is not enabled")
+	}
+	thrd, err := s.Collections.Threads.GetByName(ctx, mail.ThreadName, key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return thread.Undef, status.Error(codes.FailedPrecondition, "User has not set up a mailbox")
+		}
+		return thread.Undef, err
+	}
+	return thrd.ID, nil
+}
+
+func inviteToPb(invite *mdb.Invite) (*pb.InviteInfo, error) {
+	from, err := invite.From.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.InviteInfo{
+		Token:      invite.Token,
+		Org:        invite.Org,
+		From:       from,
+		EmailTo:    invite.EmailTo,
+		UsernameTo: invite.UsernameTo,
+		Accepted:   invite.Accepted,
+		ExpiresAt:  invite.ExpiresAt.Unix(),
+	}
+	if !invite.SentAt.IsZero() {
+		info.SentAt = invite.SentAt.Unix()
+	}
+	return info, nil
+}
@@ -0,0 +1,81 @@
+package hubd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxKeyRotationGrace is the longest a rotated key secret can stay valid.
+const maxKeyRotationGrace = 30 * 24 * time.Hour
+
+// keyOptionsFromPb validates and returns the restrictions for a new key.
+func keyOptionsFromPb(req *pb.CreateKeyRequest) (opts mdb.APIKeyOptions, err error) {
+	for _, scope := range req.Scopes {
+		if err := mdb.ValidateScope(scope); err != nil {
+			return opts, err
+		}
+	}
+	opts.Scopes = req.Scopes
+	if req.ExpiresAt != 0 {
+		opts.ExpiresAt = time.Unix(req.ExpiresAt, 0)
+		if opts.ExpiresAt.Before(time.Now()) {
+			return opts, fmt.Errorf("expiry must be in the future")
+		}
+	}
+	for _, origin := range req.AllowedOrigins {
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+			return opts, fmt.Errorf("invalid origin %s", origin)
+		}
+		opts.AllowedOrigins = append(opts.AllowedOrigins, u.Scheme+"://"+u.Host)
+	}
+	for _, ip := range req.AllowedIps {
+		if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+			return opts, fmt.Errorf("invalid IP address or CIDR range %s", ip)
+		}
+	}
+	opts.AllowedIPs = req.AllowedIps
+	return opts, nil
+}
+
+func keyToPb(key *mdb.APIKey, threads int32) (*pb.KeyInfo, error) {
+	t, err := keyTypeToPb(key.Type)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "mapping key type: %v", key.Type)
+	}
+	info := &pb.KeyInfo{
+		Key:            key.Key,
+		Secret:         key.Secret,
+		Type:           t,
+		Valid:          key.Valid,
+		Threads:        threads,
+		Secure:         key.Secure,
+		Scopes:         key.Scopes,
+		AllowedOrigins: key.AllowedOrigins,
+		AllowedIps:     key.AllowedIPs,
+	}
+	if !key.ExpiresAt.IsZero() {
+		info.ExpiresAt = key.ExpiresAt.Unix()
+	}
+	if !key.LastUsedAt.IsZero() {
+		info.LastUsedAt = key.LastUsedAt.Unix()
+	}
+	if !key.RotatedAt.IsZero() {
+		info.RotatedAt = key.RotatedAt.Unix()
+	}
+	if key.PreviousSecretValid() {
+		info.PreviousSecretExpiresAt = key.PreviousSecretExpiresAt.Unix()
+		if !key.PreviousSecretLastUsedAt.IsZero() {
+			info.PreviousSecretLastUsedAt = key.PreviousSecretLastUsedAt.Unix()
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,243 @@
+package hubd
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/textileio/textile/v2/api/billingd/analytics"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orgTransferDur is how long a pending org ownership transfer can be accepted.
+const orgTransferDur = 7 * 24 * time.Hour
+
+func (s *Service) SetOrgMemberRole(
+	ctx context.Context,
+	req *pb.SetOrgMemberRoleRequest,
+) (*pb.SetOrgMemberRoleResponse, error) {
+	log.Debugf("received set org member role request")
+
+	role, err := mdb.ParseRole(req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	account, member, err := s.getOrgMemberToManage(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if role == mdb.OrgOwner {
+		if err := requireOrgOwner(account); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Collections.Accounts.SetMemberRole(ctx, account.Org.Username, member.Key, role); err != nil {
+		return nil, orgMemberError(err)
+	}
+	return &pb.SetOrgMemberRoleResponse{}, nil
+}
+
+func (s *Service) RemoveOrgMember(
+	ctx context.Context,
+	req *pb.RemoveOrgMemberRequest,
+) (*pb.RemoveOrgMemberResponse, error) {
+	log.Debugf("received remove org member request")
+
+	account, member, err := s.getOrgMemberToManage(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Collections.Accounts.RemoveMember(ctx, account.Org.Username, member.Key); err != nil {
+		return nil, orgMemberError(err)
+	}
+	if err := s.Collections.Invites.DeleteByFromAndOrg(ctx, member.Key, account.Org.Username); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveOrgMemberResponse{}, nil
+}
+
+func (s *Service) TransferOrgOwnership(
+	ctx context.Context,
+	req *pb.TransferOrgOwnershipRequest,
+) (*pb.TransferOrgOwnershipResponse, error) {
+	log.Debugf("received transfer org ownership request")
+
+	account, err := s.getOrgOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" {
+		if err := s.Collections.Accounts.SetTransfer(ctx, account.Org.Key, nil); err != nil {
+			return nil, err
+		}
+		return &pb.TransferOrgOwnershipResponse{}, nil
+	}
+	dev, err := s.getDevByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if role, ok := account.Org.MemberRole(dev.Key); ok && role == mdb.OrgOwner {
+		return nil, status.Error(codes.FailedPrecondition, "User is already an org owner")
+	}
+	transfer := &mdb.OwnershipTransfer{
+		From:       account.User.Key,
+		To:         dev.Key,
+		ToUsername: dev.Username,
+		ExpiresAt:  time.Now().Add(orgTransferDur),
+	}
+	if err := s.Collections.Accounts.SetTransfer(ctx, account.Org.Key, transfer); err != nil {
+		return nil, err
+	}
+	return &pb.TransferOrgOwnershipResponse{ExpiresAt: transfer.ExpiresAt.Unix()}, nil
+}
+
+func (s *Service) AcceptOrgOwnership(
+	ctx context.Context,
+	req *pb.AcceptOrgOwnershipRequest,
+) (*pb.AcceptOrgOwnershipResponse, error) {
+	log.Debugf("received accept org ownership request")
+
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.User == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	org, err := s.Collections.Accounts.ClaimTransfer(ctx, req.Org, account.User.Key)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, status.Error(codes.NotFound, "Ownership transfer not found or expired")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Add the new owner before demoting the previous one so the org is never without an owner
+	if _, ok := org.MemberRole(account.User.Key); ok {
+		err = s.Collections.Accounts.SetMemberRole(ctx, org.Username, account.User.Key, mdb.OrgOwner)
+	} else {
+		err = s.Collections.Accounts.AddMember(ctx, org.Username, mdb.Member{
+			Key:      account.User.Key,
+			Username: account.User.Username,
+			Role:     mdb.OrgOwner,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if role, ok := org.MemberRole(org.Transfer.From); ok && role == mdb.OrgOwner {
+		err := s.Collections.Accounts.SetMemberRole(ctx, org.Username, org.Transfer.From, mdb.OrgAdmin)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+	}
+
+	// Org threads, keys, and billing belong to the org's key, but billing uses an owner's email
+	if s.BillingClient != nil {
+		err := s.BillingClient.UpdateCustomerEmail(ctx, org.Key, account.User.Email)
+		if err != nil && !strings.Contains(err.Error(), mongo.ErrNoDocuments.Error()) {
+			return nil, err
+		}
+		s.BillingClient.Identify(ctx, org.Key, org.Type, true, account.User.Email, map[string]string{
+			"username":          org.Username,
+			"owner":             account.User.Key.String(),
+			"owner_username":    account.User.Username,
+			"previous_owner":    org.Transfer.From.String(),
+			"ownership_sent_at": strconv.FormatInt(org.Transfer.ExpiresAt.Add(-orgTransferDur).Unix(), 10),
+		})
+		s.BillingClient.TrackEvent(
+			ctx,
+			org.Key,
+			org.Type,
+			true,
+			analytics.OrgOwnershipTransferred,
+			map[string]string{
+				"member":          account.User.Key.String(),
+				"member_username": account.User.Username,
+				"member_email":    account.User.Email,
+				"previous_owner":  org.Transfer.From.String(),
+			},
+		)
+	}
+
+	org, err = s.Collections.Accounts.Get(ctx, org.Key)
+	if err != nil {
+		return nil, err
+	}
+	orgInfo, err := s.orgToPbOrg(org)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to encode OrgInfo: %v", err)
+	}
+	return &pb.AcceptOrgOwnershipResponse{OrgInfo: orgInfo}, nil
+}
+
+// getOrgMemberToManage returns the account context and the org member with username
+// if the user's role can manage that member. Only owners can manage other owners.
+func (s *Service) getOrgMemberToManage(ctx context.Context, username string) (*mdb.AccountCtx, *mdb.Member, error) {
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account.User == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	if account.Org == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
+	}
+	if err := requireOrgPermission(account, mdb.PermManageMembers); err != nil {
+		return nil, nil, err
+	}
+	var member *mdb.Member
+	for i, m := range account.Org.Members {
+		if m.Username == username {
+			member = &account.Org.Members[i]
+			break
+		}
+	}
+	if member == nil {
+		return nil, nil, status.Error(codes.NotFound, "Member not found")
+	}
+	if member.Role == mdb.OrgOwner {
+		if err := requireOrgOwner(account); err != nil {
+			return nil, nil, err
+		}
+	}
+	return account, member, nil
+}
+
+// requireOrgPermission returns an error if the user's org role doesn't have permission p.
+// Accounts that aren't acting as an org member, e.g., API keys, aren't limited by roles.
+func requireOrgPermission(account *mdb.AccountCtx, p mdb.Permission) error {
+	if account.User == nil || account.Org == nil {
+		return nil
+	}
+	role, ok := account.Org.MemberRole(account.User.Key)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "User is not an org member")
+	}
+	if !role.Allows(p) {
+		return status.Errorf(codes.PermissionDenied, "Org role %s does not allow this action", role)
+	}
+	return nil
+}
+
+func requireOrgOwner(account *mdb.AccountCtx) error {
+	if role, ok := account.Org.MemberRole(account.User.Key); !ok || role != mdb.OrgOwner {
+		return status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	return nil
+}
+
+func orgMemberError(err error) error {
+	if errors.Is(err, mdb.ErrLastOwner) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if errors.Is(err, mongo.ErrNoDocuments) {
+		return status.Error(codes.NotFound, "Member not found")
+	}
+	return err
+}
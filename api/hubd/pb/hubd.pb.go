@@ -30,6 +30,58 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type LoginStatus int32
+
+const (
+	LoginStatus_LOGIN_STATUS_UNSPECIFIED         LoginStatus = 0
+	LoginStatus_LOGIN_STATUS_PENDING             LoginStatus = 1
+	LoginStatus_LOGIN_STATUS_COMPLETE            LoginStatus = 2
+	LoginStatus_LOGIN_STATUS_TWO_FACTOR_REQUIRED LoginStatus = 3
+)
+
+// Enum value maps for LoginStatus.
+var (
+	LoginStatus_name = map[int32]string{
+		0: "LOGIN_STATUS_UNSPECIFIED",
+		1: "LOGIN_STATUS_PENDING",
+		2: "LOGIN_STATUS_COMPLETE",
+		3: "LOGIN_STATUS_TWO_FACTOR_REQUIRED",
+	}
+	LoginStatus_value = map[string]int32{
+		"LOGIN_STATUS_UNSPECIFIED":         0,
+		"LOGIN_STATUS_PENDING":             1,
+		"LOGIN_STATUS_COMPLETE":            2,
+		"LOGIN_STATUS_TWO_FACTOR_REQUIRED": 3,
+	}
+)
+
+func (x LoginStatus) Enum() *LoginStatus {
+	p := new(LoginStatus)
+	*p = x
+	return p
+}
+
+func (x LoginStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoginStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_hubd_pb_hubd_proto_enumTypes[0].Descriptor()
+}
+
+func (LoginStatus) Type() protoreflect.EnumType {
+	return &file_api_hubd_pb_hubd_proto_enumTypes[0]
+}
+
+func (x LoginStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoginStatus.Descriptor instead.
+func (LoginStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{0}
+}
+
 type KeyType int32
 
 const (
@@ -63,11 +115,11 @@ func (x KeyType) String() string {
 }
 
 func (KeyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_hubd_pb_hubd_proto_enumTypes[0].Descriptor()
+	return file_api_hubd_pb_hubd_proto_enumTypes[1].Descriptor()
 }
 
 func (KeyType) Type() protoreflect.EnumType {
-	return &file_api_hubd_pb_hubd_proto_enumTypes[0]
+	return &file_api_hubd_pb_hubd_proto_enumTypes[1]
 }
 
 func (x KeyType) Number() protoreflect.EnumNumber {
@@ -76,7 +128,62 @@ func (x KeyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyType.Descriptor instead.
 func (KeyType) EnumDescriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{0}
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportStatus int32
+
+const (
+	ExportStatus_EXPORT_STATUS_UNSPECIFIED ExportStatus = 0
+	ExportStatus_EXPORT_STATUS_PENDING     ExportStatus = 1
+	ExportStatus_EXPORT_STATUS_RUNNING     ExportStatus = 2
+	ExportStatus_EXPORT_STATUS_COMPLETE    ExportStatus = 3
+	ExportStatus_EXPORT_STATUS_FAILED      ExportStatus = 4
+)
+
+// Enum value maps for ExportStatus.
+var (
+	ExportStatus_name = map[int32]string{
+		0: "EXPORT_STATUS_UNSPECIFIED",
+		1: "EXPORT_STATUS_PENDING",
+		2: "EXPORT_STATUS_RUNNING",
+		3: "EXPORT_STATUS_COMPLETE",
+		4: "EXPORT_STATUS_FAILED",
+	}
+	ExportStatus_value = map[string]int32{
+		"EXPORT_STATUS_UNSPECIFIED": 0,
+		"EXPORT_STATUS_PENDING":     1,
+		"EXPORT_STATUS_RUNNING":     2,
+		"EXPORT_STATUS_COMPLETE":    3,
+		"EXPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ExportStatus) Enum() *ExportStatus {
+	p := new(ExportStatus)
+	*p = x
+	return p
+}
+
+func (x ExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_hubd_pb_hubd_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportStatus) Type() protoreflect.EnumType {
+	return &file_api_hubd_pb_hubd_proto_enumTypes[2]
+}
+
+func (x ExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatus.Descriptor instead.
+func (ExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{2}
 }
 
 type BuildInfoRequest struct {
@@ -320,6 +427,7 @@ type SigninRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	UsernameOrEmail string `protobuf:"bytes,1,opt,name=username_or_email,json=usernameOrEmail,proto3" json:"username_or_email,omitempty"`
+	TwoFactorCode   string `protobuf:"bytes,2,opt,name=two_factor_code,json=twoFactorCode,proto3" json:"two_factor_code,omitempty"`
 }
 
 func (x *SigninRequest) Reset() {
@@ -361,6 +469,13 @@ func (x *SigninRequest) GetUsernameOrEmail() string {
 	return ""
 }
 
+func (x *SigninRequest) GetTwoFactorCode() string {
+	if x != nil {
+		return x.TwoFactorCode
+	}
+	return ""
+}
+
 type SigninResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -416,14 +531,17 @@ func (x *SigninResponse) GetSession() string {
 	return ""
 }
 
-type SignoutRequest struct {
+type StartSignupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *SignoutRequest) Reset() {
-	*x = SignoutRequest{}
+func (x *StartSignupRequest) Reset() {
+	*x = StartSignupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,13 +549,13 @@ func (x *SignoutRequest) Reset() {
 	}
 }
 
-func (x *SignoutRequest) String() string {
+func (x *StartSignupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignoutRequest) ProtoMessage() {}
+func (*StartSignupRequest) ProtoMessage() {}
 
-func (x *SignoutRequest) ProtoReflect() protoreflect.Message {
+func (x *StartSignupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,19 +567,35 @@ func (x *SignoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignoutRequest.ProtoReflect.Descriptor instead.
-func (*SignoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSignupRequest.ProtoReflect.Descriptor instead.
+func (*StartSignupRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{6}
 }
 
-type SignoutResponse struct {
+func (x *StartSignupRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StartSignupRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type StartSignupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
 }
 
-func (x *SignoutResponse) Reset() {
-	*x = SignoutResponse{}
+func (x *StartSignupResponse) Reset() {
+	*x = StartSignupResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,13 +603,13 @@ func (x *SignoutResponse) Reset() {
 	}
 }
 
-func (x *SignoutResponse) String() string {
+func (x *StartSignupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignoutResponse) ProtoMessage() {}
+func (*StartSignupResponse) ProtoMessage() {}
 
-func (x *SignoutResponse) ProtoReflect() protoreflect.Message {
+func (x *StartSignupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,19 +621,28 @@ func (x *SignoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignoutResponse.ProtoReflect.Descriptor instead.
-func (*SignoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSignupResponse.ProtoReflect.Descriptor instead.
+func (*StartSignupResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{7}
 }
 
-type GetSessionInfoRequest struct {
+func (x *StartSignupResponse) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+type StartSigninRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UsernameOrEmail string `protobuf:"bytes,1,opt,name=username_or_email,json=usernameOrEmail,proto3" json:"username_or_email,omitempty"`
 }
 
-func (x *GetSessionInfoRequest) Reset() {
-	*x = GetSessionInfoRequest{}
+func (x *StartSigninRequest) Reset() {
+	*x = StartSigninRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,13 +650,13 @@ func (x *GetSessionInfoRequest) Reset() {
 	}
 }
 
-func (x *GetSessionInfoRequest) String() string {
+func (x *StartSigninRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionInfoRequest) ProtoMessage() {}
+func (*StartSigninRequest) ProtoMessage() {}
 
-func (x *GetSessionInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *StartSigninRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,23 +668,28 @@ func (x *GetSessionInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSessionInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSigninRequest.ProtoReflect.Descriptor instead.
+func (*StartSigninRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{8}
 }
 
-type GetSessionInfoResponse struct {
+func (x *StartSigninRequest) GetUsernameOrEmail() string {
+	if x != nil {
+		return x.UsernameOrEmail
+	}
+	return ""
+}
+
+type StartSigninResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
 }
 
-func (x *GetSessionInfoResponse) Reset() {
-	*x = GetSessionInfoResponse{}
+func (x *StartSigninResponse) Reset() {
+	*x = StartSigninResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,13 +697,13 @@ func (x *GetSessionInfoResponse) Reset() {
 	}
 }
 
-func (x *GetSessionInfoResponse) String() string {
+func (x *StartSigninResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionInfoResponse) ProtoMessage() {}
+func (*StartSigninResponse) ProtoMessage() {}
 
-func (x *GetSessionInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *StartSigninResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,40 +715,29 @@ func (x *GetSessionInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSessionInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartSigninResponse.ProtoReflect.Descriptor instead.
+func (*StartSigninResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetSessionInfoResponse) GetKey() []byte {
-	if x != nil {
-		return x.Key
-	}
-	return nil
-}
-
-func (x *GetSessionInfoResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *GetSessionInfoResponse) GetEmail() string {
+func (x *StartSigninResponse) GetLoginId() string {
 	if x != nil {
-		return x.Email
+		return x.LoginId
 	}
 	return ""
 }
 
-type GetIdentityRequest struct {
+type PollLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LoginId       string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	TwoFactorCode string `protobuf:"bytes,2,opt,name=two_factor_code,json=twoFactorCode,proto3" json:"two_factor_code,omitempty"`
 }
 
-func (x *GetIdentityRequest) Reset() {
-	*x = GetIdentityRequest{}
+func (x *PollLoginRequest) Reset() {
+	*x = PollLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,13 +745,13 @@ func (x *GetIdentityRequest) Reset() {
 	}
 }
 
-func (x *GetIdentityRequest) String() string {
+func (x *PollLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIdentityRequest) ProtoMessage() {}
+func (*PollLoginRequest) ProtoMessage() {}
 
-func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
+func (x *PollLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,21 +763,37 @@ func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIdentityRequest.ProtoReflect.Descriptor instead.
-func (*GetIdentityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PollLoginRequest.ProtoReflect.Descriptor instead.
+func (*PollLoginRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{10}
 }
 
-type GetIdentityResponse struct {
+func (x *PollLoginRequest) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *PollLoginRequest) GetTwoFactorCode() string {
+	if x != nil {
+		return x.TwoFactorCode
+	}
+	return ""
+}
+
+type PollLoginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Identity []byte `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Status  LoginStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.hubd.pb.LoginStatus" json:"status,omitempty"`
+	Key     []byte      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Session string      `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *GetIdentityResponse) Reset() {
-	*x = GetIdentityResponse{}
+func (x *PollLoginResponse) Reset() {
+	*x = PollLoginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,13 +801,13 @@ func (x *GetIdentityResponse) Reset() {
 	}
 }
 
-func (x *GetIdentityResponse) String() string {
+func (x *PollLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIdentityResponse) ProtoMessage() {}
+func (*PollLoginResponse) ProtoMessage() {}
 
-func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
+func (x *PollLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,33 +819,47 @@ func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIdentityResponse.ProtoReflect.Descriptor instead.
-func (*GetIdentityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PollLoginResponse.ProtoReflect.Descriptor instead.
+func (*PollLoginResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetIdentityResponse) GetIdentity() []byte {
+func (x *PollLoginResponse) GetStatus() LoginStatus {
 	if x != nil {
-		return x.Identity
+		return x.Status
+	}
+	return LoginStatus_LOGIN_STATUS_UNSPECIFIED
+}
+
+func (x *PollLoginResponse) GetKey() []byte {
+	if x != nil {
+		return x.Key
 	}
 	return nil
 }
 
-type KeyInfo struct {
+func (x *PollLoginResponse) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+type GetSSOURLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Secret  string  `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	Type    KeyType `protobuf:"varint,3,opt,name=type,proto3,enum=api.hubd.pb.KeyType" json:"type,omitempty"`
-	Valid   bool    `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
-	Threads int32   `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
-	Secure  bool    `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`
+	Org           string `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+	RedirectUri   string `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	CodeChallenge string `protobuf:"bytes,3,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	Username      string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// link links the provider account to the session dev instead of signing in.
+	Link bool `protobuf:"varint,5,opt,name=link,proto3" json:"link,omitempty"`
 }
 
-func (x *KeyInfo) Reset() {
-	*x = KeyInfo{}
+func (x *GetSSOURLRequest) Reset() {
+	*x = GetSSOURLRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +867,13 @@ func (x *KeyInfo) Reset() {
 	}
 }
 
-func (x *KeyInfo) String() string {
+func (x *GetSSOURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyInfo) ProtoMessage() {}
+func (*GetSSOURLRequest) ProtoMessage() {}
 
-func (x *KeyInfo) ProtoReflect() protoreflect.Message {
+func (x *GetSSOURLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,64 +885,57 @@ func (x *KeyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyInfo.ProtoReflect.Descriptor instead.
-func (*KeyInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSSOURLRequest.ProtoReflect.Descriptor instead.
+func (*GetSSOURLRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *KeyInfo) GetKey() string {
+func (x *GetSSOURLRequest) GetOrg() string {
 	if x != nil {
-		return x.Key
+		return x.Org
 	}
 	return ""
 }
 
-func (x *KeyInfo) GetSecret() string {
+func (x *GetSSOURLRequest) GetRedirectUri() string {
 	if x != nil {
-		return x.Secret
+		return x.RedirectUri
 	}
 	return ""
 }
 
-func (x *KeyInfo) GetType() KeyType {
-	if x != nil {
-		return x.Type
-	}
-	return KeyType_KEY_TYPE_UNSPECIFIED
-}
-
-func (x *KeyInfo) GetValid() bool {
+func (x *GetSSOURLRequest) GetCodeChallenge() string {
 	if x != nil {
-		return x.Valid
+		return x.CodeChallenge
 	}
-	return false
+	return ""
 }
 
-func (x *KeyInfo) GetThreads() int32 {
+func (x *GetSSOURLRequest) GetUsername() string {
 	if x != nil {
-		return x.Threads
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *KeyInfo) GetSecure() bool {
+func (x *GetSSOURLRequest) GetLink() bool {
 	if x != nil {
-		return x.Secure
+		return x.Link
 	}
 	return false
 }
 
-type CreateKeyRequest struct {
+type GetSSOURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   KeyType `protobuf:"varint,1,opt,name=type,proto3,enum=api.hubd.pb.KeyType" json:"type,omitempty"`
-	Secure bool    `protobuf:"varint,2,opt,name=secure,proto3" json:"secure,omitempty"`
+	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *CreateKeyRequest) Reset() {
-	*x = CreateKeyRequest{}
+func (x *GetSSOURLResponse) Reset() {
+	*x = GetSSOURLResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,13 +943,13 @@ func (x *CreateKeyRequest) Reset() {
 	}
 }
 
-func (x *CreateKeyRequest) String() string {
+func (x *GetSSOURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateKeyRequest) ProtoMessage() {}
+func (*GetSSOURLResponse) ProtoMessage() {}
 
-func (x *CreateKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSSOURLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,35 +961,37 @@ func (x *CreateKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateKeyRequest.ProtoReflect.Descriptor instead.
-func (*CreateKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSSOURLResponse.ProtoReflect.Descriptor instead.
+func (*GetSSOURLResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateKeyRequest) GetType() KeyType {
+func (x *GetSSOURLResponse) GetUrl() string {
 	if x != nil {
-		return x.Type
+		return x.Url
 	}
-	return KeyType_KEY_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateKeyRequest) GetSecure() bool {
+func (x *GetSSOURLResponse) GetState() string {
 	if x != nil {
-		return x.Secure
+		return x.State
 	}
-	return false
+	return ""
 }
 
-type CreateKeyResponse struct {
+type SSOSigninRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyInfo *KeyInfo `protobuf:"bytes,1,opt,name=key_info,json=keyInfo,proto3" json:"key_info,omitempty"`
+	State        string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code         string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CodeVerifier string `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
 }
 
-func (x *CreateKeyResponse) Reset() {
-	*x = CreateKeyResponse{}
+func (x *SSOSigninRequest) Reset() {
+	*x = SSOSigninRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +999,13 @@ func (x *CreateKeyResponse) Reset() {
 	}
 }
 
-func (x *CreateKeyResponse) String() string {
+func (x *SSOSigninRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateKeyResponse) ProtoMessage() {}
+func (*SSOSigninRequest) ProtoMessage() {}
 
-func (x *CreateKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *SSOSigninRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,28 +1017,51 @@ func (x *CreateKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateKeyResponse.ProtoReflect.Descriptor instead.
-func (*CreateKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOSigninRequest.ProtoReflect.Descriptor instead.
+func (*SSOSigninRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateKeyResponse) GetKeyInfo() *KeyInfo {
+func (x *SSOSigninRequest) GetState() string {
 	if x != nil {
-		return x.KeyInfo
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-type InvalidateKeyRequest struct {
+func (x *SSOSigninRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SSOSigninRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+type SSOSigninResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-}
-
-func (x *InvalidateKeyRequest) Reset() {
-	*x = InvalidateKeyRequest{}
+	Key     []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Session string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// login_id is set instead of session if a two-factor code is required.
+	// The login is completed with PollLogin.
+	LoginId string `protobuf:"bytes,3,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	// linked is true if the provider account was linked to the session dev.
+	Linked bool `protobuf:"varint,4,opt,name=linked,proto3" json:"linked,omitempty"`
+	// pending is true if the sign on uses the gateway callback and it hasn't been received yet.
+	// Call SSOSignin again to check.
+	Pending bool `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *SSOSigninResponse) Reset() {
+	*x = SSOSigninResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,13 +1069,13 @@ func (x *InvalidateKeyRequest) Reset() {
 	}
 }
 
-func (x *InvalidateKeyRequest) String() string {
+func (x *SSOSigninResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvalidateKeyRequest) ProtoMessage() {}
+func (*SSOSigninResponse) ProtoMessage() {}
 
-func (x *InvalidateKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *SSOSigninResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,26 +1087,54 @@ func (x *InvalidateKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvalidateKeyRequest.ProtoReflect.Descriptor instead.
-func (*InvalidateKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SSOSigninResponse.ProtoReflect.Descriptor instead.
+func (*SSOSigninResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *InvalidateKeyRequest) GetKey() string {
+func (x *SSOSigninResponse) GetKey() []byte {
 	if x != nil {
 		return x.Key
 	}
+	return nil
+}
+
+func (x *SSOSigninResponse) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
 	return ""
 }
 
-type InvalidateKeyResponse struct {
+func (x *SSOSigninResponse) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *SSOSigninResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *SSOSigninResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type SignoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *InvalidateKeyResponse) Reset() {
-	*x = InvalidateKeyResponse{}
+func (x *SignoutRequest) Reset() {
+	*x = SignoutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -929,13 +1142,13 @@ func (x *InvalidateKeyResponse) Reset() {
 	}
 }
 
-func (x *InvalidateKeyResponse) String() string {
+func (x *SignoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvalidateKeyResponse) ProtoMessage() {}
+func (*SignoutRequest) ProtoMessage() {}
 
-func (x *InvalidateKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *SignoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -947,19 +1160,19 @@ func (x *InvalidateKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvalidateKeyResponse.ProtoReflect.Descriptor instead.
-func (*InvalidateKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignoutRequest.ProtoReflect.Descriptor instead.
+func (*SignoutRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{16}
 }
 
-type ListKeysRequest struct {
+type SignoutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListKeysRequest) Reset() {
-	*x = ListKeysRequest{}
+func (x *SignoutResponse) Reset() {
+	*x = SignoutResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,13 +1180,13 @@ func (x *ListKeysRequest) Reset() {
 	}
 }
 
-func (x *ListKeysRequest) String() string {
+func (x *SignoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListKeysRequest) ProtoMessage() {}
+func (*SignoutResponse) ProtoMessage() {}
 
-func (x *ListKeysRequest) ProtoReflect() protoreflect.Message {
+func (x *SignoutResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,21 +1198,19 @@ func (x *ListKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListKeysRequest.ProtoReflect.Descriptor instead.
-func (*ListKeysRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignoutResponse.ProtoReflect.Descriptor instead.
+func (*SignoutResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{17}
 }
 
-type ListKeysResponse struct {
+type GetSessionInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	List []*KeyInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *ListKeysResponse) Reset() {
-	*x = ListKeysResponse{}
+func (x *GetSessionInfoRequest) Reset() {
+	*x = GetSessionInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1218,13 @@ func (x *ListKeysResponse) Reset() {
 	}
 }
 
-func (x *ListKeysResponse) String() string {
+func (x *GetSessionInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListKeysResponse) ProtoMessage() {}
+func (*GetSessionInfoRequest) ProtoMessage() {}
 
-func (x *ListKeysResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSessionInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,33 +1236,24 @@ func (x *ListKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListKeysResponse.ProtoReflect.Descriptor instead.
-func (*ListKeysResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionInfoRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListKeysResponse) GetList() []*KeyInfo {
-	if x != nil {
-		return x.List
-	}
-	return nil
-}
-
-type OrgInfo struct {
+type GetSessionInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key       []byte            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name      string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Slug      string            `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
-	Host      string            `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
-	Members   []*OrgInfo_Member `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
-	CreatedAt int64             `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Key              []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Username         string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email            string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	TwoFactorEnabled bool   `protobuf:"varint,4,opt,name=two_factor_enabled,json=twoFactorEnabled,proto3" json:"two_factor_enabled,omitempty"`
 }
 
-func (x *OrgInfo) Reset() {
-	*x = OrgInfo{}
+func (x *GetSessionInfoResponse) Reset() {
+	*x = GetSessionInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1059,13 +1261,13 @@ func (x *OrgInfo) Reset() {
 	}
 }
 
-func (x *OrgInfo) String() string {
+func (x *GetSessionInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgInfo) ProtoMessage() {}
+func (*GetSessionInfoResponse) ProtoMessage() {}
 
-func (x *OrgInfo) ProtoReflect() protoreflect.Message {
+func (x *GetSessionInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1077,63 +1279,56 @@ func (x *OrgInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgInfo.ProtoReflect.Descriptor instead.
-func (*OrgInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSessionInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionInfoResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *OrgInfo) GetKey() []byte {
+func (x *GetSessionInfoResponse) GetKey() []byte {
 	if x != nil {
 		return x.Key
 	}
 	return nil
 }
 
-func (x *OrgInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *OrgInfo) GetSlug() string {
+func (x *GetSessionInfoResponse) GetUsername() string {
 	if x != nil {
-		return x.Slug
+		return x.Username
 	}
 	return ""
 }
 
-func (x *OrgInfo) GetHost() string {
+func (x *GetSessionInfoResponse) GetEmail() string {
 	if x != nil {
-		return x.Host
+		return x.Email
 	}
 	return ""
 }
 
-func (x *OrgInfo) GetMembers() []*OrgInfo_Member {
-	if x != nil {
-		return x.Members
-	}
-	return nil
-}
-
-func (x *OrgInfo) GetCreatedAt() int64 {
+func (x *GetSessionInfoResponse) GetTwoFactorEnabled() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.TwoFactorEnabled
 	}
-	return 0
+	return false
 }
 
-type CreateOrgRequest struct {
+type SessionInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent  string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Ip         string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt int64  `protobuf:"varint,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SsoIssuer  string `protobuf:"bytes,7,opt,name=sso_issuer,json=ssoIssuer,proto3" json:"sso_issuer,omitempty"`
+	Current    bool   `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
 }
 
-func (x *CreateOrgRequest) Reset() {
-	*x = CreateOrgRequest{}
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1141,13 +1336,13 @@ func (x *CreateOrgRequest) Reset() {
 	}
 }
 
-func (x *CreateOrgRequest) String() string {
+func (x *SessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrgRequest) ProtoMessage() {}
+func (*SessionInfo) ProtoMessage() {}
 
-func (x *CreateOrgRequest) ProtoReflect() protoreflect.Message {
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1159,28 +1354,75 @@ func (x *CreateOrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrgRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateOrgRequest) GetName() string {
+func (x *SessionInfo) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type CreateOrgResponse struct {
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetSsoIssuer() string {
+	if x != nil {
+		return x.SsoIssuer
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	OrgInfo *OrgInfo `protobuf:"bytes,1,opt,name=org_info,json=orgInfo,proto3" json:"org_info,omitempty"`
 }
 
-func (x *CreateOrgResponse) Reset() {
-	*x = CreateOrgResponse{}
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1188,13 +1430,13 @@ func (x *CreateOrgResponse) Reset() {
 	}
 }
 
-func (x *CreateOrgResponse) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrgResponse) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *CreateOrgResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,26 +1448,21 @@ func (x *CreateOrgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrgResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateOrgResponse) GetOrgInfo() *OrgInfo {
-	if x != nil {
-		return x.OrgInfo
-	}
-	return nil
-}
-
-type GetOrgRequest struct {
+type ListSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	List []*SessionInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *GetOrgRequest) Reset() {
-	*x = GetOrgRequest{}
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1233,13 +1470,13 @@ func (x *GetOrgRequest) Reset() {
 	}
 }
 
-func (x *GetOrgRequest) String() string {
+func (x *ListSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrgRequest) ProtoMessage() {}
+func (*ListSessionsResponse) ProtoMessage() {}
 
-func (x *GetOrgRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,21 +1488,28 @@ func (x *GetOrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrgRequest.ProtoReflect.Descriptor instead.
-func (*GetOrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{22}
 }
 
-type GetOrgResponse struct {
+func (x *ListSessionsResponse) GetList() []*SessionInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrgInfo *OrgInfo `protobuf:"bytes,1,opt,name=org_info,json=orgInfo,proto3" json:"org_info,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetOrgResponse) Reset() {
-	*x = GetOrgResponse{}
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1273,13 +1517,13 @@ func (x *GetOrgResponse) Reset() {
 	}
 }
 
-func (x *GetOrgResponse) String() string {
+func (x *RevokeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrgResponse) ProtoMessage() {}
+func (*RevokeSessionRequest) ProtoMessage() {}
 
-func (x *GetOrgResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,26 +1535,26 @@ func (x *GetOrgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrgResponse.ProtoReflect.Descriptor instead.
-func (*GetOrgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetOrgResponse) GetOrgInfo() *OrgInfo {
+func (x *RevokeSessionRequest) GetId() string {
 	if x != nil {
-		return x.OrgInfo
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListOrgsRequest struct {
+type RevokeSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListOrgsRequest) Reset() {
-	*x = ListOrgsRequest{}
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,13 +1562,13 @@ func (x *ListOrgsRequest) Reset() {
 	}
 }
 
-func (x *ListOrgsRequest) String() string {
+func (x *RevokeSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrgsRequest) ProtoMessage() {}
+func (*RevokeSessionResponse) ProtoMessage() {}
 
-func (x *ListOrgsRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1336,21 +1580,21 @@ func (x *ListOrgsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrgsRequest.ProtoReflect.Descriptor instead.
-func (*ListOrgsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{24}
 }
 
-type ListOrgsResponse struct {
+type RevokeAllSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	List []*OrgInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	KeepCurrent bool `protobuf:"varint,1,opt,name=keep_current,json=keepCurrent,proto3" json:"keep_current,omitempty"`
 }
 
-func (x *ListOrgsResponse) Reset() {
-	*x = ListOrgsResponse{}
+func (x *RevokeAllSessionsRequest) Reset() {
+	*x = RevokeAllSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1358,13 +1602,13 @@ func (x *ListOrgsResponse) Reset() {
 	}
 }
 
-func (x *ListOrgsResponse) String() string {
+func (x *RevokeAllSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrgsResponse) ProtoMessage() {}
+func (*RevokeAllSessionsRequest) ProtoMessage() {}
 
-func (x *ListOrgsResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokeAllSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1376,26 +1620,26 @@ func (x *ListOrgsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrgsResponse.ProtoReflect.Descriptor instead.
-func (*ListOrgsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListOrgsResponse) GetList() []*OrgInfo {
+func (x *RevokeAllSessionsRequest) GetKeepCurrent() bool {
 	if x != nil {
-		return x.List
+		return x.KeepCurrent
 	}
-	return nil
+	return false
 }
 
-type RemoveOrgRequest struct {
+type RevokeAllSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveOrgRequest) Reset() {
-	*x = RemoveOrgRequest{}
+func (x *RevokeAllSessionsResponse) Reset() {
+	*x = RevokeAllSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1403,13 +1647,13 @@ func (x *RemoveOrgRequest) Reset() {
 	}
 }
 
-func (x *RemoveOrgRequest) String() string {
+func (x *RevokeAllSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveOrgRequest) ProtoMessage() {}
+func (*RevokeAllSessionsResponse) ProtoMessage() {}
 
-func (x *RemoveOrgRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokeAllSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1421,19 +1665,19 @@ func (x *RemoveOrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveOrgRequest.ProtoReflect.Descriptor instead.
-func (*RemoveOrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{26}
 }
 
-type RemoveOrgResponse struct {
+type EnableTwoFactorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveOrgResponse) Reset() {
-	*x = RemoveOrgResponse{}
+func (x *EnableTwoFactorRequest) Reset() {
+	*x = EnableTwoFactorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,13 +1685,13 @@ func (x *RemoveOrgResponse) Reset() {
 	}
 }
 
-func (x *RemoveOrgResponse) String() string {
+func (x *EnableTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveOrgResponse) ProtoMessage() {}
+func (*EnableTwoFactorRequest) ProtoMessage() {}
 
-func (x *RemoveOrgResponse) ProtoReflect() protoreflect.Message {
+func (x *EnableTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,21 +1703,22 @@ func (x *RemoveOrgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveOrgResponse.ProtoReflect.Descriptor instead.
-func (*RemoveOrgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{27}
 }
 
-type InviteToOrgRequest struct {
+type EnableTwoFactorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Url    string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *InviteToOrgRequest) Reset() {
-	*x = InviteToOrgRequest{}
+func (x *EnableTwoFactorResponse) Reset() {
+	*x = EnableTwoFactorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1481,13 +1726,13 @@ func (x *InviteToOrgRequest) Reset() {
 	}
 }
 
-func (x *InviteToOrgRequest) String() string {
+func (x *EnableTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteToOrgRequest) ProtoMessage() {}
+func (*EnableTwoFactorResponse) ProtoMessage() {}
 
-func (x *InviteToOrgRequest) ProtoReflect() protoreflect.Message {
+func (x *EnableTwoFactorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1499,28 +1744,35 @@ func (x *InviteToOrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteToOrgRequest.ProtoReflect.Descriptor instead.
-func (*InviteToOrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *InviteToOrgRequest) GetEmail() string {
+func (x *EnableTwoFactorResponse) GetSecret() string {
 	if x != nil {
-		return x.Email
+		return x.Secret
 	}
 	return ""
 }
 
-type InviteToOrgResponse struct {
+func (x *EnableTwoFactorResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ConfirmTwoFactorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *InviteToOrgResponse) Reset() {
-	*x = InviteToOrgResponse{}
+func (x *ConfirmTwoFactorRequest) Reset() {
+	*x = ConfirmTwoFactorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1528,13 +1780,13 @@ func (x *InviteToOrgResponse) Reset() {
 	}
 }
 
-func (x *InviteToOrgResponse) String() string {
+func (x *ConfirmTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteToOrgResponse) ProtoMessage() {}
+func (*ConfirmTwoFactorRequest) ProtoMessage() {}
 
-func (x *InviteToOrgResponse) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1546,26 +1798,28 @@ func (x *InviteToOrgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteToOrgResponse.ProtoReflect.Descriptor instead.
-func (*InviteToOrgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *InviteToOrgResponse) GetToken() string {
+func (x *ConfirmTwoFactorRequest) GetCode() string {
 	if x != nil {
-		return x.Token
+		return x.Code
 	}
 	return ""
 }
 
-type LeaveOrgRequest struct {
+type ConfirmTwoFactorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
 }
 
-func (x *LeaveOrgRequest) Reset() {
-	*x = LeaveOrgRequest{}
+func (x *ConfirmTwoFactorResponse) Reset() {
+	*x = ConfirmTwoFactorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,13 +1827,13 @@ func (x *LeaveOrgRequest) Reset() {
 	}
 }
 
-func (x *LeaveOrgRequest) String() string {
+func (x *ConfirmTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveOrgRequest) ProtoMessage() {}
+func (*ConfirmTwoFactorResponse) ProtoMessage() {}
 
-func (x *LeaveOrgRequest) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTwoFactorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1591,19 +1845,28 @@ func (x *LeaveOrgRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveOrgRequest.ProtoReflect.Descriptor instead.
-func (*LeaveOrgRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{30}
 }
 
-type LeaveOrgResponse struct {
+func (x *ConfirmTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTwoFactorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *LeaveOrgResponse) Reset() {
-	*x = LeaveOrgResponse{}
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,13 +1874,13 @@ func (x *LeaveOrgResponse) Reset() {
 	}
 }
 
-func (x *LeaveOrgResponse) String() string {
+func (x *DisableTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveOrgResponse) ProtoMessage() {}
+func (*DisableTwoFactorRequest) ProtoMessage() {}
 
-func (x *LeaveOrgResponse) ProtoReflect() protoreflect.Message {
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,19 +1892,26 @@ func (x *LeaveOrgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveOrgResponse.ProtoReflect.Descriptor instead.
-func (*LeaveOrgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{31}
 }
 
-type SetupBillingRequest struct {
+func (x *DisableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTwoFactorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetupBillingRequest) Reset() {
-	*x = SetupBillingRequest{}
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1649,13 +1919,13 @@ func (x *SetupBillingRequest) Reset() {
 	}
 }
 
-func (x *SetupBillingRequest) String() string {
+func (x *DisableTwoFactorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupBillingRequest) ProtoMessage() {}
+func (*DisableTwoFactorResponse) ProtoMessage() {}
 
-func (x *SetupBillingRequest) ProtoReflect() protoreflect.Message {
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,19 +1937,19 @@ func (x *SetupBillingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupBillingRequest.ProtoReflect.Descriptor instead.
-func (*SetupBillingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{32}
 }
 
-type SetupBillingResponse struct {
+type GetIdentityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetupBillingResponse) Reset() {
-	*x = SetupBillingResponse{}
+func (x *GetIdentityRequest) Reset() {
+	*x = GetIdentityRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1687,13 +1957,13 @@ func (x *SetupBillingResponse) Reset() {
 	}
 }
 
-func (x *SetupBillingResponse) String() string {
+func (x *GetIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetupBillingResponse) ProtoMessage() {}
+func (*GetIdentityRequest) ProtoMessage() {}
 
-func (x *SetupBillingResponse) ProtoReflect() protoreflect.Message {
+func (x *GetIdentityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1705,19 +1975,21 @@ func (x *SetupBillingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetupBillingResponse.ProtoReflect.Descriptor instead.
-func (*SetupBillingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentityRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{33}
 }
 
-type GetBillingSessionRequest struct {
+type GetIdentityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Identity []byte `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *GetBillingSessionRequest) Reset() {
-	*x = GetBillingSessionRequest{}
+func (x *GetIdentityResponse) Reset() {
+	*x = GetIdentityResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1725,13 +1997,13 @@ func (x *GetBillingSessionRequest) Reset() {
 	}
 }
 
-func (x *GetBillingSessionRequest) String() string {
+func (x *GetIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBillingSessionRequest) ProtoMessage() {}
+func (*GetIdentityResponse) ProtoMessage() {}
 
-func (x *GetBillingSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetIdentityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1743,21 +2015,41 @@ func (x *GetBillingSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBillingSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetBillingSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentityResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{34}
 }
 
-type GetBillingSessionResponse struct {
+func (x *GetIdentityResponse) GetIdentity() []byte {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type KeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Key                      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret                   string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Type                     KeyType  `protobuf:"varint,3,opt,name=type,proto3,enum=api.hubd.pb.KeyType" json:"type,omitempty"`
+	Valid                    bool     `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Threads                  int32    `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	Secure                   bool     `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`
+	Scopes                   []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt                int64    `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AllowedOrigins           []string `protobuf:"bytes,9,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	AllowedIps               []string `protobuf:"bytes,10,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	LastUsedAt               int64    `protobuf:"varint,11,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RotatedAt                int64    `protobuf:"varint,12,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	PreviousSecretExpiresAt  int64    `protobuf:"varint,13,opt,name=previous_secret_expires_at,json=previousSecretExpiresAt,proto3" json:"previous_secret_expires_at,omitempty"`
+	PreviousSecretLastUsedAt int64    `protobuf:"varint,14,opt,name=previous_secret_last_used_at,json=previousSecretLastUsedAt,proto3" json:"previous_secret_last_used_at,omitempty"`
 }
 
-func (x *GetBillingSessionResponse) Reset() {
-	*x = GetBillingSessionResponse{}
+func (x *KeyInfo) Reset() {
+	*x = KeyInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1765,13 +2057,13 @@ func (x *GetBillingSessionResponse) Reset() {
 	}
 }
 
-func (x *GetBillingSessionResponse) String() string {
+func (x *KeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBillingSessionResponse) ProtoMessage() {}
+func (*KeyInfo) ProtoMessage() {}
 
-func (x *GetBillingSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1783,153 +2075,139 @@ func (x *GetBillingSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBillingSessionResponse.ProtoReflect.Descriptor instead.
-func (*GetBillingSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyInfo.ProtoReflect.Descriptor instead.
+func (*KeyInfo) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetBillingSessionResponse) GetUrl() string {
+func (x *KeyInfo) GetKey() string {
 	if x != nil {
-		return x.Url
+		return x.Key
 	}
 	return ""
 }
 
-type ListBillingUsersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *KeyInfo) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
 }
 
-func (x *ListBillingUsersRequest) Reset() {
-	*x = ListBillingUsersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *KeyInfo) GetType() KeyType {
+	if x != nil {
+		return x.Type
 	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
 }
 
-func (x *ListBillingUsersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *KeyInfo) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
 }
 
-func (*ListBillingUsersRequest) ProtoMessage() {}
-
-func (x *ListBillingUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *KeyInfo) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListBillingUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListBillingUsersRequest) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{36}
+func (x *KeyInfo) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
 }
 
-func (x *ListBillingUsersRequest) GetOffset() int64 {
+func (x *KeyInfo) GetScopes() []string {
 	if x != nil {
-		return x.Offset
+		return x.Scopes
 	}
-	return 0
+	return nil
 }
 
-func (x *ListBillingUsersRequest) GetLimit() int64 {
+func (x *KeyInfo) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Limit
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type ListBillingUsersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Users      []*pb.GetCustomerResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	NextOffset int64                     `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
-}
-
-func (x *ListBillingUsersResponse) Reset() {
-	*x = ListBillingUsersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *KeyInfo) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
 	}
+	return nil
 }
 
-func (x *ListBillingUsersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *KeyInfo) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
 }
 
-func (*ListBillingUsersResponse) ProtoMessage() {}
-
-func (x *ListBillingUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *KeyInfo) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListBillingUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListBillingUsersResponse) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{37}
+func (x *KeyInfo) GetRotatedAt() int64 {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return 0
 }
 
-func (x *ListBillingUsersResponse) GetUsers() []*pb.GetCustomerResponse {
+func (x *KeyInfo) GetPreviousSecretExpiresAt() int64 {
 	if x != nil {
-		return x.Users
+		return x.PreviousSecretExpiresAt
 	}
-	return nil
+	return 0
 }
 
-func (x *ListBillingUsersResponse) GetNextOffset() int64 {
+func (x *KeyInfo) GetPreviousSecretLastUsedAt() int64 {
 	if x != nil {
-		return x.NextOffset
+		return x.PreviousSecretLastUsedAt
 	}
 	return 0
 }
 
-type IsUsernameAvailableRequest struct {
+type CreateKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Type           KeyType  `protobuf:"varint,1,opt,name=type,proto3,enum=api.hubd.pb.KeyType" json:"type,omitempty"`
+	Secure         bool     `protobuf:"varint,2,opt,name=secure,proto3" json:"secure,omitempty"`
+	Scopes         []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt      int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AllowedOrigins []string `protobuf:"bytes,5,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	AllowedIps     []string `protobuf:"bytes,6,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
 }
 
-func (x *IsUsernameAvailableRequest) Reset() {
-	*x = IsUsernameAvailableRequest{}
+func (x *CreateKeyRequest) Reset() {
+	*x = CreateKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IsUsernameAvailableRequest) String() string {
+func (x *CreateKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsUsernameAvailableRequest) ProtoMessage() {}
+func (*CreateKeyRequest) ProtoMessage() {}
 
-func (x *IsUsernameAvailableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+func (x *CreateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,26 +2218,155 @@ func (x *IsUsernameAvailableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsUsernameAvailableRequest.ProtoReflect.Descriptor instead.
-func (*IsUsernameAvailableRequest) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{38}
-}
+// Deprecated: Use CreateKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{36}
+}
 
-func (x *IsUsernameAvailableRequest) GetUsername() string {
+func (x *CreateKeyRequest) GetType() KeyType {
 	if x != nil {
-		return x.Username
+		return x.Type
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
+}
+
+func (x *CreateKeyRequest) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
+}
+
+func (x *CreateKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateKeyRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateKeyRequest) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *CreateKeyRequest) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+type CreateKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyInfo *KeyInfo `protobuf:"bytes,1,opt,name=key_info,json=keyInfo,proto3" json:"key_info,omitempty"`
+}
+
+func (x *CreateKeyResponse) Reset() {
+	*x = CreateKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeyResponse) ProtoMessage() {}
+
+func (x *CreateKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateKeyResponse) GetKeyInfo() *KeyInfo {
+	if x != nil {
+		return x.KeyInfo
+	}
+	return nil
+}
+
+type InvalidateKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *InvalidateKeyRequest) Reset() {
+	*x = InvalidateKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateKeyRequest) ProtoMessage() {}
+
+func (x *InvalidateKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateKeyRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InvalidateKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-type IsUsernameAvailableResponse struct {
+type InvalidateKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *IsUsernameAvailableResponse) Reset() {
-	*x = IsUsernameAvailableResponse{}
+func (x *InvalidateKeyResponse) Reset() {
+	*x = InvalidateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1967,13 +2374,13 @@ func (x *IsUsernameAvailableResponse) Reset() {
 	}
 }
 
-func (x *IsUsernameAvailableResponse) String() string {
+func (x *InvalidateKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsUsernameAvailableResponse) ProtoMessage() {}
+func (*InvalidateKeyResponse) ProtoMessage() {}
 
-func (x *IsUsernameAvailableResponse) ProtoReflect() protoreflect.Message {
+func (x *InvalidateKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1985,21 +2392,22 @@ func (x *IsUsernameAvailableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsUsernameAvailableResponse.ProtoReflect.Descriptor instead.
-func (*IsUsernameAvailableResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvalidateKeyResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateKeyResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{39}
 }
 
-type IsOrgNameAvailableRequest struct {
+type RotateKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	GracePeriod int64  `protobuf:"varint,2,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
 }
 
-func (x *IsOrgNameAvailableRequest) Reset() {
-	*x = IsOrgNameAvailableRequest{}
+func (x *RotateKeyRequest) Reset() {
+	*x = RotateKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2007,13 +2415,13 @@ func (x *IsOrgNameAvailableRequest) Reset() {
 	}
 }
 
-func (x *IsOrgNameAvailableRequest) String() string {
+func (x *RotateKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsOrgNameAvailableRequest) ProtoMessage() {}
+func (*RotateKeyRequest) ProtoMessage() {}
 
-func (x *IsOrgNameAvailableRequest) ProtoReflect() protoreflect.Message {
+func (x *RotateKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2025,29 +2433,35 @@ func (x *IsOrgNameAvailableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsOrgNameAvailableRequest.ProtoReflect.Descriptor instead.
-func (*IsOrgNameAvailableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateKeyRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *IsOrgNameAvailableRequest) GetName() string {
+func (x *RotateKeyRequest) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-type IsOrgNameAvailableResponse struct {
+func (x *RotateKeyRequest) GetGracePeriod() int64 {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return 0
+}
+
+type RotateKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	KeyInfo *KeyInfo `protobuf:"bytes,1,opt,name=key_info,json=keyInfo,proto3" json:"key_info,omitempty"`
 }
 
-func (x *IsOrgNameAvailableResponse) Reset() {
-	*x = IsOrgNameAvailableResponse{}
+func (x *RotateKeyResponse) Reset() {
+	*x = RotateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2055,13 +2469,13 @@ func (x *IsOrgNameAvailableResponse) Reset() {
 	}
 }
 
-func (x *IsOrgNameAvailableResponse) String() string {
+func (x *RotateKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsOrgNameAvailableResponse) ProtoMessage() {}
+func (*RotateKeyResponse) ProtoMessage() {}
 
-func (x *IsOrgNameAvailableResponse) ProtoReflect() protoreflect.Message {
+func (x *RotateKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2073,33 +2487,26 @@ func (x *IsOrgNameAvailableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsOrgNameAvailableResponse.ProtoReflect.Descriptor instead.
-func (*IsOrgNameAvailableResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateKeyResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *IsOrgNameAvailableResponse) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
-}
-
-func (x *IsOrgNameAvailableResponse) GetHost() string {
+func (x *RotateKeyResponse) GetKeyInfo() *KeyInfo {
 	if x != nil {
-		return x.Host
+		return x.KeyInfo
 	}
-	return ""
+	return nil
 }
 
-type DestroyAccountRequest struct {
+type ListKeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DestroyAccountRequest) Reset() {
-	*x = DestroyAccountRequest{}
+func (x *ListKeysRequest) Reset() {
+	*x = ListKeysRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2107,13 +2514,13 @@ func (x *DestroyAccountRequest) Reset() {
 	}
 }
 
-func (x *DestroyAccountRequest) String() string {
+func (x *ListKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyAccountRequest) ProtoMessage() {}
+func (*ListKeysRequest) ProtoMessage() {}
 
-func (x *DestroyAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListKeysRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2125,19 +2532,21 @@ func (x *DestroyAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyAccountRequest.ProtoReflect.Descriptor instead.
-func (*DestroyAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListKeysRequest) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{42}
 }
 
-type DestroyAccountResponse struct {
+type ListKeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	List []*KeyInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *DestroyAccountResponse) Reset() {
-	*x = DestroyAccountResponse{}
+func (x *ListKeysResponse) Reset() {
+	*x = ListKeysResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2145,13 +2554,13 @@ func (x *DestroyAccountResponse) Reset() {
 	}
 }
 
-func (x *DestroyAccountResponse) String() string {
+func (x *ListKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyAccountResponse) ProtoMessage() {}
+func (*ListKeysResponse) ProtoMessage() {}
 
-func (x *DestroyAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ListKeysResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2163,23 +2572,35 @@ func (x *DestroyAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyAccountResponse.ProtoReflect.Descriptor instead.
-func (*DestroyAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListKeysResponse) Descriptor() ([]byte, []int) {
 	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{43}
 }
 
-type OrgInfo_Member struct {
+func (x *ListKeysResponse) GetList() []*KeyInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type OrgInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Key               []byte            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name              string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug              string            `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Host              string            `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Members           []*OrgInfo_Member `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt         int64             `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Transfer          *OrgInfo_Transfer `protobuf:"bytes,7,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	TwoFactorRequired bool              `protobuf:"varint,8,opt,name=two_factor_required,json=twoFactorRequired,proto3" json:"two_factor_required,omitempty"`
 }
 
-func (x *OrgInfo_Member) Reset() {
-	*x = OrgInfo_Member{}
+func (x *OrgInfo) Reset() {
+	*x = OrgInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2187,13 +2608,13 @@ func (x *OrgInfo_Member) Reset() {
 	}
 }
 
-func (x *OrgInfo_Member) String() string {
+func (x *OrgInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrgInfo_Member) ProtoMessage() {}
+func (*OrgInfo) ProtoMessage() {}
 
-func (x *OrgInfo_Member) ProtoReflect() protoreflect.Message {
+func (x *OrgInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_api_hubd_pb_hubd_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,444 +2626,4016 @@ func (x *OrgInfo_Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrgInfo_Member.ProtoReflect.Descriptor instead.
-func (*OrgInfo_Member) Descriptor() ([]byte, []int) {
-	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use OrgInfo.ProtoReflect.Descriptor instead.
+func (*OrgInfo) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *OrgInfo_Member) GetKey() []byte {
+func (x *OrgInfo) GetKey() []byte {
 	if x != nil {
 		return x.Key
 	}
 	return nil
 }
 
-func (x *OrgInfo_Member) GetUsername() string {
+func (x *OrgInfo) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OrgInfo_Member) GetRole() string {
+func (x *OrgInfo) GetSlug() string {
 	if x != nil {
-		return x.Role
+		return x.Slug
 	}
 	return ""
 }
 
-var File_api_hubd_pb_hubd_proto protoreflect.FileDescriptor
+func (x *OrgInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
 
-var file_api_hubd_pb_hubd_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75, 0x62, 0x64, 0x2f, 0x70, 0x62, 0x2f, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x64, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x0a,
-	0x09, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x67, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69,
-	0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x67, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3c, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x75,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x22, 0x3c, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x10, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x22, 0x54, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63,
-	0x75, 0x72, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x07, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x28, 0x0a, 0x14, 0x49, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xf9, 0x01,
-	0x0a, 0x07, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
-	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x4a, 0x0a,
-	0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
-	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
-	0x6f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6f, 0x72,
-	0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c,
-	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
-	0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
-	0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x22, 0x2b, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x11,
-	0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14,
-	0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
-	0x47, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x22, 0x38, 0x0a, 0x1a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x49,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x49, 0x73,
-	0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x1a, 0x49,
-	0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65,
-	0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x11, 0x0a, 0x0d, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
-	0x10, 0x02, 0x32, 0xed, 0x0d, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x53, 0x69, 0x67,
-	0x6e, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x12, 0x1a, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67,
-	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67,
-	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72,
-	0x67, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x55, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6a, 0x0a, 0x13, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12,
-	0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
-	0x2e, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
-	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
-	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69,
-	0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75, 0x62, 0x64, 0x2f, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *OrgInfo) GetMembers() []*OrgInfo_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
 }
 
-var (
-	file_api_hubd_pb_hubd_proto_rawDescOnce sync.Once
-	file_api_hubd_pb_hubd_proto_rawDescData = file_api_hubd_pb_hubd_proto_rawDesc
-)
+func (x *OrgInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
 
-func file_api_hubd_pb_hubd_proto_rawDescGZIP() []byte {
-	file_api_hubd_pb_hubd_proto_rawDescOnce.Do(func() {
-		file_api_hubd_pb_hubd_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_hubd_pb_hubd_proto_rawDescData)
-	})
-	return file_api_hubd_pb_hubd_proto_rawDescData
+func (x *OrgInfo) GetTransfer() *OrgInfo_Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
 }
 
-var file_api_hubd_pb_hubd_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_hubd_pb_hubd_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_api_hubd_pb_hubd_proto_goTypes = []interface{}{
-	(KeyType)(0),                        // 0: api.hubd.pb.KeyType
-	(*BuildInfoRequest)(nil),            // 1: api.hubd.pb.BuildInfoRequest
-	(*BuildInfoResponse)(nil),           // 2: api.hubd.pb.BuildInfoResponse
-	(*SignupRequest)(nil),               // 3: api.hubd.pb.SignupRequest
-	(*SignupResponse)(nil),              // 4: api.hubd.pb.SignupResponse
-	(*SigninRequest)(nil),               // 5: api.hubd.pb.SigninRequest
-	(*SigninResponse)(nil),              // 6: api.hubd.pb.SigninResponse
-	(*SignoutRequest)(nil),              // 7: api.hubd.pb.SignoutRequest
-	(*SignoutResponse)(nil),             // 8: api.hubd.pb.SignoutResponse
-	(*GetSessionInfoRequest)(nil),       // 9: api.hubd.pb.GetSessionInfoRequest
-	(*GetSessionInfoResponse)(nil),      // 10: api.hubd.pb.GetSessionInfoResponse
-	(*GetIdentityRequest)(nil),          // 11: api.hubd.pb.GetIdentityRequest
-	(*GetIdentityResponse)(nil),         // 12: api.hubd.pb.GetIdentityResponse
-	(*KeyInfo)(nil),                     // 13: api.hubd.pb.KeyInfo
-	(*CreateKeyRequest)(nil),            // 14: api.hubd.pb.CreateKeyRequest
-	(*CreateKeyResponse)(nil),           // 15: api.hubd.pb.CreateKeyResponse
-	(*InvalidateKeyRequest)(nil),        // 16: api.hubd.pb.InvalidateKeyRequest
-	(*InvalidateKeyResponse)(nil),       // 17: api.hubd.pb.InvalidateKeyResponse
-	(*ListKeysRequest)(nil),             // 18: api.hubd.pb.ListKeysRequest
-	(*ListKeysResponse)(nil),            // 19: api.hubd.pb.ListKeysResponse
-	(*OrgInfo)(nil),                     // 20: api.hubd.pb.OrgInfo
-	(*CreateOrgRequest)(nil),            // 21: api.hubd.pb.CreateOrgRequest
-	(*CreateOrgResponse)(nil),           // 22: api.hubd.pb.CreateOrgResponse
-	(*GetOrgRequest)(nil),               // 23: api.hubd.pb.GetOrgRequest
-	(*GetOrgResponse)(nil),              // 24: api.hubd.pb.GetOrgResponse
-	(*ListOrgsRequest)(nil),             // 25: api.hubd.pb.ListOrgsRequest
-	(*ListOrgsResponse)(nil),            // 26: api.hubd.pb.ListOrgsResponse
-	(*RemoveOrgRequest)(nil),            // 27: api.hubd.pb.RemoveOrgRequest
-	(*RemoveOrgResponse)(nil),           // 28: api.hubd.pb.RemoveOrgResponse
-	(*InviteToOrgRequest)(nil),          // 29: api.hubd.pb.InviteToOrgRequest
-	(*InviteToOrgResponse)(nil),         // 30: api.hubd.pb.InviteToOrgResponse
-	(*LeaveOrgRequest)(nil),             // 31: api.hubd.pb.LeaveOrgRequest
-	(*LeaveOrgResponse)(nil),            // 32: api.hubd.pb.LeaveOrgResponse
-	(*SetupBillingRequest)(nil),         // 33: api.hubd.pb.SetupBillingRequest
-	(*SetupBillingResponse)(nil),        // 34: api.hubd.pb.SetupBillingResponse
-	(*GetBillingSessionRequest)(nil),    // 35: api.hubd.pb.GetBillingSessionRequest
-	(*GetBillingSessionResponse)(nil),   // 36: api.hubd.pb.GetBillingSessionResponse
-	(*ListBillingUsersRequest)(nil),     // 37: api.hubd.pb.ListBillingUsersRequest
-	(*ListBillingUsersResponse)(nil),    // 38: api.hubd.pb.ListBillingUsersResponse
-	(*IsUsernameAvailableRequest)(nil),  // 39: api.hubd.pb.IsUsernameAvailableRequest
-	(*IsUsernameAvailableResponse)(nil), // 40: api.hubd.pb.IsUsernameAvailableResponse
-	(*IsOrgNameAvailableRequest)(nil),   // 41: api.hubd.pb.IsOrgNameAvailableRequest
-	(*IsOrgNameAvailableResponse)(nil),  // 42: api.hubd.pb.IsOrgNameAvailableResponse
-	(*DestroyAccountRequest)(nil),       // 43: api.hubd.pb.DestroyAccountRequest
-	(*DestroyAccountResponse)(nil),      // 44: api.hubd.pb.DestroyAccountResponse
-	(*OrgInfo_Member)(nil),              // 45: api.hubd.pb.OrgInfo.Member
-	(*pb.GetCustomerResponse)(nil),      // 46: api.billingd.pb.GetCustomerResponse
+func (x *OrgInfo) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
 }
-var file_api_hubd_pb_hubd_proto_depIdxs = []int32{
-	0,  // 0: api.hubd.pb.KeyInfo.type:type_name -> api.hubd.pb.KeyType
-	0,  // 1: api.hubd.pb.CreateKeyRequest.type:type_name -> api.hubd.pb.KeyType
-	13, // 2: api.hubd.pb.CreateKeyResponse.key_info:type_name -> api.hubd.pb.KeyInfo
-	13, // 3: api.hubd.pb.ListKeysResponse.list:type_name -> api.hubd.pb.KeyInfo
-	45, // 4: api.hubd.pb.OrgInfo.members:type_name -> api.hubd.pb.OrgInfo.Member
-	20, // 5: api.hubd.pb.CreateOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
-	20, // 6: api.hubd.pb.GetOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
-	20, // 7: api.hubd.pb.ListOrgsResponse.list:type_name -> api.hubd.pb.OrgInfo
-	46, // 8: api.hubd.pb.ListBillingUsersResponse.users:type_name -> api.billingd.pb.GetCustomerResponse
-	1,  // 9: api.hubd.pb.APIService.BuildInfo:input_type -> api.hubd.pb.BuildInfoRequest
-	3,  // 10: api.hubd.pb.APIService.Signup:input_type -> api.hubd.pb.SignupRequest
-	5,  // 11: api.hubd.pb.APIService.Signin:input_type -> api.hubd.pb.SigninRequest
-	7,  // 12: api.hubd.pb.APIService.Signout:input_type -> api.hubd.pb.SignoutRequest
-	9,  // 13: api.hubd.pb.APIService.GetSessionInfo:input_type -> api.hubd.pb.GetSessionInfoRequest
-	11, // 14: api.hubd.pb.APIService.GetIdentity:input_type -> api.hubd.pb.GetIdentityRequest
-	14, // 15: api.hubd.pb.APIService.CreateKey:input_type -> api.hubd.pb.CreateKeyRequest
-	18, // 16: api.hubd.pb.APIService.ListKeys:input_type -> api.hubd.pb.ListKeysRequest
-	16, // 17: api.hubd.pb.APIService.InvalidateKey:input_type -> api.hubd.pb.InvalidateKeyRequest
-	21, // 18: api.hubd.pb.APIService.CreateOrg:input_type -> api.hubd.pb.CreateOrgRequest
-	23, // 19: api.hubd.pb.APIService.GetOrg:input_type -> api.hubd.pb.GetOrgRequest
-	25, // 20: api.hubd.pb.APIService.ListOrgs:input_type -> api.hubd.pb.ListOrgsRequest
-	27, // 21: api.hubd.pb.APIService.RemoveOrg:input_type -> api.hubd.pb.RemoveOrgRequest
-	29, // 22: api.hubd.pb.APIService.InviteToOrg:input_type -> api.hubd.pb.InviteToOrgRequest
-	31, // 23: api.hubd.pb.APIService.LeaveOrg:input_type -> api.hubd.pb.LeaveOrgRequest
-	33, // 24: api.hubd.pb.APIService.SetupBilling:input_type -> api.hubd.pb.SetupBillingRequest
-	35, // 25: api.hubd.pb.APIService.GetBillingSession:input_type -> api.hubd.pb.GetBillingSessionRequest
-	37, // 26: api.hubd.pb.APIService.ListBillingUsers:input_type -> api.hubd.pb.ListBillingUsersRequest
-	39, // 27: api.hubd.pb.APIService.IsUsernameAvailable:input_type -> api.hubd.pb.IsUsernameAvailableRequest
-	41, // 28: api.hubd.pb.APIService.IsOrgNameAvailable:input_type -> api.hubd.pb.IsOrgNameAvailableRequest
-	43, // 29: api.hubd.pb.APIService.DestroyAccount:input_type -> api.hubd.pb.DestroyAccountRequest
-	2,  // 30: api.hubd.pb.APIService.BuildInfo:output_type -> api.hubd.pb.BuildInfoResponse
-	4,  // 31: api.hubd.pb.APIService.Signup:output_type -> api.hubd.pb.SignupResponse
-	6,  // 32: api.hubd.pb.APIService.Signin:output_type -> api.hubd.pb.SigninResponse
-	8,  // 33: api.hubd.pb.APIService.Signout:output_type -> api.hubd.pb.SignoutResponse
-	10, // 34: api.hubd.pb.APIService.GetSessionInfo:output_type -> api.hubd.pb.GetSessionInfoResponse
-	12, // 35: api.hubd.pb.APIService.GetIdentity:output_type -> api.hubd.pb.GetIdentityResponse
-	15, // 36: api.hubd.pb.APIService.CreateKey:output_type -> api.hubd.pb.CreateKeyResponse
-	19, // 37: api.hubd.pb.APIService.ListKeys:output_type -> api.hubd.pb.ListKeysResponse
-	17, // 38: api.hubd.pb.APIService.InvalidateKey:output_type -> api.hubd.pb.InvalidateKeyResponse
-	22, // 39: api.hubd.pb.APIService.CreateOrg:output_type -> api.hubd.pb.CreateOrgResponse
-	24, // 40: api.hubd.pb.APIService.GetOrg:output_type -> api.hubd.pb.GetOrgResponse
-	26, // 41: api.hubd.pb.APIService.ListOrgs:output_type -> api.hubd.pb.ListOrgsResponse
-	28, // 42: api.hubd.pb.APIService.RemoveOrg:output_type -> api.hubd.pb.RemoveOrgResponse
-	30, // 43: api.hubd.pb.APIService.InviteToOrg:output_type -> api.hubd.pb.InviteToOrgResponse
-	32, // 44: api.hubd.pb.APIService.LeaveOrg:output_type -> api.hubd.pb.LeaveOrgResponse
-	34, // 45: api.hubd.pb.APIService.SetupBilling:output_type -> api.hubd.pb.SetupBillingResponse
-	36, // 46: api.hubd.pb.APIService.GetBillingSession:output_type -> api.hubd.pb.GetBillingSessionResponse
-	38, // 47: api.hubd.pb.APIService.ListBillingUsers:output_type -> api.hubd.pb.ListBillingUsersResponse
-	40, // 48: api.hubd.pb.APIService.IsUsernameAvailable:output_type -> api.hubd.pb.IsUsernameAvailableResponse
-	42, // 49: api.hubd.pb.APIService.IsOrgNameAvailable:output_type -> api.hubd.pb.IsOrgNameAvailableResponse
-	44, // 50: api.hubd.pb.APIService.DestroyAccount:output_type -> api.hubd.pb.DestroyAccountResponse
-	30, // [30:51] is the sub-list for method output_type
-	9,  // [9:30] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+type CreateOrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func init() { file_api_hubd_pb_hubd_proto_init() }
-func file_api_hubd_pb_hubd_proto_init() {
-	if File_api_hubd_pb_hubd_proto != nil {
-		return
+func (x *CreateOrgRequest) Reset() {
+	*x = CreateOrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_hubd_pb_hubd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildInfoRequest); i {
+}
+
+func (x *CreateOrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrgRequest) ProtoMessage() {}
+
+func (x *CreateOrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrgRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrgRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateOrgRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOrgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgInfo *OrgInfo `protobuf:"bytes,1,opt,name=org_info,json=orgInfo,proto3" json:"org_info,omitempty"`
+}
+
+func (x *CreateOrgResponse) Reset() {
+	*x = CreateOrgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrgResponse) ProtoMessage() {}
+
+func (x *CreateOrgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrgResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrgResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateOrgResponse) GetOrgInfo() *OrgInfo {
+	if x != nil {
+		return x.OrgInfo
+	}
+	return nil
+}
+
+type GetOrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOrgRequest) Reset() {
+	*x = GetOrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgRequest) ProtoMessage() {}
+
+func (x *GetOrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{47}
+}
+
+type GetOrgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgInfo *OrgInfo `protobuf:"bytes,1,opt,name=org_info,json=orgInfo,proto3" json:"org_info,omitempty"`
+}
+
+func (x *GetOrgResponse) Reset() {
+	*x = GetOrgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgResponse) ProtoMessage() {}
+
+func (x *GetOrgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetOrgResponse) GetOrgInfo() *OrgInfo {
+	if x != nil {
+		return x.OrgInfo
+	}
+	return nil
+}
+
+type ListOrgsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOrgsRequest) Reset() {
+	*x = ListOrgsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgsRequest) ProtoMessage() {}
+
+func (x *ListOrgsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrgsRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{49}
+}
+
+type ListOrgsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*OrgInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListOrgsResponse) Reset() {
+	*x = ListOrgsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgsResponse) ProtoMessage() {}
+
+func (x *ListOrgsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrgsResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListOrgsResponse) GetList() []*OrgInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type RemoveOrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveOrgRequest) Reset() {
+	*x = RemoveOrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgRequest) ProtoMessage() {}
+
+func (x *RemoveOrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrgRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{51}
+}
+
+type RemoveOrgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveOrgResponse) Reset() {
+	*x = RemoveOrgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgResponse) ProtoMessage() {}
+
+func (x *RemoveOrgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrgResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{52}
+}
+
+type InviteToOrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *InviteToOrgRequest) Reset() {
+	*x = InviteToOrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToOrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToOrgRequest) ProtoMessage() {}
+
+func (x *InviteToOrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToOrgRequest.ProtoReflect.Descriptor instead.
+func (*InviteToOrgRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *InviteToOrgRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteToOrgRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type InviteToOrgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *InviteToOrgResponse) Reset() {
+	*x = InviteToOrgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToOrgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToOrgResponse) ProtoMessage() {}
+
+func (x *InviteToOrgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToOrgResponse.ProtoReflect.Descriptor instead.
+func (*InviteToOrgResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InviteToOrgResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type InviteInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Org        string `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	From       []byte `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	EmailTo    string `protobuf:"bytes,4,opt,name=email_to,json=emailTo,proto3" json:"email_to,omitempty"`
+	UsernameTo string `protobuf:"bytes,5,opt,name=username_to,json=usernameTo,proto3" json:"username_to,omitempty"`
+	Accepted   bool   `protobuf:"varint,6,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SentAt     int64  `protobuf:"varint,8,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+}
+
+func (x *InviteInfo) Reset() {
+	*x = InviteInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteInfo) ProtoMessage() {}
+
+func (x *InviteInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteInfo.ProtoReflect.Descriptor instead.
+func (*InviteInfo) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InviteInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InviteInfo) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *InviteInfo) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *InviteInfo) GetEmailTo() string {
+	if x != nil {
+		return x.EmailTo
+	}
+	return ""
+}
+
+func (x *InviteInfo) GetUsernameTo() string {
+	if x != nil {
+		return x.UsernameTo
+	}
+	return ""
+}
+
+func (x *InviteInfo) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *InviteInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *InviteInfo) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{56}
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*InviteInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListInvitesResponse) GetList() []*InviteInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ResendInviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ResendInviteRequest) Reset() {
+	*x = ResendInviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResendInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInviteRequest) ProtoMessage() {}
+
+func (x *ResendInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInviteRequest.ProtoReflect.Descriptor instead.
+func (*ResendInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResendInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResendInviteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invite *InviteInfo `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+}
+
+func (x *ResendInviteResponse) Reset() {
+	*x = ResendInviteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResendInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInviteResponse) ProtoMessage() {}
+
+func (x *ResendInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInviteResponse.ProtoReflect.Descriptor instead.
+func (*ResendInviteResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResendInviteResponse) GetInvite() *InviteInfo {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RevokeInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{61}
+}
+
+type LeaveOrgRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveOrgRequest) Reset() {
+	*x = LeaveOrgRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveOrgRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveOrgRequest) ProtoMessage() {}
+
+func (x *LeaveOrgRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveOrgRequest.ProtoReflect.Descriptor instead.
+func (*LeaveOrgRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{62}
+}
+
+type LeaveOrgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveOrgResponse) Reset() {
+	*x = LeaveOrgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveOrgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveOrgResponse) ProtoMessage() {}
+
+func (x *LeaveOrgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveOrgResponse.ProtoReflect.Descriptor instead.
+func (*LeaveOrgResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{63}
+}
+
+type TransferOrgOwnershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *TransferOrgOwnershipRequest) Reset() {
+	*x = TransferOrgOwnershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOrgOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOrgOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOrgOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOrgOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOrgOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TransferOrgOwnershipRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type TransferOrgOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresAt int64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *TransferOrgOwnershipResponse) Reset() {
+	*x = TransferOrgOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferOrgOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOrgOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOrgOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOrgOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOrgOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TransferOrgOwnershipResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AcceptOrgOwnershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Org string `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+}
+
+func (x *AcceptOrgOwnershipRequest) Reset() {
+	*x = AcceptOrgOwnershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptOrgOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrgOwnershipRequest) ProtoMessage() {}
+
+func (x *AcceptOrgOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrgOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrgOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AcceptOrgOwnershipRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+type AcceptOrgOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgInfo *OrgInfo `protobuf:"bytes,1,opt,name=org_info,json=orgInfo,proto3" json:"org_info,omitempty"`
+}
+
+func (x *AcceptOrgOwnershipResponse) Reset() {
+	*x = AcceptOrgOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptOrgOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrgOwnershipResponse) ProtoMessage() {}
+
+func (x *AcceptOrgOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrgOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrgOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AcceptOrgOwnershipResponse) GetOrgInfo() *OrgInfo {
+	if x != nil {
+		return x.OrgInfo
+	}
+	return nil
+}
+
+type SetOrgMemberRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role     string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SetOrgMemberRoleRequest) Reset() {
+	*x = SetOrgMemberRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetOrgMemberRoleRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetOrgMemberRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOrgMemberRoleResponse) Reset() {
+	*x = SetOrgMemberRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{69}
+}
+
+type RemoveOrgMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *RemoveOrgMemberRequest) Reset() {
+	*x = RemoveOrgMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrgMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RemoveOrgMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RemoveOrgMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveOrgMemberResponse) Reset() {
+	*x = RemoveOrgMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberResponse) ProtoMessage() {}
+
+func (x *RemoveOrgMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{71}
+}
+
+type SetOrgSSORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer       string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Required     bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *SetOrgSSORequest) Reset() {
+	*x = SetOrgSSORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgSSORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgSSORequest) ProtoMessage() {}
+
+func (x *SetOrgSSORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgSSORequest.ProtoReflect.Descriptor instead.
+func (*SetOrgSSORequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetOrgSSORequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *SetOrgSSORequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SetOrgSSORequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *SetOrgSSORequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetOrgSSOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOrgSSOResponse) Reset() {
+	*x = SetOrgSSOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgSSOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgSSOResponse) ProtoMessage() {}
+
+func (x *SetOrgSSOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgSSOResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgSSOResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{73}
+}
+
+type GetOrgSSORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOrgSSORequest) Reset() {
+	*x = GetOrgSSORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrgSSORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgSSORequest) ProtoMessage() {}
+
+func (x *GetOrgSSORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgSSORequest.ProtoReflect.Descriptor instead.
+func (*GetOrgSSORequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{74}
+}
+
+type GetOrgSSOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer   string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Required bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *GetOrgSSOResponse) Reset() {
+	*x = GetOrgSSOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrgSSOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgSSOResponse) ProtoMessage() {}
+
+func (x *GetOrgSSOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgSSOResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgSSOResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetOrgSSOResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *GetOrgSSOResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetOrgSSOResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetOrgTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *SetOrgTwoFactorRequest) Reset() {
+	*x = SetOrgTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgTwoFactorRequest) ProtoMessage() {}
+
+func (x *SetOrgTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*SetOrgTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetOrgTwoFactorRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetOrgTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOrgTwoFactorResponse) Reset() {
+	*x = SetOrgTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgTwoFactorResponse) ProtoMessage() {}
+
+func (x *SetOrgTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetOrgTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{77}
+}
+
+type SetupBillingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetupBillingRequest) Reset() {
+	*x = SetupBillingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupBillingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupBillingRequest) ProtoMessage() {}
+
+func (x *SetupBillingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupBillingRequest.ProtoReflect.Descriptor instead.
+func (*SetupBillingRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{78}
+}
+
+type SetupBillingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetupBillingResponse) Reset() {
+	*x = SetupBillingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupBillingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupBillingResponse) ProtoMessage() {}
+
+func (x *SetupBillingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupBillingResponse.ProtoReflect.Descriptor instead.
+func (*SetupBillingResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{79}
+}
+
+type GetBillingSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBillingSessionRequest) Reset() {
+	*x = GetBillingSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillingSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingSessionRequest) ProtoMessage() {}
+
+func (x *GetBillingSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetBillingSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{80}
+}
+
+type GetBillingSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *GetBillingSessionResponse) Reset() {
+	*x = GetBillingSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillingSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingSessionResponse) ProtoMessage() {}
+
+func (x *GetBillingSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetBillingSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetBillingSessionResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ListBillingUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListBillingUsersRequest) Reset() {
+	*x = ListBillingUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillingUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillingUsersRequest) ProtoMessage() {}
+
+func (x *ListBillingUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillingUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBillingUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListBillingUsersRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListBillingUsersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBillingUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*pb.GetCustomerResponse `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextOffset int64                     `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+}
+
+func (x *ListBillingUsersResponse) Reset() {
+	*x = ListBillingUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillingUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillingUsersResponse) ProtoMessage() {}
+
+func (x *ListBillingUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillingUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBillingUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListBillingUsersResponse) GetUsers() []*pb.GetCustomerResponse {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBillingUsersResponse) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+type IsUsernameAvailableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *IsUsernameAvailableRequest) Reset() {
+	*x = IsUsernameAvailableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsUsernameAvailableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsUsernameAvailableRequest) ProtoMessage() {}
+
+func (x *IsUsernameAvailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsUsernameAvailableRequest.ProtoReflect.Descriptor instead.
+func (*IsUsernameAvailableRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *IsUsernameAvailableRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type IsUsernameAvailableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IsUsernameAvailableResponse) Reset() {
+	*x = IsUsernameAvailableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsUsernameAvailableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsUsernameAvailableResponse) ProtoMessage() {}
+
+func (x *IsUsernameAvailableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsUsernameAvailableResponse.ProtoReflect.Descriptor instead.
+func (*IsUsernameAvailableResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{85}
+}
+
+type IsOrgNameAvailableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *IsOrgNameAvailableRequest) Reset() {
+	*x = IsOrgNameAvailableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOrgNameAvailableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOrgNameAvailableRequest) ProtoMessage() {}
+
+func (x *IsOrgNameAvailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOrgNameAvailableRequest.ProtoReflect.Descriptor instead.
+func (*IsOrgNameAvailableRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *IsOrgNameAvailableRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type IsOrgNameAvailableResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *IsOrgNameAvailableResponse) Reset() {
+	*x = IsOrgNameAvailableResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsOrgNameAvailableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsOrgNameAvailableResponse) ProtoMessage() {}
+
+func (x *IsOrgNameAvailableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsOrgNameAvailableResponse.ProtoReflect.Descriptor instead.
+func (*IsOrgNameAvailableResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *IsOrgNameAvailableResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *IsOrgNameAvailableResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ExportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status    ExportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.hubd.pb.ExportStatus" json:"status,omitempty"`
+	Stage     string       `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Done      int32        `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Total     int32        `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Size      int64        `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Error     string       `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt int64        `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt int64        `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ExportInfo) Reset() {
+	*x = ExportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInfo) ProtoMessage() {}
+
+func (x *ExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInfo.ProtoReflect.Descriptor instead.
+func (*ExportInfo) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetStatus() ExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExportStatus_EXPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ExportInfo) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ExportInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ExportAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportAccountRequest) Reset() {
+	*x = ExportAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountRequest) ProtoMessage() {}
+
+func (x *ExportAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{89}
+}
+
+type ExportAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Export *ExportInfo `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *ExportAccountResponse) Reset() {
+	*x = ExportAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountResponse) ProtoMessage() {}
+
+func (x *ExportAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExportAccountResponse) GetExport() *ExportInfo {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetExportRequest) Reset() {
+	*x = GetExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportRequest) ProtoMessage() {}
+
+func (x *GetExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportRequest.ProtoReflect.Descriptor instead.
+func (*GetExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetExportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Export *ExportInfo `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *GetExportResponse) Reset() {
+	*x = GetExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportResponse) ProtoMessage() {}
+
+func (x *GetExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportResponse.ProtoReflect.Descriptor instead.
+func (*GetExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetExportResponse) GetExport() *ExportInfo {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type DownloadExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DownloadExportRequest) Reset() {
+	*x = DownloadExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExportRequest) ProtoMessage() {}
+
+func (x *DownloadExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DownloadExportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DownloadExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *DownloadExportResponse) Reset() {
+	*x = DownloadExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadExportResponse) ProtoMessage() {}
+
+func (x *DownloadExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DownloadExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type DestroyAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DestroyAccountRequest) Reset() {
+	*x = DestroyAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestroyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyAccountRequest) ProtoMessage() {}
+
+func (x *DestroyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DestroyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{95}
+}
+
+type DestroyAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DestroyAccountResponse) Reset() {
+	*x = DestroyAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestroyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyAccountResponse) ProtoMessage() {}
+
+func (x *DestroyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DestroyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{96}
+}
+
+type OrgInfo_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *OrgInfo_Member) Reset() {
+	*x = OrgInfo_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgInfo_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgInfo_Member) ProtoMessage() {}
+
+func (x *OrgInfo_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgInfo_Member.ProtoReflect.Descriptor instead.
+func (*OrgInfo_Member) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *OrgInfo_Member) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *OrgInfo_Member) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OrgInfo_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type OrgInfo_Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUsername string `protobuf:"bytes,1,opt,name=from_username,json=fromUsername,proto3" json:"from_username,omitempty"`
+	ToUsername   string `protobuf:"bytes,2,opt,name=to_username,json=toUsername,proto3" json:"to_username,omitempty"`
+	ExpiresAt    int64  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *OrgInfo_Transfer) Reset() {
+	*x = OrgInfo_Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_hubd_pb_hubd_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgInfo_Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgInfo_Transfer) ProtoMessage() {}
+
+func (x *OrgInfo_Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_hubd_pb_hubd_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgInfo_Transfer.ProtoReflect.Descriptor instead.
+func (*OrgInfo_Transfer) Descriptor() ([]byte, []int) {
+	return file_api_hubd_pb_hubd_proto_rawDescGZIP(), []int{44, 1}
+}
+
+func (x *OrgInfo_Transfer) GetFromUsername() string {
+	if x != nil {
+		return x.FromUsername
+	}
+	return ""
+}
+
+func (x *OrgInfo_Transfer) GetToUsername() string {
+	if x != nil {
+		return x.ToUsername
+	}
+	return ""
+}
+
+func (x *OrgInfo_Transfer) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+var File_api_hubd_pb_hubd_proto protoreflect.FileDescriptor
+
+var file_api_hubd_pb_hubd_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75, 0x62, 0x64, 0x2f, 0x70, 0x62, 0x2f, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x64, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x11, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69,
+	0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3c, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x53, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x49, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x6c, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x71, 0x0a,
+	0x11, 0x50, 0x6f, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x9e, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x53, 0x4f, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x53, 0x4f, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x61,
+	0x0a, 0x10, 0x53, 0x53, 0x4f, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x53, 0x4f, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x10, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a,
+	0x12, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x0b,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x73,
+	0x6f, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x73, 0x6f, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a,
+	0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x17, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2d, 0x0a, 0x17,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x18, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2d,
+	0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x1a, 0x0a,
+	0x18, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x31, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0xe4, 0x03, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x1a,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x1c, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x70,
+	0x73, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x28, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd5, 0x03, 0x0a, 0x07, 0x4f,
+	0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x4a, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x1a, 0x6f, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x72, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f,
+	0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46,
+	0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x22, 0x14,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65,
+	0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x2b,
+	0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f,
+	0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x1b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x22, 0x2d, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72,
+	0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6f, 0x72, 0x67, 0x22, 0x4d, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x67,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x4f, 0x72, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1a, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53,
+	0x53, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x4f, 0x72, 0x67, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x2d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0x47, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x38, 0x0a, 0x1a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x49,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x49, 0x73,
+	0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x1a, 0x49,
+	0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x22, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2e, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x86, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c,
+	0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f,
+	0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x07,
+	0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4b, 0x45, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4b, 0x45, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x99, 0x01, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xaa, 0x1f, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6f, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x53, 0x4f, 0x55, 0x52, 0x4c,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x53, 0x4f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x53, 0x4f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x53, 0x4f, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x12, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x4f,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x4f, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
+	0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x12, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72,
+	0x67, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72,
+	0x67, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x4f, 0x72, 0x67, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75,
+	0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4f,
+	0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72,
+	0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f,
+	0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f,
+	0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x26, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f, 0x12, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67,
+	0x53, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53,
+	0x53, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53,
+	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x53, 0x53, 0x4f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x4f, 0x72, 0x67, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4f,
+	0x72, 0x67, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x75, 0x70, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x13, 0x49, 0x73,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4f, 0x72, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x73, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62,
+	0x64, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x75, 0x62, 0x64, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x78, 0x74,
+	0x69, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x75, 0x62, 0x64, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_hubd_pb_hubd_proto_rawDescOnce sync.Once
+	file_api_hubd_pb_hubd_proto_rawDescData = file_api_hubd_pb_hubd_proto_rawDesc
+)
+
+func file_api_hubd_pb_hubd_proto_rawDescGZIP() []byte {
+	file_api_hubd_pb_hubd_proto_rawDescOnce.Do(func() {
+		file_api_hubd_pb_hubd_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_hubd_pb_hubd_proto_rawDescData)
+	})
+	return file_api_hubd_pb_hubd_proto_rawDescData
+}
+
+var file_api_hubd_pb_hubd_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_hubd_pb_hubd_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_api_hubd_pb_hubd_proto_goTypes = []interface{}{
+	(LoginStatus)(0),                     // 0: api.hubd.pb.LoginStatus
+	(KeyType)(0),                         // 1: api.hubd.pb.KeyType
+	(ExportStatus)(0),                    // 2: api.hubd.pb.ExportStatus
+	(*BuildInfoRequest)(nil),             // 3: api.hubd.pb.BuildInfoRequest
+	(*BuildInfoResponse)(nil),            // 4: api.hubd.pb.BuildInfoResponse
+	(*SignupRequest)(nil),                // 5: api.hubd.pb.SignupRequest
+	(*SignupResponse)(nil),               // 6: api.hubd.pb.SignupResponse
+	(*SigninRequest)(nil),                // 7: api.hubd.pb.SigninRequest
+	(*SigninResponse)(nil),               // 8: api.hubd.pb.SigninResponse
+	(*StartSignupRequest)(nil),           // 9: api.hubd.pb.StartSignupRequest
+	(*StartSignupResponse)(nil),          // 10: api.hubd.pb.StartSignupResponse
+	(*StartSigninRequest)(nil),           // 11: api.hubd.pb.StartSigninRequest
+	(*StartSigninResponse)(nil),          // 12: api.hubd.pb.StartSigninResponse
+	(*PollLoginRequest)(nil),             // 13: api.hubd.pb.PollLoginRequest
+	(*PollLoginResponse)(nil),            // 14: api.hubd.pb.PollLoginResponse
+	(*GetSSOURLRequest)(nil),             // 15: api.hubd.pb.GetSSOURLRequest
+	(*GetSSOURLResponse)(nil),            // 16: api.hubd.pb.GetSSOURLResponse
+	(*SSOSigninRequest)(nil),             // 17: api.hubd.pb.SSOSigninRequest
+	(*SSOSigninResponse)(nil),            // 18: api.hubd.pb.SSOSigninResponse
+	(*SignoutRequest)(nil),               // 19: api.hubd.pb.SignoutRequest
+	(*SignoutResponse)(nil),              // 20: api.hubd.pb.SignoutResponse
+	(*GetSessionInfoRequest)(nil),        // 21: api.hubd.pb.GetSessionInfoRequest
+	(*GetSessionInfoResponse)(nil),       // 22: api.hubd.pb.GetSessionInfoResponse
+	(*SessionInfo)(nil),                  // 23: api.hubd.pb.SessionInfo
+	(*ListSessionsRequest)(nil),          // 24: api.hubd.pb.ListSessionsRequest
+	(*ListSessionsResponse)(nil),         // 25: api.hubd.pb.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),         // 26: api.hubd.pb.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),        // 27: api.hubd.pb.RevokeSessionResponse
+	(*RevokeAllSessionsRequest)(nil),     // 28: api.hubd.pb.RevokeAllSessionsRequest
+	(*RevokeAllSessionsResponse)(nil),    // 29: api.hubd.pb.RevokeAllSessionsResponse
+	(*EnableTwoFactorRequest)(nil),       // 30: api.hubd.pb.EnableTwoFactorRequest
+	(*EnableTwoFactorResponse)(nil),      // 31: api.hubd.pb.EnableTwoFactorResponse
+	(*ConfirmTwoFactorRequest)(nil),      // 32: api.hubd.pb.ConfirmTwoFactorRequest
+	(*ConfirmTwoFactorResponse)(nil),     // 33: api.hubd.pb.ConfirmTwoFactorResponse
+	(*DisableTwoFactorRequest)(nil),      // 34: api.hubd.pb.DisableTwoFactorRequest
+	(*DisableTwoFactorResponse)(nil),     // 35: api.hubd.pb.DisableTwoFactorResponse
+	(*GetIdentityRequest)(nil),           // 36: api.hubd.pb.GetIdentityRequest
+	(*GetIdentityResponse)(nil),          // 37: api.hubd.pb.GetIdentityResponse
+	(*KeyInfo)(nil),                      // 38: api.hubd.pb.KeyInfo
+	(*CreateKeyRequest)(nil),             // 39: api.hubd.pb.CreateKeyRequest
+	(*CreateKeyResponse)(nil),            // 40: api.hubd.pb.CreateKeyResponse
+	(*InvalidateKeyRequest)(nil),         // 41: api.hubd.pb.InvalidateKeyRequest
+	(*InvalidateKeyResponse)(nil),        // 42: api.hubd.pb.InvalidateKeyResponse
+	(*RotateKeyRequest)(nil),             // 43: api.hubd.pb.RotateKeyRequest
+	(*RotateKeyResponse)(nil),            // 44: api.hubd.pb.RotateKeyResponse
+	(*ListKeysRequest)(nil),              // 45: api.hubd.pb.ListKeysRequest
+	(*ListKeysResponse)(nil),             // 46: api.hubd.pb.ListKeysResponse
+	(*OrgInfo)(nil),                      // 47: api.hubd.pb.OrgInfo
+	(*CreateOrgRequest)(nil),             // 48: api.hubd.pb.CreateOrgRequest
+	(*CreateOrgResponse)(nil),            // 49: api.hubd.pb.CreateOrgResponse
+	(*GetOrgRequest)(nil),                // 50: api.hubd.pb.GetOrgRequest
+	(*GetOrgResponse)(nil),               // 51: api.hubd.pb.GetOrgResponse
+	(*ListOrgsRequest)(nil),              // 52: api.hubd.pb.ListOrgsRequest
+	(*ListOrgsResponse)(nil),             // 53: api.hubd.pb.ListOrgsResponse
+	(*RemoveOrgRequest)(nil),             // 54: api.hubd.pb.RemoveOrgRequest
+	(*RemoveOrgResponse)(nil),            // 55: api.hubd.pb.RemoveOrgResponse
+	(*InviteToOrgRequest)(nil),           // 56: api.hubd.pb.InviteToOrgRequest
+	(*InviteToOrgResponse)(nil),          // 57: api.hubd.pb.InviteToOrgResponse
+	(*InviteInfo)(nil),                   // 58: api.hubd.pb.InviteInfo
+	(*ListInvitesRequest)(nil),           // 59: api.hubd.pb.ListInvitesRequest
+	(*ListInvitesResponse)(nil),          // 60: api.hubd.pb.ListInvitesResponse
+	(*ResendInviteRequest)(nil),          // 61: api.hubd.pb.ResendInviteRequest
+	(*ResendInviteResponse)(nil),         // 62: api.hubd.pb.ResendInviteResponse
+	(*RevokeInviteRequest)(nil),          // 63: api.hubd.pb.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),         // 64: api.hubd.pb.RevokeInviteResponse
+	(*LeaveOrgRequest)(nil),              // 65: api.hubd.pb.LeaveOrgRequest
+	(*LeaveOrgResponse)(nil),             // 66: api.hubd.pb.LeaveOrgResponse
+	(*TransferOrgOwnershipRequest)(nil),  // 67: api.hubd.pb.TransferOrgOwnershipRequest
+	(*TransferOrgOwnershipResponse)(nil), // 68: api.hubd.pb.TransferOrgOwnershipResponse
+	(*AcceptOrgOwnershipRequest)(nil),    // 69: api.hubd.pb.AcceptOrgOwnershipRequest
+	(*AcceptOrgOwnershipResponse)(nil),   // 70: api.hubd.pb.AcceptOrgOwnershipResponse
+	(*SetOrgMemberRoleRequest)(nil),      // 71: api.hubd.pb.SetOrgMemberRoleRequest
+	(*SetOrgMemberRoleResponse)(nil),     // 72: api.hubd.pb.SetOrgMemberRoleResponse
+	(*RemoveOrgMemberRequest)(nil),       // 73: api.hubd.pb.RemoveOrgMemberRequest
+	(*RemoveOrgMemberResponse)(nil),      // 74: api.hubd.pb.RemoveOrgMemberResponse
+	(*SetOrgSSORequest)(nil),             // 75: api.hubd.pb.SetOrgSSORequest
+	(*SetOrgSSOResponse)(nil),            // 76: api.hubd.pb.SetOrgSSOResponse
+	(*GetOrgSSORequest)(nil),             // 77: api.hubd.pb.GetOrgSSORequest
+	(*GetOrgSSOResponse)(nil),            // 78: api.hubd.pb.GetOrgSSOResponse
+	(*SetOrgTwoFactorRequest)(nil),       // 79: api.hubd.pb.SetOrgTwoFactorRequest
+	(*SetOrgTwoFactorResponse)(nil),      // 80: api.hubd.pb.SetOrgTwoFactorResponse
+	(*SetupBillingRequest)(nil),          // 81: api.hubd.pb.SetupBillingRequest
+	(*SetupBillingResponse)(nil),         // 82: api.hubd.pb.SetupBillingResponse
+	(*GetBillingSessionRequest)(nil),     // 83: api.hubd.pb.GetBillingSessionRequest
+	(*GetBillingSessionResponse)(nil),    // 84: api.hubd.pb.GetBillingSessionResponse
+	(*ListBillingUsersRequest)(nil),      // 85: api.hubd.pb.ListBillingUsersRequest
+	(*ListBillingUsersResponse)(nil),     // 86: api.hubd.pb.ListBillingUsersResponse
+	(*IsUsernameAvailableRequest)(nil),   // 87: api.hubd.pb.IsUsernameAvailableRequest
+	(*IsUsernameAvailableResponse)(nil),  // 88: api.hubd.pb.IsUsernameAvailableResponse
+	(*IsOrgNameAvailableRequest)(nil),    // 89: api.hubd.pb.IsOrgNameAvailableRequest
+	(*IsOrgNameAvailableResponse)(nil),   // 90: api.hubd.pb.IsOrgNameAvailableResponse
+	(*ExportInfo)(nil),                   // 91: api.hubd.pb.ExportInfo
+	(*ExportAccountRequest)(nil),         // 92: api.hubd.pb.ExportAccountRequest
+	(*ExportAccountResponse)(nil),        // 93: api.hubd.pb.ExportAccountResponse
+	(*GetExportRequest)(nil),             // 94: api.hubd.pb.GetExportRequest
+	(*GetExportResponse)(nil),            // 95: api.hubd.pb.GetExportResponse
+	(*DownloadExportRequest)(nil),        // 96: api.hubd.pb.DownloadExportRequest
+	(*DownloadExportResponse)(nil),       // 97: api.hubd.pb.DownloadExportResponse
+	(*DestroyAccountRequest)(nil),        // 98: api.hubd.pb.DestroyAccountRequest
+	(*DestroyAccountResponse)(nil),       // 99: api.hubd.pb.DestroyAccountResponse
+	(*OrgInfo_Member)(nil),               // 100: api.hubd.pb.OrgInfo.Member
+	(*OrgInfo_Transfer)(nil),             // 101: api.hubd.pb.OrgInfo.Transfer
+	(*pb.GetCustomerResponse)(nil),       // 102: api.billingd.pb.GetCustomerResponse
+}
+var file_api_hubd_pb_hubd_proto_depIdxs = []int32{
+	0,   // 0: api.hubd.pb.PollLoginResponse.status:type_name -> api.hubd.pb.LoginStatus
+	23,  // 1: api.hubd.pb.ListSessionsResponse.list:type_name -> api.hubd.pb.SessionInfo
+	1,   // 2: api.hubd.pb.KeyInfo.type:type_name -> api.hubd.pb.KeyType
+	1,   // 3: api.hubd.pb.CreateKeyRequest.type:type_name -> api.hubd.pb.KeyType
+	38,  // 4: api.hubd.pb.CreateKeyResponse.key_info:type_name -> api.hubd.pb.KeyInfo
+	38,  // 5: api.hubd.pb.RotateKeyResponse.key_info:type_name -> api.hubd.pb.KeyInfo
+	38,  // 6: api.hubd.pb.ListKeysResponse.list:type_name -> api.hubd.pb.KeyInfo
+	100, // 7: api.hubd.pb.OrgInfo.members:type_name -> api.hubd.pb.OrgInfo.Member
+	101, // 8: api.hubd.pb.OrgInfo.transfer:type_name -> api.hubd.pb.OrgInfo.Transfer
+	47,  // 9: api.hubd.pb.CreateOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
+	47,  // 10: api.hubd.pb.GetOrgResponse.org_info:type_name -> api.hubd.pb.OrgInfo
+	47,  // 11: api.hubd.pb.ListOrgsResponse.list:type_name -> api.hubd.pb.OrgInfo
+	58,  // 12: api.hubd.pb.ListInvitesResponse.list:type_name -> api.hubd.pb.InviteInfo
+	58,  // 13: api.hubd.pb.ResendInviteResponse.invite:type_name -> api.hubd.pb.InviteInfo
+	47,  // 14: api.hubd.pb.AcceptOrgOwnershipResponse.org_info:type_name -> api.hubd.pb.OrgInfo
+	102, // 15: api.hubd.pb.ListBillingUsersResponse.users:type_name -> api.billingd.pb.GetCustomerResponse
+	2,   // 16: api.hubd.pb.ExportInfo.status:type_name -> api.hubd.pb.ExportStatus
+	91,  // 17: api.hubd.pb.ExportAccountResponse.export:type_name -> api.hubd.pb.ExportInfo
+	91,  // 18: api.hubd.pb.GetExportResponse.export:type_name -> api.hubd.pb.ExportInfo
+	3,   // 19: api.hubd.pb.APIService.BuildInfo:input_type -> api.hubd.pb.BuildInfoRequest
+	5,   // 20: api.hubd.pb.APIService.Signup:input_type -> api.hubd.pb.SignupRequest
+	7,   // 21: api.hubd.pb.APIService.Signin:input_type -> api.hubd.pb.SigninRequest
+	9,   // 22: api.hubd.pb.APIService.StartSignup:input_type -> api.hubd.pb.StartSignupRequest
+	11,  // 23: api.hubd.pb.APIService.StartSignin:input_type -> api.hubd.pb.StartSigninRequest
+	13,  // 24: api.hubd.pb.APIService.PollLogin:input_type -> api.hubd.pb.PollLoginRequest
+	15,  // 25: api.hubd.pb.APIService.GetSSOURL:input_type -> api.hubd.pb.GetSSOURLRequest
+	17,  // 26: api.hubd.pb.APIService.SSOSignin:input_type -> api.hubd.pb.SSOSigninRequest
+	19,  // 27: api.hubd.pb.APIService.Signout:input_type -> api.hubd.pb.SignoutRequest
+	21,  // 28: api.hubd.pb.APIService.GetSessionInfo:input_type -> api.hubd.pb.GetSessionInfoRequest
+	24,  // 29: api.hubd.pb.APIService.ListSessions:input_type -> api.hubd.pb.ListSessionsRequest
+	26,  // 30: api.hubd.pb.APIService.RevokeSession:input_type -> api.hubd.pb.RevokeSessionRequest
+	28,  // 31: api.hubd.pb.APIService.RevokeAllSessions:input_type -> api.hubd.pb.RevokeAllSessionsRequest
+	30,  // 32: api.hubd.pb.APIService.EnableTwoFactor:input_type -> api.hubd.pb.EnableTwoFactorRequest
+	32,  // 33: api.hubd.pb.APIService.ConfirmTwoFactor:input_type -> api.hubd.pb.ConfirmTwoFactorRequest
+	34,  // 34: api.hubd.pb.APIService.DisableTwoFactor:input_type -> api.hubd.pb.DisableTwoFactorRequest
+	36,  // 35: api.hubd.pb.APIService.GetIdentity:input_type -> api.hubd.pb.GetIdentityRequest
+	39,  // 36: api.hubd.pb.APIService.CreateKey:input_type -> api.hubd.pb.CreateKeyRequest
+	45,  // 37: api.hubd.pb.APIService.ListKeys:input_type -> api.hubd.pb.ListKeysRequest
+	41,  // 38: api.hubd.pb.APIService.InvalidateKey:input_type -> api.hubd.pb.InvalidateKeyRequest
+	43,  // 39: api.hubd.pb.APIService.RotateKey:input_type -> api.hubd.pb.RotateKeyRequest
+	48,  // 40: api.hubd.pb.APIService.CreateOrg:input_type -> api.hubd.pb.CreateOrgRequest
+	50,  // 41: api.hubd.pb.APIService.GetOrg:input_type -> api.hubd.pb.GetOrgRequest
+	52,  // 42: api.hubd.pb.APIService.ListOrgs:input_type -> api.hubd.pb.ListOrgsRequest
+	54,  // 43: api.hubd.pb.APIService.RemoveOrg:input_type -> api.hubd.pb.RemoveOrgRequest
+	56,  // 44: api.hubd.pb.APIService.InviteToOrg:input_type -> api.hubd.pb.InviteToOrgRequest
+	59,  // 45: api.hubd.pb.APIService.ListInvites:input_type -> api.hubd.pb.ListInvitesRequest
+	61,  // 46: api.hubd.pb.APIService.ResendInvite:input_type -> api.hubd.pb.ResendInviteRequest
+	63,  // 47: api.hubd.pb.APIService.RevokeInvite:input_type -> api.hubd.pb.RevokeInviteRequest
+	65,  // 48: api.hubd.pb.APIService.LeaveOrg:input_type -> api.hubd.pb.LeaveOrgRequest
+	71,  // 49: api.hubd.pb.APIService.SetOrgMemberRole:input_type -> api.hubd.pb.SetOrgMemberRoleRequest
+	73,  // 50: api.hubd.pb.APIService.RemoveOrgMember:input_type -> api.hubd.pb.RemoveOrgMemberRequest
+	67,  // 51: api.hubd.pb.APIService.TransferOrgOwnership:input_type -> api.hubd.pb.TransferOrgOwnershipRequest
+	69,  // 52: api.hubd.pb.APIService.AcceptOrgOwnership:input_type -> api.hubd.pb.AcceptOrgOwnershipRequest
+	75,  // 53: api.hubd.pb.APIService.SetOrgSSO:input_type -> api.hubd.pb.SetOrgSSORequest
+	77,  // 54: api.hubd.pb.APIService.GetOrgSSO:input_type -> api.hubd.pb.GetOrgSSORequest
+	79,  // 55: api.hubd.pb.APIService.SetOrgTwoFactor:input_type -> api.hubd.pb.SetOrgTwoFactorRequest
+	81,  // 56: api.hubd.pb.APIService.SetupBilling:input_type -> api.hubd.pb.SetupBillingRequest
+	83,  // 57: api.hubd.pb.APIService.GetBillingSession:input_type -> api.hubd.pb.GetBillingSessionRequest
+	85,  // 58: api.hubd.pb.APIService.ListBillingUsers:input_type -> api.hubd.pb.ListBillingUsersRequest
+	87,  // 59: api.hubd.pb.APIService.IsUsernameAvailable:input_type -> api.hubd.pb.IsUsernameAvailableRequest
+	89,  // 60: api.hubd.pb.APIService.IsOrgNameAvailable:input_type -> api.hubd.pb.IsOrgNameAvailableRequest
+	92,  // 61: api.hubd.pb.APIService.ExportAccount:input_type -> api.hubd.pb.ExportAccountRequest
+	94,  // 62: api.hubd.pb.APIService.GetExport:input_type -> api.hubd.pb.GetExportRequest
+	96,  // 63: api.hubd.pb.APIService.DownloadExport:input_type -> api.hubd.pb.DownloadExportRequest
+	98,  // 64: api.hubd.pb.APIService.DestroyAccount:input_type -> api.hubd.pb.DestroyAccountRequest
+	4,   // 65: api.hubd.pb.APIService.BuildInfo:output_type -> api.hubd.pb.BuildInfoResponse
+	6,   // 66: api.hubd.pb.APIService.Signup:output_type -> api.hubd.pb.SignupResponse
+	8,   // 67: api.hubd.pb.APIService.Signin:output_type -> api.hubd.pb.SigninResponse
+	10,  // 68: api.hubd.pb.APIService.StartSignup:output_type -> api.hubd.pb.StartSignupResponse
+	12,  // 69: api.hubd.pb.APIService.StartSignin:output_type -> api.hubd.pb.StartSigninResponse
+	14,  // 70: api.hubd.pb.APIService.PollLogin:output_type -> api.hubd.pb.PollLoginResponse
+	16,  // 71: api.hubd.pb.APIService.GetSSOURL:output_type -> api.hubd.pb.GetSSOURLResponse
+	18,  // 72: api.hubd.pb.APIService.SSOSignin:output_type -> api.hubd.pb.SSOSigninResponse
+	20,  // 73: api.hubd.pb.APIService.Signout:output_type -> api.hubd.pb.SignoutResponse
+	22,  // 74: api.hubd.pb.APIService.GetSessionInfo:output_type -> api.hubd.pb.GetSessionInfoResponse
+	25,  // 75: api.hubd.pb.APIService.ListSessions:output_type -> api.hubd.pb.ListSessionsResponse
+	27,  // 76: api.hubd.pb.APIService.RevokeSession:output_type -> api.hubd.pb.RevokeSessionResponse
+	29,  // 77: api.hubd.pb.APIService.RevokeAllSessions:output_type -> api.hubd.pb.RevokeAllSessionsResponse
+	31,  // 78: api.hubd.pb.APIService.EnableTwoFactor:output_type -> api.hubd.pb.EnableTwoFactorResponse
+	33,  // 79: api.hubd.pb.APIService.ConfirmTwoFactor:output_type -> api.hubd.pb.ConfirmTwoFactorResponse
+	35,  // 80: api.hubd.pb.APIService.DisableTwoFactor:output_type -> api.hubd.pb.DisableTwoFactorResponse
+	37,  // 81: api.hubd.pb.APIService.GetIdentity:output_type -> api.hubd.pb.GetIdentityResponse
+	40,  // 82: api.hubd.pb.APIService.CreateKey:output_type -> api.hubd.pb.CreateKeyResponse
+	46,  // 83: api.hubd.pb.APIService.ListKeys:output_type -> api.hubd.pb.ListKeysResponse
+	42,  // 84: api.hubd.pb.APIService.InvalidateKey:output_type -> api.hubd.pb.InvalidateKeyResponse
+	44,  // 85: api.hubd.pb.APIService.RotateKey:output_type -> api.hubd.pb.RotateKeyResponse
+	49,  // 86: api.hubd.pb.APIService.CreateOrg:output_type -> api.hubd.pb.CreateOrgResponse
+	51,  // 87: api.hubd.pb.APIService.GetOrg:output_type -> api.hubd.pb.GetOrgResponse
+	53,  // 88: api.hubd.pb.APIService.ListOrgs:output_type -> api.hubd.pb.ListOrgsResponse
+	55,  // 89: api.hubd.pb.APIService.RemoveOrg:output_type -> api.hubd.pb.RemoveOrgResponse
+	57,  // 90: api.hubd.pb.APIService.InviteToOrg:output_type -> api.hubd.pb.InviteToOrgResponse
+	60,  // 91: api.hubd.pb.APIService.ListInvites:output_type -> api.hubd.pb.ListInvitesResponse
+	62,  // 92: api.hubd.pb.APIService.ResendInvite:output_type -> api.hubd.pb.ResendInviteResponse
+	64,  // 93: api.hubd.pb.APIService.RevokeInvite:output_type -> api.hubd.pb.RevokeInviteResponse
+	66,  // 94: api.hubd.pb.APIService.LeaveOrg:output_type -> api.hubd.pb.LeaveOrgResponse
+	72,  // 95: api.hubd.pb.APIService.SetOrgMemberRole:output_type -> api.hubd.pb.SetOrgMemberRoleResponse
+	74,  // 96: api.hubd.pb.APIService.RemoveOrgMember:output_type -> api.hubd.pb.RemoveOrgMemberResponse
+	68,  // 97: api.hubd.pb.APIService.TransferOrgOwnership:output_type -> api.hubd.pb.TransferOrgOwnershipResponse
+	70,  // 98: api.hubd.pb.APIService.AcceptOrgOwnership:output_type -> api.hubd.pb.AcceptOrgOwnershipResponse
+	76,  // 99: api.hubd.pb.APIService.SetOrgSSO:output_type -> api.hubd.pb.SetOrgSSOResponse
+	78,  // 100: api.hubd.pb.APIService.GetOrgSSO:output_type -> api.hubd.pb.GetOrgSSOResponse
+	80,  // 101: api.hubd.pb.APIService.SetOrgTwoFactor:output_type -> api.hubd.pb.SetOrgTwoFactorResponse
+	82,  // 102: api.hubd.pb.APIService.SetupBilling:output_type -> api.hubd.pb.SetupBillingResponse
+	84,  // 103: api.hubd.pb.APIService.GetBillingSession:output_type -> api.hubd.pb.GetBillingSessionResponse
+	86,  // 104: api.hubd.pb.APIService.ListBillingUsers:output_type -> api.hubd.pb.ListBillingUsersResponse
+	88,  // 105: api.hubd.pb.APIService.IsUsernameAvailable:output_type -> api.hubd.pb.IsUsernameAvailableResponse
+	90,  // 106: api.hubd.pb.APIService.IsOrgNameAvailable:output_type -> api.hubd.pb.IsOrgNameAvailableResponse
+	93,  // 107: api.hubd.pb.APIService.ExportAccount:output_type -> api.hubd.pb.ExportAccountResponse
+	95,  // 108: api.hubd.pb.APIService.GetExport:output_type -> api.hubd.pb.GetExportResponse
+	97,  // 109: api.hubd.pb.APIService.DownloadExport:output_type -> api.hubd.pb.DownloadExportResponse
+	99,  // 110: api.hubd.pb.APIService.DestroyAccount:output_type -> api.hubd.pb.DestroyAccountResponse
+	65,  // [65:111] is the sub-list for method output_type
+	19,  // [19:65] is the sub-list for method input_type
+	19,  // [19:19] is the sub-list for extension type_name
+	19,  // [19:19] is the sub-list for extension extendee
+	0,   // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_api_hubd_pb_hubd_proto_init() }
+func file_api_hubd_pb_hubd_proto_init() {
+	if File_api_hubd_pb_hubd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_hubd_pb_hubd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigninRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigninResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSignupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSignupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSigninRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSigninResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSSOURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSSOURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSOSigninRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSOSigninResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAllSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAllSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2653,8 +6646,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildInfoResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2665,8 +6658,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignupRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2677,8 +6670,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignupResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2689,8 +6682,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigninRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2701,8 +6694,260 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigninResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrgsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrgsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToOrgRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToOrgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_hubd_pb_hubd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2713,8 +6958,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignoutRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2725,8 +6970,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignoutResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2737,8 +6982,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionInfoRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveOrgRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2749,8 +6994,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionInfoResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveOrgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2761,8 +7006,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIdentityRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOrgOwnershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2773,8 +7018,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIdentityResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOrgOwnershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2785,8 +7030,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyInfo); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptOrgOwnershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2797,8 +7042,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateKeyRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptOrgOwnershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2809,8 +7054,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateKeyResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgMemberRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2821,8 +7066,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvalidateKeyRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgMemberRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2833,8 +7078,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvalidateKeyResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrgMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2845,8 +7090,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListKeysRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrgMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2857,8 +7102,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListKeysResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgSSORequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2869,8 +7114,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgInfo); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgSSOResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2881,8 +7126,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrgRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgSSORequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2893,8 +7138,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrgResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgSSOResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2905,8 +7150,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgTwoFactorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2917,8 +7162,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOrgTwoFactorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2929,8 +7174,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrgsRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupBillingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2941,8 +7186,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrgsResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupBillingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2953,8 +7198,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveOrgRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillingSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2965,8 +7210,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveOrgResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillingSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2977,8 +7222,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToOrgRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillingUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2989,8 +7234,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToOrgResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillingUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3001,8 +7246,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveOrgRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsUsernameAvailableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3013,8 +7258,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveOrgResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsUsernameAvailableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3025,8 +7270,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupBillingRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOrgNameAvailableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3037,8 +7282,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupBillingResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsOrgNameAvailableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3049,8 +7294,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBillingSessionRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3061,8 +7306,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBillingSessionResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3073,8 +7318,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBillingUsersRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3085,8 +7330,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBillingUsersResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3097,8 +7342,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsUsernameAvailableRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3109,8 +7354,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsUsernameAvailableResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3121,8 +7366,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsOrgNameAvailableRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3133,8 +7378,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsOrgNameAvailableResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3145,8 +7390,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyAccountRequest); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3157,8 +7402,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyAccountResponse); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgInfo_Member); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3169,8 +7414,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 				return nil
 			}
 		}
-		file_api_hubd_pb_hubd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgInfo_Member); i {
+		file_api_hubd_pb_hubd_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgInfo_Transfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3187,8 +7432,8 @@ func file_api_hubd_pb_hubd_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_hubd_pb_hubd_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   45,
+			NumEnums:      3,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3218,23 +7463,48 @@ type APIServiceClient interface {
 	BuildInfo(ctx context.Context, in *BuildInfoRequest, opts ...grpc.CallOption) (*BuildInfoResponse, error)
 	Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error)
 	Signin(ctx context.Context, in *SigninRequest, opts ...grpc.CallOption) (*SigninResponse, error)
+	StartSignup(ctx context.Context, in *StartSignupRequest, opts ...grpc.CallOption) (*StartSignupResponse, error)
+	StartSignin(ctx context.Context, in *StartSigninRequest, opts ...grpc.CallOption) (*StartSigninResponse, error)
+	PollLogin(ctx context.Context, in *PollLoginRequest, opts ...grpc.CallOption) (*PollLoginResponse, error)
+	GetSSOURL(ctx context.Context, in *GetSSOURLRequest, opts ...grpc.CallOption) (*GetSSOURLResponse, error)
+	SSOSignin(ctx context.Context, in *SSOSigninRequest, opts ...grpc.CallOption) (*SSOSigninResponse, error)
 	Signout(ctx context.Context, in *SignoutRequest, opts ...grpc.CallOption) (*SignoutResponse, error)
 	GetSessionInfo(ctx context.Context, in *GetSessionInfoRequest, opts ...grpc.CallOption) (*GetSessionInfoResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
+	EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
 	GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error)
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error)
 	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
 	InvalidateKey(ctx context.Context, in *InvalidateKeyRequest, opts ...grpc.CallOption) (*InvalidateKeyResponse, error)
+	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error)
 	CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*CreateOrgResponse, error)
 	GetOrg(ctx context.Context, in *GetOrgRequest, opts ...grpc.CallOption) (*GetOrgResponse, error)
 	ListOrgs(ctx context.Context, in *ListOrgsRequest, opts ...grpc.CallOption) (*ListOrgsResponse, error)
 	RemoveOrg(ctx context.Context, in *RemoveOrgRequest, opts ...grpc.CallOption) (*RemoveOrgResponse, error)
 	InviteToOrg(ctx context.Context, in *InviteToOrgRequest, opts ...grpc.CallOption) (*InviteToOrgResponse, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*ResendInviteResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
 	LeaveOrg(ctx context.Context, in *LeaveOrgRequest, opts ...grpc.CallOption) (*LeaveOrgResponse, error)
+	SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleRequest, opts ...grpc.CallOption) (*SetOrgMemberRoleResponse, error)
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*RemoveOrgMemberResponse, error)
+	TransferOrgOwnership(ctx context.Context, in *TransferOrgOwnershipRequest, opts ...grpc.CallOption) (*TransferOrgOwnershipResponse, error)
+	AcceptOrgOwnership(ctx context.Context, in *AcceptOrgOwnershipRequest, opts ...grpc.CallOption) (*AcceptOrgOwnershipResponse, error)
+	SetOrgSSO(ctx context.Context, in *SetOrgSSORequest, opts ...grpc.CallOption) (*SetOrgSSOResponse, error)
+	GetOrgSSO(ctx context.Context, in *GetOrgSSORequest, opts ...grpc.CallOption) (*GetOrgSSOResponse, error)
+	SetOrgTwoFactor(ctx context.Context, in *SetOrgTwoFactorRequest, opts ...grpc.CallOption) (*SetOrgTwoFactorResponse, error)
 	SetupBilling(ctx context.Context, in *SetupBillingRequest, opts ...grpc.CallOption) (*SetupBillingResponse, error)
 	GetBillingSession(ctx context.Context, in *GetBillingSessionRequest, opts ...grpc.CallOption) (*GetBillingSessionResponse, error)
 	ListBillingUsers(ctx context.Context, in *ListBillingUsersRequest, opts ...grpc.CallOption) (*ListBillingUsersResponse, error)
 	IsUsernameAvailable(ctx context.Context, in *IsUsernameAvailableRequest, opts ...grpc.CallOption) (*IsUsernameAvailableResponse, error)
 	IsOrgNameAvailable(ctx context.Context, in *IsOrgNameAvailableRequest, opts ...grpc.CallOption) (*IsOrgNameAvailableResponse, error)
+	ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (*ExportAccountResponse, error)
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportResponse, error)
+	DownloadExport(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (APIService_DownloadExportClient, error)
 	DestroyAccount(ctx context.Context, in *DestroyAccountRequest, opts ...grpc.CallOption) (*DestroyAccountResponse, error)
 }
 
@@ -3273,6 +7543,51 @@ func (c *aPIServiceClient) Signin(ctx context.Context, in *SigninRequest, opts .
 	return out, nil
 }
 
+func (c *aPIServiceClient) StartSignup(ctx context.Context, in *StartSignupRequest, opts ...grpc.CallOption) (*StartSignupResponse, error) {
+	out := new(StartSignupResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/StartSignup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) StartSignin(ctx context.Context, in *StartSigninRequest, opts ...grpc.CallOption) (*StartSigninResponse, error) {
+	out := new(StartSigninResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/StartSignin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) PollLogin(ctx context.Context, in *PollLoginRequest, opts ...grpc.CallOption) (*PollLoginResponse, error) {
+	out := new(PollLoginResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/PollLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetSSOURL(ctx context.Context, in *GetSSOURLRequest, opts ...grpc.CallOption) (*GetSSOURLResponse, error) {
+	out := new(GetSSOURLResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetSSOURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SSOSignin(ctx context.Context, in *SSOSigninRequest, opts ...grpc.CallOption) (*SSOSigninResponse, error) {
+	out := new(SSOSigninResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/SSOSignin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) Signout(ctx context.Context, in *SignoutRequest, opts ...grpc.CallOption) (*SignoutResponse, error) {
 	out := new(SignoutResponse)
 	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/Signout", in, out, opts...)
@@ -3282,99 +7597,252 @@ func (c *aPIServiceClient) Signout(ctx context.Context, in *SignoutRequest, opts
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetSessionInfo(ctx context.Context, in *GetSessionInfoRequest, opts ...grpc.CallOption) (*GetSessionInfoResponse, error) {
-	out := new(GetSessionInfoResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetSessionInfo", in, out, opts...)
+func (c *aPIServiceClient) GetSessionInfo(ctx context.Context, in *GetSessionInfoRequest, opts ...grpc.CallOption) (*GetSessionInfoResponse, error) {
+	out := new(GetSessionInfoResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetSessionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RevokeAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error) {
+	out := new(EnableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/EnableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error) {
+	out := new(ConfirmTwoFactorResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ConfirmTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/DisableTwoFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error) {
+	out := new(GetIdentityResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error) {
+	out := new(CreateKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/CreateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InvalidateKey(ctx context.Context, in *InvalidateKeyRequest, opts ...grpc.CallOption) (*InvalidateKeyResponse, error) {
+	out := new(InvalidateKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/InvalidateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error) {
+	out := new(RotateKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RotateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*CreateOrgResponse, error) {
+	out := new(CreateOrgResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/CreateOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetOrg(ctx context.Context, in *GetOrgRequest, opts ...grpc.CallOption) (*GetOrgResponse, error) {
+	out := new(GetOrgResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListOrgs(ctx context.Context, in *ListOrgsRequest, opts ...grpc.CallOption) (*ListOrgsResponse, error) {
+	out := new(ListOrgsResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListOrgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RemoveOrg(ctx context.Context, in *RemoveOrgRequest, opts ...grpc.CallOption) (*RemoveOrgResponse, error) {
+	out := new(RemoveOrgResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RemoveOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InviteToOrg(ctx context.Context, in *InviteToOrgRequest, opts ...grpc.CallOption) (*InviteToOrgResponse, error) {
+	out := new(InviteToOrgResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/InviteToOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListInvites", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetIdentity(ctx context.Context, in *GetIdentityRequest, opts ...grpc.CallOption) (*GetIdentityResponse, error) {
-	out := new(GetIdentityResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetIdentity", in, out, opts...)
+func (c *aPIServiceClient) ResendInvite(ctx context.Context, in *ResendInviteRequest, opts ...grpc.CallOption) (*ResendInviteResponse, error) {
+	out := new(ResendInviteResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ResendInvite", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error) {
-	out := new(CreateKeyResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/CreateKey", in, out, opts...)
+func (c *aPIServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RevokeInvite", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
-	out := new(ListKeysResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListKeys", in, out, opts...)
+func (c *aPIServiceClient) LeaveOrg(ctx context.Context, in *LeaveOrgRequest, opts ...grpc.CallOption) (*LeaveOrgResponse, error) {
+	out := new(LeaveOrgResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/LeaveOrg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) InvalidateKey(ctx context.Context, in *InvalidateKeyRequest, opts ...grpc.CallOption) (*InvalidateKeyResponse, error) {
-	out := new(InvalidateKeyResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/InvalidateKey", in, out, opts...)
+func (c *aPIServiceClient) SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleRequest, opts ...grpc.CallOption) (*SetOrgMemberRoleResponse, error) {
+	out := new(SetOrgMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/SetOrgMemberRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*CreateOrgResponse, error) {
-	out := new(CreateOrgResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/CreateOrg", in, out, opts...)
+func (c *aPIServiceClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*RemoveOrgMemberResponse, error) {
+	out := new(RemoveOrgMemberResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RemoveOrgMember", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) GetOrg(ctx context.Context, in *GetOrgRequest, opts ...grpc.CallOption) (*GetOrgResponse, error) {
-	out := new(GetOrgResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetOrg", in, out, opts...)
+func (c *aPIServiceClient) TransferOrgOwnership(ctx context.Context, in *TransferOrgOwnershipRequest, opts ...grpc.CallOption) (*TransferOrgOwnershipResponse, error) {
+	out := new(TransferOrgOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/TransferOrgOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) ListOrgs(ctx context.Context, in *ListOrgsRequest, opts ...grpc.CallOption) (*ListOrgsResponse, error) {
-	out := new(ListOrgsResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ListOrgs", in, out, opts...)
+func (c *aPIServiceClient) AcceptOrgOwnership(ctx context.Context, in *AcceptOrgOwnershipRequest, opts ...grpc.CallOption) (*AcceptOrgOwnershipResponse, error) {
+	out := new(AcceptOrgOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/AcceptOrgOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) RemoveOrg(ctx context.Context, in *RemoveOrgRequest, opts ...grpc.CallOption) (*RemoveOrgResponse, error) {
-	out := new(RemoveOrgResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/RemoveOrg", in, out, opts...)
+func (c *aPIServiceClient) SetOrgSSO(ctx context.Context, in *SetOrgSSORequest, opts ...grpc.CallOption) (*SetOrgSSOResponse, error) {
+	out := new(SetOrgSSOResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/SetOrgSSO", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) InviteToOrg(ctx context.Context, in *InviteToOrgRequest, opts ...grpc.CallOption) (*InviteToOrgResponse, error) {
-	out := new(InviteToOrgResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/InviteToOrg", in, out, opts...)
+func (c *aPIServiceClient) GetOrgSSO(ctx context.Context, in *GetOrgSSORequest, opts ...grpc.CallOption) (*GetOrgSSOResponse, error) {
+	out := new(GetOrgSSOResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetOrgSSO", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *aPIServiceClient) LeaveOrg(ctx context.Context, in *LeaveOrgRequest, opts ...grpc.CallOption) (*LeaveOrgResponse, error) {
-	out := new(LeaveOrgResponse)
-	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/LeaveOrg", in, out, opts...)
+func (c *aPIServiceClient) SetOrgTwoFactor(ctx context.Context, in *SetOrgTwoFactorRequest, opts ...grpc.CallOption) (*SetOrgTwoFactorResponse, error) {
+	out := new(SetOrgTwoFactorResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/SetOrgTwoFactor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3426,6 +7894,56 @@ func (c *aPIServiceClient) IsOrgNameAvailable(ctx context.Context, in *IsOrgName
 	return out, nil
 }
 
+func (c *aPIServiceClient) ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (*ExportAccountResponse, error) {
+	out := new(ExportAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/ExportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportResponse, error) {
+	out := new(GetExportResponse)
+	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/GetExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DownloadExport(ctx context.Context, in *DownloadExportRequest, opts ...grpc.CallOption) (APIService_DownloadExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/api.hubd.pb.APIService/DownloadExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceDownloadExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_DownloadExportClient interface {
+	Recv() (*DownloadExportResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceDownloadExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceDownloadExportClient) Recv() (*DownloadExportResponse, error) {
+	m := new(DownloadExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIServiceClient) DestroyAccount(ctx context.Context, in *DestroyAccountRequest, opts ...grpc.CallOption) (*DestroyAccountResponse, error) {
 	out := new(DestroyAccountResponse)
 	err := c.cc.Invoke(ctx, "/api.hubd.pb.APIService/DestroyAccount", in, out, opts...)
@@ -3440,23 +7958,48 @@ type APIServiceServer interface {
 	BuildInfo(context.Context, *BuildInfoRequest) (*BuildInfoResponse, error)
 	Signup(context.Context, *SignupRequest) (*SignupResponse, error)
 	Signin(context.Context, *SigninRequest) (*SigninResponse, error)
+	StartSignup(context.Context, *StartSignupRequest) (*StartSignupResponse, error)
+	StartSignin(context.Context, *StartSigninRequest) (*StartSigninResponse, error)
+	PollLogin(context.Context, *PollLoginRequest) (*PollLoginResponse, error)
+	GetSSOURL(context.Context, *GetSSOURLRequest) (*GetSSOURLResponse, error)
+	SSOSignin(context.Context, *SSOSigninRequest) (*SSOSigninResponse, error)
 	Signout(context.Context, *SignoutRequest) (*SignoutResponse, error)
 	GetSessionInfo(context.Context, *GetSessionInfoRequest) (*GetSessionInfoResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
+	EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error)
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
 	GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error)
 	CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error)
 	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
 	InvalidateKey(context.Context, *InvalidateKeyRequest) (*InvalidateKeyResponse, error)
+	RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error)
 	CreateOrg(context.Context, *CreateOrgRequest) (*CreateOrgResponse, error)
 	GetOrg(context.Context, *GetOrgRequest) (*GetOrgResponse, error)
 	ListOrgs(context.Context, *ListOrgsRequest) (*ListOrgsResponse, error)
 	RemoveOrg(context.Context, *RemoveOrgRequest) (*RemoveOrgResponse, error)
 	InviteToOrg(context.Context, *InviteToOrgRequest) (*InviteToOrgResponse, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	ResendInvite(context.Context, *ResendInviteRequest) (*ResendInviteResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
 	LeaveOrg(context.Context, *LeaveOrgRequest) (*LeaveOrgResponse, error)
+	SetOrgMemberRole(context.Context, *SetOrgMemberRoleRequest) (*SetOrgMemberRoleResponse, error)
+	RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*RemoveOrgMemberResponse, error)
+	TransferOrgOwnership(context.Context, *TransferOrgOwnershipRequest) (*TransferOrgOwnershipResponse, error)
+	AcceptOrgOwnership(context.Context, *AcceptOrgOwnershipRequest) (*AcceptOrgOwnershipResponse, error)
+	SetOrgSSO(context.Context, *SetOrgSSORequest) (*SetOrgSSOResponse, error)
+	GetOrgSSO(context.Context, *GetOrgSSORequest) (*GetOrgSSOResponse, error)
+	SetOrgTwoFactor(context.Context, *SetOrgTwoFactorRequest) (*SetOrgTwoFactorResponse, error)
 	SetupBilling(context.Context, *SetupBillingRequest) (*SetupBillingResponse, error)
 	GetBillingSession(context.Context, *GetBillingSessionRequest) (*GetBillingSessionResponse, error)
 	ListBillingUsers(context.Context, *ListBillingUsersRequest) (*ListBillingUsersResponse, error)
 	IsUsernameAvailable(context.Context, *IsUsernameAvailableRequest) (*IsUsernameAvailableResponse, error)
 	IsOrgNameAvailable(context.Context, *IsOrgNameAvailableRequest) (*IsOrgNameAvailableResponse, error)
+	ExportAccount(context.Context, *ExportAccountRequest) (*ExportAccountResponse, error)
+	GetExport(context.Context, *GetExportRequest) (*GetExportResponse, error)
+	DownloadExport(*DownloadExportRequest, APIService_DownloadExportServer) error
 	DestroyAccount(context.Context, *DestroyAccountRequest) (*DestroyAccountResponse, error)
 }
 
@@ -3473,12 +8016,45 @@ func (*UnimplementedAPIServiceServer) Signup(context.Context, *SignupRequest) (*
 func (*UnimplementedAPIServiceServer) Signin(context.Context, *SigninRequest) (*SigninResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signin not implemented")
 }
+func (*UnimplementedAPIServiceServer) StartSignup(context.Context, *StartSignupRequest) (*StartSignupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSignup not implemented")
+}
+func (*UnimplementedAPIServiceServer) StartSignin(context.Context, *StartSigninRequest) (*StartSigninResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSignin not implemented")
+}
+func (*UnimplementedAPIServiceServer) PollLogin(context.Context, *PollLoginRequest) (*PollLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PollLogin not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetSSOURL(context.Context, *GetSSOURLRequest) (*GetSSOURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSSOURL not implemented")
+}
+func (*UnimplementedAPIServiceServer) SSOSignin(context.Context, *SSOSigninRequest) (*SSOSigninResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSOSignin not implemented")
+}
 func (*UnimplementedAPIServiceServer) Signout(context.Context, *SignoutRequest) (*SignoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Signout not implemented")
 }
 func (*UnimplementedAPIServiceServer) GetSessionInfo(context.Context, *GetSessionInfoRequest) (*GetSessionInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionInfo not implemented")
 }
+func (*UnimplementedAPIServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedAPIServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (*UnimplementedAPIServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (*UnimplementedAPIServiceServer) EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTwoFactor not implemented")
+}
+func (*UnimplementedAPIServiceServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (*UnimplementedAPIServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
 func (*UnimplementedAPIServiceServer) GetIdentity(context.Context, *GetIdentityRequest) (*GetIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIdentity not implemented")
 }
@@ -3491,6 +8067,9 @@ func (*UnimplementedAPIServiceServer) ListKeys(context.Context, *ListKeysRequest
 func (*UnimplementedAPIServiceServer) InvalidateKey(context.Context, *InvalidateKeyRequest) (*InvalidateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateKey not implemented")
 }
+func (*UnimplementedAPIServiceServer) RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
 func (*UnimplementedAPIServiceServer) CreateOrg(context.Context, *CreateOrgRequest) (*CreateOrgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrg not implemented")
 }
@@ -3506,9 +8085,39 @@ func (*UnimplementedAPIServiceServer) RemoveOrg(context.Context, *RemoveOrgReque
 func (*UnimplementedAPIServiceServer) InviteToOrg(context.Context, *InviteToOrgRequest) (*InviteToOrgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InviteToOrg not implemented")
 }
+func (*UnimplementedAPIServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (*UnimplementedAPIServiceServer) ResendInvite(context.Context, *ResendInviteRequest) (*ResendInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvite not implemented")
+}
+func (*UnimplementedAPIServiceServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
 func (*UnimplementedAPIServiceServer) LeaveOrg(context.Context, *LeaveOrgRequest) (*LeaveOrgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveOrg not implemented")
 }
+func (*UnimplementedAPIServiceServer) SetOrgMemberRole(context.Context, *SetOrgMemberRoleRequest) (*SetOrgMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgMemberRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*RemoveOrgMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (*UnimplementedAPIServiceServer) TransferOrgOwnership(context.Context, *TransferOrgOwnershipRequest) (*TransferOrgOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOrgOwnership not implemented")
+}
+func (*UnimplementedAPIServiceServer) AcceptOrgOwnership(context.Context, *AcceptOrgOwnershipRequest) (*AcceptOrgOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrgOwnership not implemented")
+}
+func (*UnimplementedAPIServiceServer) SetOrgSSO(context.Context, *SetOrgSSORequest) (*SetOrgSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgSSO not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetOrgSSO(context.Context, *GetOrgSSORequest) (*GetOrgSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgSSO not implemented")
+}
+func (*UnimplementedAPIServiceServer) SetOrgTwoFactor(context.Context, *SetOrgTwoFactorRequest) (*SetOrgTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgTwoFactor not implemented")
+}
 func (*UnimplementedAPIServiceServer) SetupBilling(context.Context, *SetupBillingRequest) (*SetupBillingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetupBilling not implemented")
 }
@@ -3524,6 +8133,15 @@ func (*UnimplementedAPIServiceServer) IsUsernameAvailable(context.Context, *IsUs
 func (*UnimplementedAPIServiceServer) IsOrgNameAvailable(context.Context, *IsOrgNameAvailableRequest) (*IsOrgNameAvailableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsOrgNameAvailable not implemented")
 }
+func (*UnimplementedAPIServiceServer) ExportAccount(context.Context, *ExportAccountRequest) (*ExportAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccount not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetExport(context.Context, *GetExportRequest) (*GetExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (*UnimplementedAPIServiceServer) DownloadExport(*DownloadExportRequest, APIService_DownloadExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadExport not implemented")
+}
 func (*UnimplementedAPIServiceServer) DestroyAccount(context.Context, *DestroyAccountRequest) (*DestroyAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyAccount not implemented")
 }
@@ -3532,92 +8150,290 @@ func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
 	s.RegisterService(&_APIService_serviceDesc, srv)
 }
 
-func _APIService_BuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildInfoRequest)
+func _APIService_BuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).BuildInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/BuildInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).BuildInfo(ctx, req.(*BuildInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).Signup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/Signup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).Signup(ctx, req.(*SignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_Signin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigninRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).Signin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/Signin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).Signin(ctx, req.(*SigninRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StartSignup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSignupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).StartSignup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/StartSignup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).StartSignup(ctx, req.(*StartSignupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StartSignin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSigninRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).StartSignin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/StartSignin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).StartSignin(ctx, req.(*StartSigninRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_PollLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).PollLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/PollLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).PollLogin(ctx, req.(*PollLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetSSOURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSOURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetSSOURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/GetSSOURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetSSOURL(ctx, req.(*GetSSOURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SSOSignin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSOSigninRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SSOSignin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/SSOSignin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SSOSignin(ctx, req.(*SSOSigninRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_Signout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).Signout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/Signout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).Signout(ctx, req.(*SignoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetSessionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetSessionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/GetSessionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetSessionInfo(ctx, req.(*GetSessionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).BuildInfo(ctx, in)
+		return srv.(APIServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.hubd.pb.APIService/BuildInfo",
+		FullMethod: "/api.hubd.pb.APIService/RevokeSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).BuildInfo(ctx, req.(*BuildInfoRequest))
+		return srv.(APIServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignupRequest)
+func _APIService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).Signup(ctx, in)
+		return srv.(APIServiceServer).RevokeAllSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.hubd.pb.APIService/Signup",
+		FullMethod: "/api.hubd.pb.APIService/RevokeAllSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).Signup(ctx, req.(*SignupRequest))
+		return srv.(APIServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_Signin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SigninRequest)
+func _APIService_EnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).Signin(ctx, in)
+		return srv.(APIServiceServer).EnableTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.hubd.pb.APIService/Signin",
+		FullMethod: "/api.hubd.pb.APIService/EnableTwoFactor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).Signin(ctx, req.(*SigninRequest))
+		return srv.(APIServiceServer).EnableTwoFactor(ctx, req.(*EnableTwoFactorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_Signout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignoutRequest)
+func _APIService_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).Signout(ctx, in)
+		return srv.(APIServiceServer).ConfirmTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.hubd.pb.APIService/Signout",
+		FullMethod: "/api.hubd.pb.APIService/ConfirmTwoFactor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).Signout(ctx, req.(*SignoutRequest))
+		return srv.(APIServiceServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_GetSessionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSessionInfoRequest)
+func _APIService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).GetSessionInfo(ctx, in)
+		return srv.(APIServiceServer).DisableTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.hubd.pb.APIService/GetSessionInfo",
+		FullMethod: "/api.hubd.pb.APIService/DisableTwoFactor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).GetSessionInfo(ctx, req.(*GetSessionInfoRequest))
+		return srv.(APIServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3694,6 +8510,24 @@ func _APIService_InvalidateKey_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RotateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/RotateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RotateKey(ctx, req.(*RotateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_CreateOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOrgRequest)
 	if err := dec(in); err != nil {
@@ -3784,6 +8618,60 @@ func _APIService_InviteToOrg_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ResendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ResendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/ResendInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ResendInvite(ctx, req.(*ResendInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_LeaveOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaveOrgRequest)
 	if err := dec(in); err != nil {
@@ -3802,6 +8690,132 @@ func _APIService_LeaveOrg_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_SetOrgMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SetOrgMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/SetOrgMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SetOrgMemberRole(ctx, req.(*SetOrgMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/RemoveOrgMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TransferOrgOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOrgOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TransferOrgOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/TransferOrgOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TransferOrgOwnership(ctx, req.(*TransferOrgOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_AcceptOrgOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrgOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).AcceptOrgOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/AcceptOrgOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).AcceptOrgOwnership(ctx, req.(*AcceptOrgOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SetOrgSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SetOrgSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/SetOrgSSO",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SetOrgSSO(ctx, req.(*SetOrgSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetOrgSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrgSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetOrgSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/GetOrgSSO",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetOrgSSO(ctx, req.(*GetOrgSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SetOrgTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SetOrgTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/SetOrgTwoFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SetOrgTwoFactor(ctx, req.(*SetOrgTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_SetupBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetupBillingRequest)
 	if err := dec(in); err != nil {
@@ -3892,6 +8906,63 @@ func _APIService_IsOrgNameAvailable_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/ExportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ExportAccount(ctx, req.(*ExportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.hubd.pb.APIService/GetExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetExport(ctx, req.(*GetExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_DownloadExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).DownloadExport(m, &aPIServiceDownloadExportServer{stream})
+}
+
+type APIService_DownloadExportServer interface {
+	Send(*DownloadExportResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceDownloadExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceDownloadExportServer) Send(m *DownloadExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _APIService_DestroyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyAccountRequest)
 	if err := dec(in); err != nil {
@@ -3926,6 +8997,26 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Signin",
 			Handler:    _APIService_Signin_Handler,
 		},
+		{
+			MethodName: "StartSignup",
+			Handler:    _APIService_StartSignup_Handler,
+		},
+		{
+			MethodName: "StartSignin",
+			Handler:    _APIService_StartSignin_Handler,
+		},
+		{
+			MethodName: "PollLogin",
+			Handler:    _APIService_PollLogin_Handler,
+		},
+		{
+			MethodName: "GetSSOURL",
+			Handler:    _APIService_GetSSOURL_Handler,
+		},
+		{
+			MethodName: "SSOSignin",
+			Handler:    _APIService_SSOSignin_Handler,
+		},
 		{
 			MethodName: "Signout",
 			Handler:    _APIService_Signout_Handler,
@@ -3934,6 +9025,30 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSessionInfo",
 			Handler:    _APIService_GetSessionInfo_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _APIService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _APIService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _APIService_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "EnableTwoFactor",
+			Handler:    _APIService_EnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _APIService_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _APIService_DisableTwoFactor_Handler,
+		},
 		{
 			MethodName: "GetIdentity",
 			Handler:    _APIService_GetIdentity_Handler,
@@ -3950,6 +9065,10 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateKey",
 			Handler:    _APIService_InvalidateKey_Handler,
 		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _APIService_RotateKey_Handler,
+		},
 		{
 			MethodName: "CreateOrg",
 			Handler:    _APIService_CreateOrg_Handler,
@@ -3970,10 +9089,50 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InviteToOrg",
 			Handler:    _APIService_InviteToOrg_Handler,
 		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _APIService_ListInvites_Handler,
+		},
+		{
+			MethodName: "ResendInvite",
+			Handler:    _APIService_ResendInvite_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _APIService_RevokeInvite_Handler,
+		},
 		{
 			MethodName: "LeaveOrg",
 			Handler:    _APIService_LeaveOrg_Handler,
 		},
+		{
+			MethodName: "SetOrgMemberRole",
+			Handler:    _APIService_SetOrgMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _APIService_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "TransferOrgOwnership",
+			Handler:    _APIService_TransferOrgOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOrgOwnership",
+			Handler:    _APIService_AcceptOrgOwnership_Handler,
+		},
+		{
+			MethodName: "SetOrgSSO",
+			Handler:    _APIService_SetOrgSSO_Handler,
+		},
+		{
+			MethodName: "GetOrgSSO",
+			Handler:    _APIService_GetOrgSSO_Handler,
+		},
+		{
+			MethodName: "SetOrgTwoFactor",
+			Handler:    _APIService_SetOrgTwoFactor_Handler,
+		},
 		{
 			MethodName: "SetupBilling",
 			Handler:    _APIService_SetupBilling_Handler,
@@ -3994,11 +9153,25 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsOrgNameAvailable",
 			Handler:    _APIService_IsOrgNameAvailable_Handler,
 		},
+		{
+			MethodName: "ExportAccount",
+			Handler:    _APIService_ExportAccount_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _APIService_GetExport_Handler,
+		},
 		{
 			MethodName: "DestroyAccount",
 			Handler:    _APIService_DestroyAccount_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadExport",
+			Handler:       _APIService_DownloadExport_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/hubd/pb/hubd.proto",
 }
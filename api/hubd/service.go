@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/mail"
+	"sync"
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
@@ -12,7 +14,7 @@ import (
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	threads "github.com/textileio/go-threads/api/client"
 	"github.com/textileio/go-threads/broadcast"
-	net "github.com/textileio/go-threads/core/net"
+	corenet "github.com/textileio/go-threads/core/net"
 	"github.com/textileio/go-threads/db"
 	netclient "github.com/textileio/go-threads/net/api/client"
 	pow "github.com/textileio/powergate/v2/api/client"
@@ -25,6 +27,7 @@ import (
 	"github.com/textileio/textile/v2/email"
 	"github.com/textileio/textile/v2/ipns"
 	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/oidc"
 	tdb "github.com/textileio/textile/v2/threaddb"
 	"github.com/textileio/textile/v2/util"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -38,8 +41,9 @@ var (
 	errDevRequired = errors.New("developer account required")
 	errOrgRequired = errors.New("organization account required")
 
-	loginTimeout = time.Minute * 30
-	emailTimeout = time.Second * 10
+	// loginPollInterval is how often a pending login is rechecked in case it was completed with another hub.
+	loginPollInterval = time.Second * 5
+	emailTimeout      = time.Second * 10
 )
 
 type Service struct {
@@ -55,6 +59,19 @@ type Service struct {
 	BillingClient       *billing.Client
 	PowergateClient     *pow.Client
 	PowergateAdminToken string
+	SSOProvider         *oidc.Provider
+	Mail                *tdb.Mail
+	// InternalSession authorizes the hub's own background requests.
+	InternalSession string
+	// ExportDir is where account export archives are written. Exports are disabled if it's empty.
+	// It must be shared by all replicas, since an export can be downloaded from any of them.
+	ExportDir string
+	// Replica identifies this hub replica. Exports record the replica that runs them.
+	Replica string
+	// TrustedProxies are the networks whose x-forwarded-for headers are trusted for session device IPs.
+	TrustedProxies []*net.IPNet
+
+	ssoProviders sync.Map
 }
 
 // Info provides the currently running API's build information
@@ -72,53 +89,64 @@ func (s *Service) BuildInfo(_ context.Context, _ *pb.BuildInfoRequest) (*pb.Buil
 func (s *Service) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupResponse, error) {
 	log.Debugf("received signup request")
 
-	if err := s.Collections.Accounts.ValidateUsername(req.Username); err != nil {
-		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	login, err := s.startSignup(ctx, req.Username, req.Email)
+	if err != nil {
+		return nil, err
 	}
-	if _, err := mail.ParseAddress(req.Email); err != nil {
-		return nil, status.Error(codes.FailedPrecondition, "Email address in not valid")
+	if !s.awaitLogin(ctx, login) {
+		return nil, status.Error(codes.Unauthenticated, "Could not verify email address")
 	}
-
-	secret := getSessionSecret(s.EmailSessionSecret)
-	ectx, cancel := context.WithTimeout(ctx, emailTimeout)
-	defer cancel()
-	if err := s.EmailClient.ConfirmAddress(ectx, req.Email, req.Username, req.Email, s.GatewayURL, secret); err != nil {
+	dev, session, err := s.completeLogin(ctx, login.ID)
+	if err != nil {
 		return nil, err
 	}
-	if !s.awaitVerification(secret) {
-		return nil, status.Error(codes.Unauthenticated, "Could not verify email address")
+
+	key, err := dev.Key.MarshalBinary()
+	if err != nil {
+		return nil, err
 	}
+	return &pb.SignupResponse{
+		Key:     key,
+		Session: session.ID,
+	}, nil
+}
 
+// createDev creates a dev account with a session, and adds the dev to orgs with accepted invites.
+// ssoIssuer and ssoClientID identify the identity provider used to sign on, if any.
+func (s *Service) createDev(
+	ctx context.Context,
+	username, email, ssoIssuer, ssoClientID string,
+) (*mdb.Account, *mdb.Session, error) {
 	var powInfo *mdb.PowInfo
 	if s.PowergateClient != nil {
 		res, err := s.PowergateClient.Admin.Users.Create(s.powergateAdminCtx(ctx))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Unable to create user: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "Unable to create user: %v", err)
 		}
 		powInfo = &mdb.PowInfo{ID: res.User.Id, Token: res.User.Token}
 	}
 
-	dev, err := s.Collections.Accounts.CreateDev(ctx, req.Username, req.Email, powInfo)
+	dev, err := s.Collections.Accounts.CreateDev(ctx, username, email, powInfo)
 	if err != nil {
-		return nil, status.Error(codes.FailedPrecondition, "Account exists")
+		return nil, nil, status.Error(codes.FailedPrecondition, "Account exists")
 	}
-	session, err := s.Collections.Sessions.Create(ctx, dev.Key)
+	session, err := s.Collections.Sessions.CreateSSO(ctx, dev.Key, ssoIssuer, ssoClientID, s.sessionDevice(ctx))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	ctx = common.NewSessionContext(ctx, session.ID)
 	tok, err := s.Threads.GetToken(ctx, dev.Secret)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if err := s.Collections.Accounts.SetToken(ctx, dev.Key, tok); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Check for pending invites
 	invites, err := s.Collections.Invites.ListByEmail(ctx, dev.Email)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, invite := range invites {
 		if invite.Accepted {
@@ -129,42 +157,151 @@ func (s *Service) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.Signup
 			}); err != nil {
 				if err == mongo.ErrNoDocuments {
 					if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 				} else {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 			if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 		if time.Now().After(invite.ExpiresAt) {
 			if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 	}
 
+	return dev, session, nil
+}
+
+func (s *Service) Signin(ctx context.Context, req *pb.SigninRequest) (*pb.SigninResponse, error) {
+	log.Debugf("received signin request")
+
+	login, err := s.startSignin(ctx, req.UsernameOrEmail)
+	if err != nil {
+		return nil, err
+	}
+	if !s.awaitLogin(ctx, login) {
+		return nil, status.Error(codes.Unauthenticated, "Could not verify email address")
+	}
+	// Codes are only checked once the email is verified so they can't be guessed without it
+	dev, err := s.Collections.Accounts.GetByUsername(ctx, login.Username)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if err := s.verifyTwoFactor(ctx, dev, req.TwoFactorCode); err != nil {
+		return nil, err
+	}
+	dev, session, err := s.completeLogin(ctx, login.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	key, err := dev.Key.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
-	return &pb.SignupResponse{
+	return &pb.SigninResponse{
 		Key:     key,
 		Session: session.ID,
 	}, nil
 }
 
-func (s *Service) Signin(ctx context.Context, req *pb.SigninRequest) (*pb.SigninResponse, error) {
-	log.Debugf("received signin request")
+func (s *Service) StartSignup(ctx context.Context, req *pb.StartSignupRequest) (*pb.StartSignupResponse, error) {
+	log.Debugf("received start signup request")
+
+	login, err := s.startSignup(ctx, req.Username, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartSignupResponse{LoginId: login.ID}, nil
+}
+
+func (s *Service) StartSignin(ctx context.Context, req *pb.StartSigninRequest) (*pb.StartSigninResponse, error) {
+	log.Debugf("received start signin request")
+
+	login, err := s.startSignin(ctx, req.UsernameOrEmail)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartSigninResponse{LoginId: login.ID}, nil
+}
+
+func (s *Service) PollLogin(ctx context.Context, req *pb.PollLoginRequest) (*pb.PollLoginResponse, error) {
+	log.Debugf("received poll login request")
+
+	login, err := s.getLogin(ctx, req.LoginId)
+	if err != nil {
+		return nil, err
+	}
+	if !login.Confirmed {
+		return &pb.PollLoginResponse{Status: pb.LoginStatus_LOGIN_STATUS_PENDING}, nil
+	}
+	if login.Type == mdb.LoginSignin {
+		dev, err := s.Collections.Accounts.GetByUsername(ctx, login.Username)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "User not found")
+		}
+		if dev.TwoFactorEnabled() && req.TwoFactorCode == "" {
+			return &pb.PollLoginResponse{Status: pb.LoginStatus_LOGIN_STATUS_TWO_FACTOR_REQUIRED}, nil
+		}
+		if err := s.verifyTwoFactor(ctx, dev, req.TwoFactorCode); err != nil {
+			return nil, err
+		}
+	}
+	dev, session, err := s.completeLogin(ctx, login.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := dev.Key.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PollLoginResponse{
+		Status:  pb.LoginStatus_LOGIN_STATUS_COMPLETE,
+		Key:     key,
+		Session: session.ID,
+	}, nil
+}
+
+// startSignup creates a pending signup and sends a verification email.
+func (s *Service) startSignup(ctx context.Context, username, email string) (*mdb.Login, error) {
+	if err := s.Collections.Accounts.IsUsernameAvailable(ctx, username); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Email address in not valid")
+	}
 
-	dev, err := s.Collections.Accounts.GetByUsernameOrEmail(ctx, req.UsernameOrEmail)
+	secret := getSessionSecret(s.EmailSessionSecret)
+	login, err := s.Collections.Logins.Create(ctx, mdb.LoginSignup, username, email, secret)
+	if err != nil {
+		return nil, err
+	}
+	ectx, cancel := context.WithTimeout(ctx, emailTimeout)
+	defer cancel()
+	if err := s.EmailClient.ConfirmAddress(ectx, email, username, email, s.GatewayURL, secret); err != nil {
+		return nil, err
+	}
+	return login, nil
+}
+
+// startSignin creates a pending signin and sends a verification email.
+func (s *Service) startSignin(ctx context.Context, usernameOrEmail string) (*mdb.Login, error) {
+	dev, err := s.Collections.Accounts.GetByUsernameOrEmail(ctx, usernameOrEmail)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "User not found")
 	}
 
 	secret := getSessionSecret(s.EmailSessionSecret)
+	login, err := s.Collections.Logins.Create(ctx, mdb.LoginSignin, dev.Username, dev.Email, secret)
+	if err != nil {
+		return nil, err
+	}
 	ectx, cancel := context.WithTimeout(ctx, emailTimeout)
 	defer cancel()
 	if err = s.EmailClient.ConfirmAddress(
@@ -177,53 +314,85 @@ func (s *Service) Signin(ctx context.Context, req *pb.SigninRequest) (*pb.Signin
 	); err != nil {
 		return nil, err
 	}
-	if !s.awaitVerification(secret) {
-		return nil, status.Error(codes.Unauthenticated, "Could not verify email address")
-	}
-
-	session, err := s.Collections.Sessions.Create(ctx, dev.Key)
-	if err != nil {
-		return nil, err
-	}
+	return login, nil
+}
 
-	key, err := dev.Key.MarshalBinary()
+func (s *Service) getLogin(ctx context.Context, id string) (*mdb.Login, error) {
+	login, err := s.Collections.Logins.Get(ctx, id)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Login not found")
+		}
 		return nil, err
 	}
-
-	if s.BillingClient != nil {
-		s.BillingClient.TrackEvent(ctx, dev.Key, mdb.Dev, true, analytics.SignIn, nil)
+	if time.Now().After(login.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "Login expired")
 	}
-
-	return &pb.SigninResponse{
-		Key:     key,
-		Session: session.ID,
-	}, nil
+	return login, nil
 }
 
-// awaitVerification waits for a dev to verify their email via a sent email.
-func (s *Service) awaitVerification(secret string) bool {
+// awaitLogin waits for a dev to verify their email via a sent email.
+// The login is rechecked periodically in case the email was verified with another hub.
+func (s *Service) awaitLogin(ctx context.Context, login *mdb.Login) bool {
 	listen := s.EmailSessionBus.Listen()
-	ch := make(chan struct{})
-	timer := time.NewTimer(loginTimeout)
+	defer listen.Discard()
+	ch := make(chan struct{}, 1)
 	go func() {
 		for i := range listen.Channel() {
-			if r, ok := i.(string); ok && r == secret {
-				ch <- struct{}{}
+			if r, ok := i.(string); ok && r == login.Secret {
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
 			}
 		}
 	}()
-	select {
-	case <-ch:
-		listen.Discard()
-		timer.Stop()
-		return true
-	case <-timer.C:
-		listen.Discard()
-		return false
+	ticker := time.NewTicker(loginPollInterval)
+	defer ticker.Stop()
+	for {
+		l, err := s.getLogin(ctx, login.ID)
+		if err != nil {
+			return false
+		}
+		if l.Confirmed {
+			return true
+		}
+		select {
+		case <-ch:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return false
+		}
 	}
 }
 
+// completeLogin completes a confirmed login, creating the dev if needed, and returns a new session.
+func (s *Service) completeLogin(ctx context.Context, id string) (*mdb.Account, *mdb.Session, error) {
+	login, err := s.Collections.Logins.Complete(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, status.Error(codes.NotFound, "Login not found")
+		}
+		return nil, nil, err
+	}
+	if login.Type == mdb.LoginSignup {
+		return s.createDev(ctx, login.Username, login.Email, "", "")
+	}
+
+	dev, err := s.Collections.Accounts.GetByUsername(ctx, login.Username)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, "User not found")
+	}
+	session, err := s.Collections.Sessions.CreateSSO(ctx, dev.Key, login.SSOIssuer, login.SSOClientID, s.sessionDevice(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.BillingClient != nil {
+		s.BillingClient.TrackEvent(ctx, dev.Key, mdb.Dev, true, analytics.SignIn, nil)
+	}
+	return dev, session, nil
+}
+
 func (s *Service) powergateAdminCtx(ctx context.Context) context.Context {
 	return context.WithValue(ctx, pow.AdminKey, s.PowergateAdminToken)
 }
@@ -265,9 +434,10 @@ func (s *Service) GetSessionInfo(ctx context.Context, _ *pb.GetSessionInfoReques
 		return nil, err
 	}
 	return &pb.GetSessionInfoResponse{
-		Key:      key,
-		Username: account.User.Username,
-		Email:    account.User.Email,
+		Key:              key,
+		Username:         account.User.Username,
+		Email:            account.User.Email,
+		TwoFactorEnabled: account.User.TwoFactorEnabled(),
 	}, nil
 }
 
@@ -294,6 +464,12 @@ func (s *Service) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.
 	if err != nil {
 		return nil, err
 	}
+	if err := requireOrgPermission(account, mdb.PermManageKeys); err != nil {
+		return nil, err
+	}
+	if err := s.requireTwoFactor(ctx, account); err != nil {
+		return nil, err
+	}
 	var keyType mdb.APIKeyType
 	switch req.Type {
 	case pb.KeyType_KEY_TYPE_ACCOUNT:
@@ -304,13 +480,17 @@ func (s *Service) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "invalid key type: %v", req.Type.String())
 	}
-	key, err := s.Collections.APIKeys.Create(ctx, account.Owner().Key, keyType, req.Secure)
+	opts, err := keyOptionsFromPb(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	key, err := s.Collections.APIKeys.CreateWithOptions(ctx, account.Owner().Key, keyType, req.Secure, opts)
 	if err != nil {
 		return nil, err
 	}
-	t, err := keyTypeToPb(key.Type)
+	info, err := keyToPb(key, 0)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "mapping key type: %v", key.Type)
+		return nil, err
 	}
 
 	var event analytics.Event
@@ -340,16 +520,7 @@ func (s *Service) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.
 		)
 	}
 
-	return &pb.CreateKeyResponse{
-		KeyInfo: &pb.KeyInfo{
-			Key:     key.Key,
-			Secret:  key.Secret,
-			Type:    t,
-			Valid:   true,
-			Threads: 0,
-			Secure:  key.Secure,
-		},
-	}, nil
+	return &pb.CreateKeyResponse{KeyInfo: info}, nil
 }
 
 func (s *Service) InvalidateKey(ctx context.Context, req *pb.InvalidateKeyRequest) (*pb.InvalidateKeyResponse, error) {
@@ -359,6 +530,9 @@ func (s *Service) InvalidateKey(ctx context.Context, req *pb.InvalidateKeyReques
 	if err != nil {
 		return nil, err
 	}
+	if err := requireOrgPermission(account, mdb.PermManageKeys); err != nil {
+		return nil, err
+	}
 	key, err := s.Collections.APIKeys.Get(ctx, req.Key)
 	if err != nil {
 		return nil, err
@@ -373,6 +547,75 @@ func (s *Service) InvalidateKey(ctx context.Context, req *pb.InvalidateKeyReques
 	return &pb.InvalidateKeyResponse{}, nil
 }
 
+func (s *Service) RotateKey(ctx context.Context, req *pb.RotateKeyRequest) (*pb.RotateKeyResponse, error) {
+	log.Debugf("received rotate key request")
+
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireOrgPermission(account, mdb.PermManageKeys); err != nil {
+		return nil, err
+	}
+	if err := s.requireTwoFactor(ctx, account); err != nil {
+		return nil, err
+	}
+	grace := time.Duration(req.GracePeriod) * time.Second
+	if grace < 0 || grace > maxKeyRotationGrace {
+		return nil, status.Errorf(codes.InvalidArgument, "grace period must be between 0 and %s", maxKeyRotationGrace)
+	}
+	key, err := s.Collections.APIKeys.Get(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !account.Owner().Key.Equals(key.Owner) {
+		return nil, status.Error(codes.PermissionDenied, "User does not own key")
+	}
+	if !key.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "Key is invalid")
+	}
+	if !key.Secure {
+		// Insecure keys are used without their secret, so a new secret wouldn't lock anyone out
+		return nil, status.Error(codes.FailedPrecondition, "Only secure keys can be rotated, invalidate insecure keys instead")
+	}
+	key, err = s.Collections.APIKeys.Rotate(ctx, req.Key, grace)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, status.Error(codes.Aborted, "Key was rotated concurrently")
+	} else if err != nil {
+		return nil, err
+	}
+	ts, err := s.Collections.Threads.ListByKey(ctx, key.Key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := keyToPb(key, int32(len(ts)))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.BillingClient != nil {
+		payload := map[string]string{
+			"key":          key.Key,
+			"grace_period": grace.String(),
+		}
+		if account.User != nil {
+			payload["member"] = account.User.Key.String()
+			payload["member_username"] = account.User.Username
+			payload["member_email"] = account.User.Email
+		}
+		s.BillingClient.TrackEvent(
+			ctx,
+			account.Owner().Key,
+			account.Owner().Type,
+			true,
+			analytics.KeyRotated,
+			payload,
+		)
+	}
+
+	return &pb.RotateKeyResponse{KeyInfo: info}, nil
+}
+
 func (s *Service) ListKeys(ctx context.Context, _ *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
 	log.Debugf("received list keys request")
 
@@ -380,27 +623,23 @@ func (s *Service) ListKeys(ctx context.Context, _ *pb.ListKeysRequest) (*pb.List
 	if err != nil {
 		return nil, err
 	}
+	// Keys are listed with their secrets
+	if err := requireOrgPermission(account, mdb.PermManageKeys); err != nil {
+		return nil, err
+	}
 	keys, err := s.Collections.APIKeys.ListByOwner(ctx, account.Owner().Key)
 	if err != nil {
 		return nil, err
 	}
 	list := make([]*pb.KeyInfo, len(keys))
 	for i, key := range keys {
-		t, err := keyTypeToPb(key.Type)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "mapping key type: %v", key.Type)
-		}
 		ts, err := s.Collections.Threads.ListByKey(ctx, key.Key)
 		if err != nil {
 			return nil, err
 		}
-		list[i] = &pb.KeyInfo{
-			Key:     key.Key,
-			Secret:  key.Secret,
-			Type:    t,
-			Valid:   key.Valid,
-			Threads: int32(len(ts)),
-			Secure:  key.Secure,
+		list[i], err = keyToPb(&keys[i], int32(len(ts)))
+		if err != nil {
+			return nil, err
 		}
 	}
 	return &pb.ListKeysResponse{List: list}, nil
@@ -508,14 +747,28 @@ func (s *Service) orgToPbOrg(org *mdb.Account) (*pb.OrgInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &pb.OrgInfo{
-		Key:       key,
-		Name:      org.Name,
-		Slug:      org.Username,
-		Host:      s.GatewayURL,
-		Members:   members,
-		CreatedAt: org.CreatedAt.Unix(),
-	}, nil
+	info := &pb.OrgInfo{
+		Key:               key,
+		Name:              org.Name,
+		Slug:              org.Username,
+		Host:              s.GatewayURL,
+		Members:           members,
+		CreatedAt:         org.CreatedAt.Unix(),
+		TwoFactorRequired: org.RequireTwoFactor,
+	}
+	if org.Transfer != nil && org.Transfer.ExpiresAt.After(time.Now()) {
+		info.Transfer = &pb.OrgInfo_Transfer{
+			ToUsername: org.Transfer.ToUsername,
+			ExpiresAt:  org.Transfer.ExpiresAt.Unix(),
+		}
+		for _, m := range org.Members {
+			if m.Key.Equals(org.Transfer.From) {
+				info.Transfer.FromUsername = m.Username
+				break
+			}
+		}
+	}
+	return info, nil
 }
 
 func (s *Service) ListOrgs(ctx context.Context, _ *pb.ListOrgsRequest) (*pb.ListOrgsResponse, error) {
@@ -555,12 +808,11 @@ func (s *Service) RemoveOrg(ctx context.Context, _ *pb.RemoveOrgRequest) (*pb.Re
 	if account.Org == nil {
 		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
 	}
-	isOwner, err := s.Collections.Accounts.IsOwner(ctx, account.Org.Username, account.User.Key)
-	if err != nil {
+	if err := requireOrgPermission(account, mdb.PermRemoveOrg); err != nil {
 		return nil, err
 	}
-	if !isOwner {
-		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	if err := s.requireTwoFactor(ctx, account); err != nil {
+		return nil, err
 	}
 
 	if err = s.destroyAccount(ctx, account.Org); err != nil {
@@ -598,32 +850,32 @@ func (s *Service) InviteToOrg(ctx context.Context, req *pb.InviteToOrgRequest) (
 	if account.Org == nil {
 		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
 	}
-	if _, err := mail.ParseAddress(req.Email); err != nil {
-		return nil, status.Error(codes.FailedPrecondition, "Email address in not valid")
+	if err := requireOrgPermission(account, mdb.PermInviteMembers); err != nil {
+		return nil, err
+	}
+	var invite *mdb.Invite
+	if req.Username != "" {
+		if req.Email != "" {
+			return nil, status.Error(codes.InvalidArgument, "Invite by email address or username, not both")
+		}
+		invite, err = s.createUsernameInvite(ctx, account, req.Username)
+	} else {
+		if _, err := mail.ParseAddress(req.Email); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, "Email address in not valid")
+		}
+		invite, err = s.Collections.Invites.Create(ctx, account.User.Key, account.Org.Username, req.Email)
 	}
-	invite, err := s.Collections.Invites.Create(ctx, account.User.Key, account.Org.Username, req.Email)
 	if err != nil {
 		return nil, err
 	}
-
-	ectx, cancel := context.WithTimeout(ctx, emailTimeout)
-	defer cancel()
-	if err = s.EmailClient.InviteAddress(
-		ectx,
-		account.User.Key.String(),
-		account.Org.Name,
-		account.User.Email,
-		req.Email,
-		s.GatewayURL,
-		invite.Token,
-	); err != nil {
+	if err = s.sendInvite(ctx, account, invite); err != nil {
 		return nil, err
 	}
 
 	if s.BillingClient != nil {
 
 		payload := map[string]string{
-			"invitee": req.Email,
+			"invitee": invite.To(),
 		}
 		if account.User != nil {
 			payload["member"] = account.User.Key.String()
@@ -657,7 +909,7 @@ func (s *Service) LeaveOrg(ctx context.Context, _ *pb.LeaveOrgRequest) (*pb.Leav
 		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
 	}
 	if err := s.Collections.Accounts.RemoveMember(ctx, account.Org.Username, account.User.Key); err != nil {
-		return nil, err
+		return nil, orgMemberError(err)
 	}
 	if err := s.Collections.Invites.DeleteByFromAndOrg(ctx, account.User.Key, account.Org.Username); err != nil {
 		return nil, err
@@ -691,6 +943,9 @@ func (s *Service) SetupBilling(ctx context.Context, _ *pb.SetupBillingRequest) (
 	if err != nil {
 		return nil, err
 	}
+	if err := requireOrgPermission(account, mdb.PermManageBilling); err != nil {
+		return nil, err
+	}
 
 	if err := s.BillingClient.RecreateCustomerSubscription(
 		ctx,
@@ -730,6 +985,9 @@ func (s *Service) GetBillingSession(
 	if err != nil {
 		return nil, err
 	}
+	if err := requireOrgPermission(account, mdb.PermManageBilling); err != nil {
+		return nil, err
+	}
 	session, err := s.BillingClient.GetCustomerSession(ctx, account.Owner().Key)
 	if err != nil {
 		return nil, err
@@ -802,6 +1060,9 @@ func (s *Service) DestroyAccount(ctx context.Context, _ *pb.DestroyAccountReques
 	if account.User == nil {
 		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
 	}
+	if err := s.requireTwoFactor(ctx, account); err != nil {
+		return nil, err
+	}
 	if err := s.destroyAccount(ctx, account.User); err != nil {
 		return nil, err
 	}
@@ -820,14 +1081,21 @@ func (s *Service) DestroyAccount(ctx context.Context, _ *pb.DestroyAccountReques
 }
 
 func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
-	// First, ensure that the account does not own any orgs
+	// First, ensure that the account is not the last owner of any orgs, then leave the rest
 	if a.Type == mdb.Dev {
-		orgs, err := s.Collections.Accounts.ListByOwner(ctx, a.Key)
+		orgs, err := s.Collections.Accounts.ListByMember(ctx, a.Key)
 		if err != nil {
 			return err
 		}
-		if len(orgs) > 0 {
-			return status.Error(codes.FailedPrecondition, "Account not empty (delete orgs first)")
+		for _, org := range orgs {
+			if role, _ := org.MemberRole(a.Key); role == mdb.OrgOwner && org.CountOwners() == 1 {
+				return status.Error(codes.FailedPrecondition, "Account not empty (transfer or delete orgs first)")
+			}
+		}
+		for _, org := range orgs {
+			if err := s.Collections.Accounts.RemoveMember(ctx, org.Username, a.Key); err != nil {
+				return orgMemberError(err)
+			}
 		}
 	}
 
@@ -876,7 +1144,7 @@ func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
 			}
 		} else {
 			// Delete the entire thread.
-			if err := s.ThreadsNet.DeleteThread(ctx, t.ID, net.WithThreadToken(a.Token)); err != nil {
+			if err := s.ThreadsNet.DeleteThread(ctx, t.ID, corenet.WithThreadToken(a.Token)); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,140 @@
+package hubd
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ListSessions(ctx context.Context, _ *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	log.Debugf("received list sessions request")
+
+	session, ok := mdb.SessionFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Session required")
+	}
+	sessions, err := s.Collections.Sessions.ListByOwner(ctx, session.Owner)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.SessionInfo, len(sessions))
+	for i, ss := range sessions {
+		list[i] = sessionToPb(&ss, session.ID)
+	}
+	return &pb.ListSessionsResponse{List: list}, nil
+}
+
+func (s *Service) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	log.Debugf("received revoke session request")
+
+	session, ok := mdb.SessionFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Session required")
+	}
+	// Sessions are referenced by hash since the ID is a credential
+	sessions, err := s.Collections.Sessions.ListByOwner(ctx, session.Owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, ss := range sessions {
+		if ss.Ref() == req.Id {
+			if err := s.Collections.Sessions.Delete(ctx, ss.ID); err != nil {
+				return nil, err
+			}
+			return &pb.RevokeSessionResponse{}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "Session not found")
+}
+
+func (s *Service) RevokeAllSessions(
+	ctx context.Context,
+	req *pb.RevokeAllSessionsRequest,
+) (*pb.RevokeAllSessionsResponse, error) {
+	log.Debugf("received revoke all sessions request")
+
+	session, ok := mdb.SessionFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Session required")
+	}
+	if req.KeepCurrent {
+		err := s.Collections.Sessions.DeleteByOwnerExcept(ctx, session.Owner, session.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := s.Collections.Sessions.DeleteByOwner(ctx, session.Owner); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeAllSessionsResponse{}, nil
+}
+
+// sessionDevice returns the device metadata of the client in ctx.
+// The x-forwarded-for header is only used when the peer is a trusted proxy.
+func (s *Service) sessionDevice(ctx context.Context) mdb.SessionDevice {
+	md := metautils.ExtractIncoming(ctx)
+	device := mdb.SessionDevice{UserAgent: md.Get("user-agent")}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return device
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	device.IP = host
+	if !s.isTrustedProxy(host) {
+		return device
+	}
+	// Walk the chain back from the nearest hop, stopping at the first untrusted address
+	hops := strings.Split(md.Get("x-forwarded-for"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		device.IP = hop
+		if !s.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return device
+}
+
+// isTrustedProxy returns whether addr belongs to one of the trusted proxy networks.
+func (s *Service) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range s.TrustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func sessionToPb(session *mdb.Session, current string) *pb.SessionInfo {
+	info := &pb.SessionInfo{
+		Id:        session.Ref(),
+		UserAgent: session.Device.UserAgent,
+		Ip:        session.Device.IP,
+		ExpiresAt: session.ExpiresAt.Unix(),
+		SsoIssuer: session.SSOIssuer,
+		Current:   session.ID == current,
+	}
+	if !session.CreatedAt.IsZero() {
+		info.CreatedAt = session.CreatedAt.Unix()
+	}
+	if !session.LastSeenAt.IsZero() {
+		info.LastSeenAt = session.LastSeenAt.Unix()
+	}
+	return info
+}
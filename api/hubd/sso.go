@@ -0,0 +1,504 @@
+package hubd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/billingd/analytics"
+	"github.com/textileio/textile/v2/api/common"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/oidc"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) GetSSOURL(ctx context.Context, req *pb.GetSSOURLRequest) (*pb.GetSSOURLResponse, error) {
+	log.Debugf("received get sso url request")
+
+	p, err := s.ssoProvider(ctx, req.Org)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username != "" {
+		if err := s.Collections.Accounts.ValidateUsername(req.Username); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+	// Provider accounts can only be linked from an authenticated session
+	var linkOwner thread.PubKey
+	if req.Link {
+		dev, err := s.sessionDev(ctx)
+		if err != nil {
+			return nil, err
+		}
+		linkOwner = dev.Key
+	}
+
+	// Without a redirect URI, the gateway receives the callback and the hub holds the verifier.
+	redirectURI, verifier, challenge := req.RedirectUri, "", req.CodeChallenge
+	if redirectURI == "" {
+		redirectURI = s.GatewayURL + oidc.CallbackPath
+		verifier = oidc.NewVerifier()
+		challenge = oidc.Challenge(verifier)
+	} else {
+		if !isLoopbackURL(redirectURI) {
+			return nil, status.Error(codes.InvalidArgument, "Redirect URI must be a loopback address")
+		}
+		if challenge == "" {
+			return nil, status.Error(codes.InvalidArgument, "Code challenge is required")
+		}
+	}
+
+	state, err := s.Collections.SSOStates.Create(ctx, req.Org, redirectURI, verifier, req.Username, linkOwner)
+	if err != nil {
+		return nil, err
+	}
+	u, err := p.AuthCodeURL(ctx, redirectURI, state.ID, state.Nonce, challenge)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Identity provider is unavailable: %v", err)
+	}
+	return &pb.GetSSOURLResponse{
+		Url:   u,
+		State: state.ID,
+	}, nil
+}
+
+func (s *Service) SSOSignin(ctx context.Context, req *pb.SSOSigninRequest) (*pb.SSOSigninResponse, error) {
+	log.Debugf("received sso signin request")
+
+	state, err := s.getSSOState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	code, verifier := req.Code, req.CodeVerifier
+	if state.Verifier != "" {
+		// The gateway records the callback, which the client polls for
+		if state.Code == "" && state.Error == "" {
+			return &pb.SSOSigninResponse{Pending: true}, nil
+		}
+		code, verifier = state.Code, state.Verifier
+	} else if code == "" || verifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "Code and code verifier are required")
+	}
+
+	// States are single use
+	if err := s.Collections.SSOStates.Delete(ctx, state.ID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Sign on not found")
+		}
+		return nil, err
+	}
+	if state.Error != "" {
+		return nil, status.Errorf(codes.Unauthenticated, "Identity provider returned an error: %s", state.Error)
+	}
+
+	p, err := s.ssoProvider(ctx, state.Org)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := p.Exchange(ctx, state.RedirectURI, code, verifier, state.Nonce)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Could not sign on: %v", err)
+	}
+	if claims.Subject == "" {
+		return nil, status.Error(codes.Unauthenticated, "Identity provider did not return a subject")
+	}
+	if state.LinkOwner != nil {
+		return s.linkSSO(ctx, p, state.LinkOwner, claims)
+	}
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, status.Error(codes.FailedPrecondition, oidc.ErrEmailNotVerified.Error())
+	}
+
+	dev, session, err := s.ssoAccount(ctx, p, state, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := dev.Key.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		// The login is completed with a two-factor code via PollLogin
+		login, err := s.Collections.Logins.CreateSSO(ctx, dev.Username, dev.Email, p.Issuer(), p.ClientID())
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SSOSigninResponse{
+			Key:     key,
+			LoginId: login.ID,
+		}, nil
+	}
+
+	if s.BillingClient != nil {
+		s.BillingClient.TrackEvent(ctx, dev.Key, mdb.Dev, true, analytics.SignIn, nil)
+	}
+
+	return &pb.SSOSigninResponse{
+		Key:     key,
+		Session: session.ID,
+	}, nil
+}
+
+func (s *Service) SetOrgSSO(ctx context.Context, req *pb.SetOrgSSORequest) (*pb.SetOrgSSOResponse, error) {
+	log.Debugf("received set org sso request")
+
+	account, err := s.getOrgOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Issuer == "" {
+		if err := s.Collections.Accounts.SetSSO(ctx, account.Org.Key, nil); err != nil {
+			return nil, err
+		}
+		return &pb.SetOrgSSOResponse{}, nil
+	}
+
+	if !isValidIssuer(req.Issuer) {
+		return nil, status.Error(codes.InvalidArgument, "Issuer must be an https URL")
+	}
+	if req.ClientId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Client ID is required")
+	}
+	conf := &mdb.SSOConfig{
+		Issuer:       req.Issuer,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Required:     req.Required,
+	}
+	// Keep the existing secret when only changing whether sign on is required
+	old := account.Org.SSO
+	if conf.ClientSecret == "" && old != nil && old.Issuer == conf.Issuer && old.ClientID == conf.ClientID {
+		conf.ClientSecret = old.ClientSecret
+	}
+
+	p, err := s.getSSOProvider(conf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := p.Discover(ctx); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to reach identity provider: %v", err)
+	}
+	if err := s.Collections.Accounts.SetSSO(ctx, account.Org.Key, conf); err != nil {
+		return nil, err
+	}
+	return &pb.SetOrgSSOResponse{}, nil
+}
+
+func (s *Service) GetOrgSSO(ctx context.Context, _ *pb.GetOrgSSORequest) (*pb.GetOrgSSOResponse, error) {
+	log.Debugf("received get org sso request")
+
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.Org == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
+	}
+	conf := account.Org.SSO
+	if conf == nil {
+		return &pb.GetOrgSSOResponse{}, nil
+	}
+	return &pb.GetOrgSSOResponse{
+		Issuer:   conf.Issuer,
+		ClientId: conf.ClientID,
+		Required: conf.Required,
+	}, nil
+}
+
+// getOrgOwner returns the account context if the user is an owner of the org.
+func (s *Service) getOrgOwner(ctx context.Context) (*mdb.AccountCtx, error) {
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.User == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	if account.Org == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errOrgRequired.Error())
+	}
+	isOwner, err := s.Collections.Accounts.IsOwner(ctx, account.Org.Username, account.User.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	return account, nil
+}
+
+// ssoProvider returns the identity provider configured for org,
+// or the hub's default provider if org is empty.
+func (s *Service) ssoProvider(ctx context.Context, org string) (*oidc.Provider, error) {
+	if org == "" {
+		if s.SSOProvider == nil {
+			return nil, status.Error(codes.Unimplemented, "Single sign-on is not enabled")
+		}
+		return s.SSOProvider, nil
+	}
+	a, err := s.Collections.Accounts.GetByUsername(ctx, org)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Org not found")
+		}
+		return nil, err
+	}
+	if a.Type != mdb.Org || a.SSO == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Org does not use single sign-on")
+	}
+	return s.getSSOProvider(a.SSO)
+}
+
+// getSSOProvider returns a provider for conf.
+// Providers are reused so that discovery and signing keys are only fetched once.
+func (s *Service) getSSOProvider(conf *mdb.SSOConfig) (*oidc.Provider, error) {
+	k := conf.Issuer + "\x00" + conf.ClientID + "\x00" + conf.ClientSecret
+	if p, ok := s.ssoProviders.Load(k); ok {
+		return p.(*oidc.Provider), nil
+	}
+	p, err := oidc.NewProvider(oidc.Config{
+		Issuer:       conf.Issuer,
+		ClientID:     conf.ClientID,
+		ClientSecret: conf.ClientSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	v, _ := s.ssoProviders.LoadOrStore(k, p)
+	return v.(*oidc.Provider), nil
+}
+
+func (s *Service) getSSOState(ctx context.Context, id string) (*mdb.SSOState, error) {
+	state, err := s.Collections.SSOStates.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Sign on not found")
+		}
+		return nil, err
+	}
+	if time.Now().After(state.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "Sign on expired")
+	}
+	return state, nil
+}
+
+// ssoAccount returns the dev for a verified sign on and creates a new session.
+// Devs with two-factor authentication enabled don't get a session until they complete the second factor.
+// Devs are matched by the issuer and subject of the provider account, never by email address.
+// A provider account that isn't linked creates a new account, unless the email address is already used,
+// in which case the dev must sign in and link the provider account with GetSSOURL.
+// An org's provider may only sign on to members of the org, or to accounts with a pending invite to the org,
+// which is accepted.
+func (s *Service) ssoAccount(
+	ctx context.Context,
+	p *oidc.Provider,
+	state *mdb.SSOState,
+	claims *oidc.Claims,
+) (*mdb.Account, *mdb.Session, error) {
+	dev, err := s.Collections.Accounts.GetBySSOLink(ctx, p.Issuer(), claims.Subject)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil, err
+	}
+	if dev == nil {
+		_, err := s.Collections.Accounts.GetByUsernameOrEmail(ctx, claims.Email)
+		if err == nil {
+			return nil, nil, status.Error(
+				codes.FailedPrecondition,
+				"Account exists: sign in and link the identity provider account first",
+			)
+		} else if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, err
+		}
+	}
+
+	var invite *mdb.Invite
+	if state.Org != "" {
+		isMember := false
+		if dev != nil {
+			isMember, err = s.Collections.Accounts.IsMember(ctx, state.Org, dev.Key)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if !isMember {
+			invite, err = s.getPendingInvite(ctx, state.Org, claims.Email)
+			if err != nil {
+				return nil, nil, err
+			}
+			if invite == nil {
+				return nil, nil, status.Error(codes.PermissionDenied, "An invite is required to join the org")
+			}
+		}
+	}
+
+	var session *mdb.Session
+	if dev == nil {
+		username, err := s.ssoUsername(ctx, state, claims)
+		if err != nil {
+			return nil, nil, err
+		}
+		dev, session, err = s.createDev(ctx, username, claims.Email, p.Issuer(), p.ClientID())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := s.Collections.Accounts.AddSSOLink(ctx, dev.Key, mdb.SSOLink{
+			Issuer:  p.Issuer(),
+			Subject: claims.Subject,
+		}); err != nil {
+			return nil, nil, ssoLinkError(err)
+		}
+	} else if !dev.TwoFactorEnabled() {
+		session, err = s.Collections.Sessions.CreateSSO(ctx, dev.Key, p.Issuer(), p.ClientID(), s.sessionDevice(ctx))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if invite != nil {
+		if err := s.Collections.Accounts.AddMember(ctx, invite.Org, mdb.Member{
+			Key:      dev.Key,
+			Username: dev.Username,
+			Role:     mdb.OrgMember,
+		}); err != nil {
+			return nil, nil, err
+		}
+		if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
+			return nil, nil, err
+		}
+	}
+	return dev, session, nil
+}
+
+// linkSSO links the provider account in claims to the dev that started the sign on.
+func (s *Service) linkSSO(
+	ctx context.Context,
+	p *oidc.Provider,
+	owner thread.PubKey,
+	claims *oidc.Claims,
+) (*pb.SSOSigninResponse, error) {
+	if err := s.Collections.Accounts.AddSSOLink(ctx, owner, mdb.SSOLink{
+		Issuer:  p.Issuer(),
+		Subject: claims.Subject,
+	}); err != nil {
+		return nil, ssoLinkError(err)
+	}
+	key, err := owner.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SSOSigninResponse{
+		Key:    key,
+		Linked: true,
+	}, nil
+}
+
+// ssoLinkError maps errors from linking a provider account to grpc status errors.
+func ssoLinkError(err error) error {
+	switch {
+	case errors.Is(err, mdb.ErrSSOLinked):
+		return status.Error(codes.AlreadyExists, "Identity provider account is linked to another account")
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Error(codes.NotFound, "User not found")
+	default:
+		return err
+	}
+}
+
+// sessionDev returns the dev of the session in the request metadata.
+// It's used by methods that are ignored by the auth interceptor but optionally accept a session.
+func (s *Service) sessionDev(ctx context.Context) (*mdb.Account, error) {
+	sid, ok := common.SessionFromMD(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Session is required")
+	}
+	session, err := s.Collections.Sessions.Get(ctx, sid)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid session")
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "Expired session")
+	}
+	dev, err := s.Collections.Accounts.Get(ctx, session.Owner)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if dev.Type != mdb.Dev {
+		return nil, status.Error(codes.PermissionDenied, errDevRequired.Error())
+	}
+	return dev, nil
+}
+
+// getPendingInvite returns an unexpired invite to org for email, if one exists.
+func (s *Service) getPendingInvite(ctx context.Context, org, email string) (*mdb.Invite, error) {
+	invites, err := s.Collections.Invites.ListByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	for _, invite := range invites {
+		if invite.Org == org && time.Now().Before(invite.ExpiresAt) {
+			i := invite
+			return &i, nil
+		}
+	}
+	return nil, nil
+}
+
+// ssoUsername returns the username for a new account.
+// The username requested at sign on is used if available,
+// followed by the provider's preferred username and the email address local part.
+func (s *Service) ssoUsername(ctx context.Context, state *mdb.SSOState, claims *oidc.Claims) (string, error) {
+	if state.Username != "" {
+		if err := s.Collections.Accounts.IsUsernameAvailable(ctx, state.Username); err != nil {
+			return "", status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return state.Username, nil
+	}
+	local := strings.Split(claims.Email, "@")[0]
+	local = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, local)
+	for _, u := range []string{claims.PreferredUsername, local} {
+		if u == "" {
+			continue
+		}
+		if err := s.Collections.Accounts.IsUsernameAvailable(ctx, u); err == nil {
+			return u, nil
+		}
+	}
+	return "", status.Error(codes.FailedPrecondition, "A username is required to create an account")
+}
+
+// isValidIssuer returns whether issuer is an https URL, or an http loopback URL for local development.
+func isValidIssuer(issuer string) bool {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return false
+	}
+	return u.Scheme == "https" || isLoopbackURL(issuer)
+}
+
+// isLoopbackURL returns whether u is an http URL with a loopback host.
+func isLoopbackURL(u string) bool {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Scheme != "http" || parsed.User != nil || parsed.Fragment != "" {
+		return false
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,198 @@
+package hubd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/textileio/textile/v2/api/common"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/totp"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// twoFactorIssuer names the hub in authenticator apps.
+	twoFactorIssuer = "Textile Hub"
+	// recoveryCodeCount is the number of recovery codes issued when two-factor authentication is enabled.
+	recoveryCodeCount = 10
+)
+
+var (
+	errTwoFactorRequired = status.Error(codes.FailedPrecondition, "Two-factor code required")
+	errTwoFactorInvalid  = status.Error(codes.Unauthenticated, "Invalid two-factor code")
+	errTwoFactorLocked   = status.Error(codes.ResourceExhausted, "Too many invalid two-factor codes, try again later")
+)
+
+func (s *Service) EnableTwoFactor(ctx context.Context, _ *pb.EnableTwoFactorRequest) (*pb.EnableTwoFactorResponse, error) {
+	log.Debugf("received enable two-factor request")
+
+	dev, err := getTwoFactorDev(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dev.TwoFactorEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	// The secret isn't used to sign in until it's confirmed
+	if err := s.Collections.Accounts.SetTwoFactor(ctx, dev.Key, &mdb.TwoFactor{Secret: secret}); err != nil {
+		return nil, err
+	}
+	return &pb.EnableTwoFactorResponse{
+		Secret: secret,
+		Url:    totp.URL(twoFactorIssuer, dev.Username, secret),
+	}, nil
+}
+
+func (s *Service) ConfirmTwoFactor(
+	ctx context.Context,
+	req *pb.ConfirmTwoFactorRequest,
+) (*pb.ConfirmTwoFactorResponse, error) {
+	log.Debugf("received confirm two-factor request")
+
+	dev, err := getTwoFactorDev(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dev.TwoFactor == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication has not been started")
+	}
+	if dev.TwoFactor.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is already enabled")
+	}
+	step, ok := totp.Validate(dev.TwoFactor.Secret, req.Code, time.Now())
+	if !ok {
+		return nil, errTwoFactorInvalid
+	}
+	recovery, err := totp.NewRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(recovery))
+	for i, c := range recovery {
+		hashes[i] = totp.HashRecoveryCode(c)
+	}
+	if err := s.Collections.Accounts.SetTwoFactor(ctx, dev.Key, &mdb.TwoFactor{
+		Secret:        dev.TwoFactor.Secret,
+		Enabled:       true,
+		RecoveryCodes: hashes,
+		LastStep:      step,
+	}); err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmTwoFactorResponse{RecoveryCodes: recovery}, nil
+}
+
+func (s *Service) DisableTwoFactor(
+	ctx context.Context,
+	req *pb.DisableTwoFactorRequest,
+) (*pb.DisableTwoFactorResponse, error) {
+	log.Debugf("received disable two-factor request")
+
+	dev, err := getTwoFactorDev(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !dev.TwoFactorEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "Two-factor authentication is not enabled")
+	}
+	if err := s.verifyTwoFactor(ctx, dev, req.Code); err != nil {
+		return nil, err
+	}
+	if err := s.Collections.Accounts.SetTwoFactor(ctx, dev.Key, nil); err != nil {
+		return nil, err
+	}
+	return &pb.DisableTwoFactorResponse{}, nil
+}
+
+func (s *Service) SetOrgTwoFactor(
+	ctx context.Context,
+	req *pb.SetOrgTwoFactorRequest,
+) (*pb.SetOrgTwoFactorResponse, error) {
+	log.Debugf("received set org two-factor request")
+
+	account, err := s.getOrgOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Owners who require two-factor authentication would otherwise lock themselves out
+	if req.Required && !account.User.TwoFactorEnabled() {
+		return nil, status.Error(codes.FailedPrecondition,
+			"You must enable two-factor authentication before requiring it for the org")
+	}
+	if err := s.Collections.Accounts.SetRequireTwoFactor(ctx, account.Org.Key, req.Required); err != nil {
+		return nil, err
+	}
+	return &pb.SetOrgTwoFactorResponse{}, nil
+}
+
+// getTwoFactorDev returns the session dev.
+func getTwoFactorDev(ctx context.Context) (*mdb.Account, error) {
+	account, err := getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if account.User == nil {
+		return nil, status.Errorf(codes.InvalidArgument, errDevRequired.Error())
+	}
+	return account.User, nil
+}
+
+// requireTwoFactor verifies the two-factor code in ctx for sensitive methods called by a dev session.
+func (s *Service) requireTwoFactor(ctx context.Context, account *mdb.AccountCtx) error {
+	if account.User == nil {
+		return nil
+	}
+	code, _ := common.TwoFactorCodeFromMD(ctx)
+	return s.verifyTwoFactor(ctx, account.User, code)
+}
+
+// verifyTwoFactor returns an error if dev has enabled two-factor authentication
+// and code is not an unused authenticator or recovery code.
+// Rejected codes count towards locking dev out of two-factor authentication.
+func (s *Service) verifyTwoFactor(ctx context.Context, dev *mdb.Account, code string) error {
+	if !dev.TwoFactorEnabled() {
+		return nil
+	}
+	if code == "" {
+		return errTwoFactorRequired
+	}
+	if dev.TwoFactorLocked(time.Now()) {
+		return errTwoFactorLocked
+	}
+	if step, ok := totp.Validate(dev.TwoFactor.Secret, code, time.Now()); ok {
+		if err := s.Collections.Accounts.UseTwoFactorStep(ctx, dev.Key, step); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return status.Error(codes.Unauthenticated, "Two-factor code was already used")
+			}
+			return err
+		}
+		return nil
+	}
+	if err := s.Collections.Accounts.UseRecoveryCode(ctx, dev.Key, totp.HashRecoveryCode(code)); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return s.failTwoFactor(ctx, dev)
+		}
+		return err
+	}
+	return nil
+}
+
+// failTwoFactor records a rejected code for dev and returns the error to report.
+func (s *Service) failTwoFactor(ctx context.Context, dev *mdb.Account) error {
+	until, err := s.Collections.Accounts.FailTwoFactor(ctx, dev.Key)
+	if err != nil {
+		return err
+	}
+	if !until.IsZero() {
+		return errTwoFactorLocked
+	}
+	return errTwoFactorInvalid
+}
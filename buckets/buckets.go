@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/multiformats/go-multibase"
+	"os"
 	"strings"
+	"time"
+
+	"github.com/multiformats/go-multibase"
 
 	"github.com/textileio/go-threads/core/thread"
 	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
@@ -175,3 +178,68 @@ func ValidateAccessRoleKey(k string) error {
 	}
 	return nil
 }
+
+// FileInfo describes POSIX attributes of a bucket file.
+type FileInfo struct {
+	// Mode holds the permission bits of the file.
+	Mode os.FileMode `json:"mode"`
+	// ModTime is the file's last modification time.
+	ModTime time.Time `json:"mod_time"`
+	// Symlink is the link target if the file is a symbolic link.
+	Symlink string `json:"symlink,omitempty"`
+}
+
+// IsSymlink returns whether or not the file is a symbolic link.
+func (i FileInfo) IsSymlink() bool {
+	return i.Symlink != ""
+}
+
+// NewFileInfo returns file info for the local file at name without following symbolic links.
+func NewFileInfo(name string) (info FileInfo, err error) {
+	fi, err := os.Lstat(name)
+	if err != nil {
+		return
+	}
+	info = FileInfo{
+		Mode:    fi.Mode().Perm(),
+		ModTime: fi.ModTime(),
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		if info.Symlink, err = os.Readlink(name); err != nil {
+			return
+		}
+	}
+	return info, nil
+}
+
+// FileInfoToPb maps native type file info to protobuf type file info.
+func FileInfoToPb(info *FileInfo) *pb.FileInfo {
+	if info == nil {
+		return nil
+	}
+	var mtime int64
+	if !info.ModTime.IsZero() {
+		mtime = info.ModTime.UnixNano()
+	}
+	return &pb.FileInfo{
+		Mode:    uint32(info.Mode),
+		ModTime: mtime,
+		Symlink: info.Symlink,
+	}
+}
+
+// FileInfoFromPb maps protobuf type file info to native type file info.
+func FileInfoFromPb(pi *pb.FileInfo) *FileInfo {
+	if pi == nil {
+		return nil
+	}
+	var mtime time.Time
+	if pi.ModTime != 0 {
+		mtime = time.Unix(0, pi.ModTime)
+	}
+	return &FileInfo{
+		Mode:    os.FileMode(pi.Mode).Perm(),
+		ModTime: mtime,
+		Symlink: pi.Symlink,
+	}
+}
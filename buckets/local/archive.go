@@ -2,6 +2,7 @@ package local
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -161,6 +162,31 @@ type ArchiveStatusMessage struct {
 	InactivityClose bool
 }
 
+// MarshalJSON encodes the message with a string type of "message" or "error".
+func (m ArchiveStatusMessage) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Type            string `json:"type"`
+		Message         string `json:"message,omitempty"`
+		Error           string `json:"error,omitempty"`
+		InactivityClose bool   `json:"inactivity_close,omitempty"`
+	}{
+		Message:         strings.TrimSpace(m.Message),
+		InactivityClose: m.InactivityClose,
+	}
+	switch m.Type {
+	case ArchiveMessage:
+		v.Type = "message"
+	case ArchiveError:
+		v.Type = "error"
+	default:
+		return nil, fmt.Errorf("invalid archive message type: %d", m.Type)
+	}
+	if m.Error != nil {
+		v.Error = m.Error.Error()
+	}
+	return json.Marshal(v)
+}
+
 // ArchiveMessageType is the type of status message.
 type ArchiveMessageType int
 
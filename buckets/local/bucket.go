@@ -166,6 +166,7 @@ func (p Path) MarshalJSON() ([]byte, error) {
 type Metadata struct {
 	Key       string                  `json:"key,omitempty"`
 	Roles     map[string]buckets.Role `json:"roles,omitempty"`
+	Info      *buckets.FileInfo       `json:"info,omitempty"`
 	UpdatedAt time.Time               `json:"updated_at"`
 }
 
@@ -245,6 +246,7 @@ func pbMetadataToInfo(pbm map[string]*pb.Metadata) (map[string]Metadata, error)
 		md[p] = Metadata{
 			Key:       m.Key,
 			Roles:     roles,
+			Info:      buckets.FileInfoFromPb(m.Info),
 			UpdatedAt: time.Unix(0, m.UpdatedAt),
 		}
 	}
@@ -294,20 +296,11 @@ type Roots struct {
 
 // Roots returns the bucket's current local and remote root cids.
 func (b *Bucket) Roots(ctx context.Context) (roots Roots, err error) {
-	var lc, rc cid.Cid
-	if b.repo != nil {
-		lc, rc, err = b.repo.Root()
-		if err != nil {
-			return
-		}
-	}
-	if !rc.Defined() {
-		rc, err = b.getRemoteRoot(ctx)
-		if err != nil {
-			return
-		}
+	s, err := b.syncer()
+	if err != nil {
+		return
 	}
-	return Roots{Local: lc, Remote: rc}, nil
+	return s.Roots(ctx)
 }
 
 // Links wraps remote link info for a bucket.
@@ -394,6 +387,7 @@ func (b *Bucket) loadLocalRepo(ctx context.Context, pth, name string, setCidVers
 	if err != nil {
 		return err
 	}
+	r.SetIgnore(b.ignore)
 	b.repo = r
 	if setCidVersion {
 		if err = b.setRepoCidVersion(ctx); err != nil {
@@ -431,20 +425,33 @@ func (b *Bucket) containsPath(pth string) (c bool, err error) {
 	return strings.HasPrefix(ap, ar), nil
 }
 
-func (b *Bucket) getRemoteRoot(ctx context.Context) (cid.Cid, error) {
-	ctx, err := b.context(ctx)
-	if err != nil {
-		return cid.Undef, err
-	}
-	rr, err := b.clients.Buckets.Root(ctx, b.Key())
+// syncer returns a Syncer for the bucket's local file tree.
+func (b *Bucket) syncer() (*Syncer, error) {
+	id, err := b.Thread()
 	if err != nil {
-		return cid.Undef, err
+		return nil, err
 	}
-	rp, err := util.NewResolvedPath(rr.Root.Path)
+	bp, err := b.Path()
 	if err != nil {
-		return cid.Undef, err
+		return nil, err
 	}
-	return rp.Cid(), nil
+	return &Syncer{
+		client: b.clients.Buckets,
+		auth:   b.auth,
+		thread: id,
+		key:    b.Key(),
+		fs:     NewOsFs(),
+		root:   bp,
+		cwd:    b.cwd,
+		ignore: b.ignore,
+		repo:   b.repo,
+	}, nil
+}
+
+// ignore returns whether or not the path relative to the bucket root
+// belongs to the local config and should not be synced.
+func (b *Bucket) ignore(pth string) bool {
+	return pth == b.conf.Dir || strings.HasPrefix(pth, b.conf.Dir+string(os.PathSeparator))
 }
 
 func (b *Bucket) context(ctx context.Context) (context.Context, error) {
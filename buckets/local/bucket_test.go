@@ -253,6 +253,64 @@ func TestBucket_PullRemote(t *testing.T) {
 	ec.check(t, 0, 1)
 }
 
+func TestBucket_FileInfo(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+	bp, err := buck.Path()
+	require.NoError(t, err)
+
+	script := addRandomFile(t, buck, "bin/script", 512)
+	err = os.Chmod(script, 0755)
+	require.NoError(t, err)
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	err = os.Chtimes(script, mtime, mtime)
+	require.NoError(t, err)
+	err = os.Symlink("bin/script", filepath.Join(bp, "link"))
+	require.NoError(t, err)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	items, err := buck.ListRemotePath(context.Background(), "link")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NotNil(t, items[0].Info)
+	assert.Equal(t, "bin/script", items[0].Info.Symlink)
+
+	// Pull into a fresh checkout
+	conf2 := Config{Path: newDir(t)}
+	conf2.Key = buck.Key()
+	conf2.Thread, err = buck.Thread()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), conf2)
+	require.NoError(t, err)
+	bp2, err := buck2.Path()
+	require.NoError(t, err)
+
+	info, err := os.Lstat(filepath.Join(bp2, "bin/script"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	assert.True(t, info.ModTime().Equal(mtime))
+	target, err := os.Readlink(filepath.Join(bp2, "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "bin/script", target)
+
+	// Mode-only changes are detected and pushed
+	err = os.Chmod(script, 0700)
+	require.NoError(t, err)
+	diff, err := buck.DiffLocal()
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, du.Mod, diff[0].Type)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	_, err = buck2.PullRemote(context.Background())
+	require.NoError(t, err)
+	info, err = os.Lstat(filepath.Join(bp2, "bin/script"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+}
+
 func TestBucket_AddRemoteCid(t *testing.T) {
 	buckets := setup(t)
 	conf := getConf(t, buckets)
@@ -507,6 +565,54 @@ func TestBucket_Watch(t *testing.T) {
 	assert.Greater(t, offlineStateCount, 0) // At least one, but could be more as watch retries
 }
 
+func TestBucket_WatchTree(t *testing.T) {
+	t.Run("notify", func(t *testing.T) {
+		testWatchTree(t, false)
+	})
+	t.Run("polling", func(t *testing.T) {
+		testWatchTree(t, true)
+	})
+}
+
+func testWatchTree(t *testing.T, polling bool) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+	bp, err := buck.Path()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state, err := buck.Watch(ctx, WithPolling(polling))
+	require.NoError(t, err)
+	go func() {
+		for range state {
+		}
+	}()
+	time.Sleep(time.Second)
+
+	// Create a nested tree after the watch has started
+	addRandomFile(t, buck, "a/b/c/file1", 512)
+	addRandomFile(t, buck, "a/b/file2", 512)
+	time.Sleep(time.Second * 5)
+	_, err = buck.PushLocal(context.Background())
+	assert.True(t, errors.Is(err, ErrUpToDate))
+
+	// Rename a watched directory and write into it under the new name
+	err = os.Rename(filepath.Join(bp, "a/b"), filepath.Join(bp, "a/d"))
+	require.NoError(t, err)
+	addRandomFile(t, buck, "a/d/c/file3", 512)
+	time.Sleep(time.Second * 5)
+	_, err = buck.PushLocal(context.Background())
+	assert.True(t, errors.Is(err, ErrUpToDate))
+
+	items, err := buck.ListRemotePath(context.Background(), "a/d/c")
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	_, err = buck.ListRemotePath(context.Background(), "a/b")
+	require.Error(t, err)
+}
+
 func TestBucket_AccessRoles(t *testing.T) {
 	buckets := setup(t)
 	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
@@ -6,14 +6,10 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/ipfs/go-cid"
 	"github.com/spf13/cobra"
 	"github.com/textileio/go-threads/core/thread"
-	"github.com/textileio/textile/v2/api/bucketsd/client"
 	"github.com/textileio/textile/v2/api/common"
-	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/cmd"
-	"github.com/textileio/textile/v2/util"
 )
 
 var (
@@ -145,83 +141,31 @@ func (b *Buckets) NewBucket(ctx context.Context, conf Config, opts ...NewOption)
 		auth:      b.auth,
 		pushBlock: make(chan struct{}, 1),
 	}
-	ctx, err = buck.context(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	initRemote := conf.Key == ""
-	if initRemote {
-		rep, err := b.clients.Buckets.Create(
-			ctx,
-			client.WithName(args.name),
-			client.WithPrivate(args.private),
-			client.WithCid(args.fromCid),
-			client.WithUnfreeze(args.unfreeze))
-		if err != nil {
-			return nil, err
-		}
-
-		// If we're unfreezing, we simply return since the
-		// bucket will get created async if the Filecoin retrieval
-		// is successful. The user will `[hub] buck init -e` in the future
-		// to pull the new bucket.
-		if args.unfreeze {
-			buck.retrID = rep.RetrievalId
-			return buck, nil
-		}
-		buck.conf.Viper.Set("key", rep.Root.Key)
-
-		seed := filepath.Join(cwd, buckets.SeedName)
-		file, err := os.Create(seed)
-		if err != nil {
-			return nil, err
-		}
-		_, err = file.Write(rep.Seed)
-		if err != nil {
-			file.Close()
-			return nil, err
-		}
-		file.Close()
-
+	if !initRemote || !args.unfreeze {
 		if err = buck.loadLocalRepo(ctx, cwd, b.repoName(), false); err != nil {
 			return nil, err
 		}
-		if err = buck.repo.SaveFile(ctx, seed, buckets.SeedName); err != nil {
-			return nil, err
-		}
-		sc, err := cid.Decode(rep.SeedCid)
-		if err != nil {
-			return nil, err
-		}
-		if err = buck.repo.SetRemotePath(buckets.SeedName, sc); err != nil {
-			return nil, err
-		}
-		rp, err := util.NewResolvedPath(rep.Root.Path)
-		if err != nil {
-			return nil, err
-		}
-		if err = buck.repo.SetRemotePath("", rp.Cid()); err != nil {
-			return nil, err
-		}
-
-		buck.links = &Links{URL: rep.Links.Url, WWW: rep.Links.Www, IPNS: rep.Links.Ipns}
-	} else {
-		if err := buck.loadLocalRepo(ctx, cwd, b.repoName(), true); err != nil {
-			return nil, err
-		}
-		r, err := buck.Roots(ctx)
-		if err != nil {
-			return nil, err
-		}
-		if err = buck.repo.SetRemotePath("", r.Remote); err != nil {
-			return nil, err
-		}
+	}
+	s, err := buck.syncer()
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.Init(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-		if _, err = buck.RemoteLinks(ctx, ""); err != nil {
-			return nil, err
-		}
+	// If we're unfreezing, we simply return since the
+	// bucket will get created async if the Filecoin retrieval
+	// is successful. The user will `[hub] buck init -e` in the future
+	// to pull the new bucket.
+	if initRemote && args.unfreeze {
+		buck.retrID = s.RetrievalID()
+		return buck, nil
 	}
+	buck.conf.Viper.Set("key", s.Key())
+	buck.links = &links
 
 	// Write the local config to disk
 	dir := filepath.Join(cwd, buck.conf.Dir)
@@ -237,39 +181,6 @@ func (b *Buckets) NewBucket(ctx context.Context, conf Config, opts ...NewOption)
 		return
 	}
 	buck.conf.Viper.SetConfigFile(cfile)
-
-	// Pull remote bucket contents
-	if !initRemote || args.fromCid.Defined() {
-		if err := buck.repo.Save(ctx); err != nil {
-			return nil, err
-		}
-		switch args.strategy {
-		case Soft, Hybrid:
-			diff, missing, remove, err := buck.diffPath(ctx, "", cwd, args.strategy == Hybrid)
-			if err != nil {
-				return nil, err
-			}
-			if err = stashChanges(diff); err != nil {
-				return nil, err
-			}
-			if _, err = buck.handleChanges(ctx, missing, remove, args.events); err != nil {
-				return nil, err
-			}
-			if err := buck.repo.Save(ctx); err != nil {
-				return nil, err
-			}
-			if err = applyChanges(diff); err != nil {
-				return nil, err
-			}
-		case Hard:
-			if _, err := buck.getPath(ctx, "", cwd, nil, false, args.events); err != nil {
-				return nil, err
-			}
-			if err := buck.repo.Save(ctx); err != nil {
-				return nil, err
-			}
-		}
-	}
 	return buck, nil
 }
 
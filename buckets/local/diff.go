@@ -2,6 +2,8 @@ package local
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -9,6 +11,7 @@ import (
 
 	du "github.com/ipfs/go-merkledag/dagutils"
 	aurora2 "github.com/logrusorgru/aurora"
+	"github.com/spf13/afero"
 	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/cmd"
 )
@@ -17,10 +20,55 @@ var aurora = aurora2.NewAurora(runtime.GOOS != "windows")
 
 // Change describes a local bucket change.
 type Change struct {
-	Type du.ChangeType
-	Name string // Absolute file name
-	Path string // File name relative to the bucket root
-	Rel  string // File name relative to the bucket current working directory
+	Type du.ChangeType `json:"-"`
+	Name string        `json:"name"`           // Absolute file name
+	Path string        `json:"path"`           // File name relative to the bucket root
+	Rel  string        `json:"rel"`            // File name relative to the bucket current working directory
+	Size int64         `json:"size,omitempty"` // File size in bytes, if known
+}
+
+type changeJSON struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Rel  string `json:"rel"`
+	Size int64  `json:"size,omitempty"`
+}
+
+// MarshalJSON encodes the change with a string type of "add", "modify", or "remove".
+func (c Change) MarshalJSON() ([]byte, error) {
+	var t string
+	switch c.Type {
+	case du.Add:
+		t = "add"
+	case du.Mod:
+		t = "modify"
+	case du.Remove:
+		t = "remove"
+	default:
+		return nil, fmt.Errorf("invalid change type: %d", c.Type)
+	}
+	return json.Marshal(changeJSON{Type: t, Name: c.Name, Path: c.Path, Rel: c.Rel, Size: c.Size})
+}
+
+// UnmarshalJSON decodes a change encoded with MarshalJSON.
+func (c *Change) UnmarshalJSON(data []byte) error {
+	var v changeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v.Type {
+	case "add":
+		c.Type = du.Add
+	case "modify":
+		c.Type = du.Mod
+	case "remove":
+		c.Type = du.Remove
+	default:
+		return fmt.Errorf("invalid change type: %s", v.Type)
+	}
+	c.Name, c.Path, c.Rel, c.Size = v.Name, v.Path, v.Rel, v.Size
+	return nil
 }
 
 // ChangeType returns a string representation of a change type.
@@ -53,17 +101,27 @@ func ChangeColor(t du.ChangeType) func(arg interface{}) aurora2.Value {
 
 // DiffLocal returns a list of locally staged bucket file changes.
 func (b *Bucket) DiffLocal() ([]Change, error) {
-	if b.repo == nil {
-		return nil, ErrNotABucket
-	}
-	bp, err := b.Path()
+	s, err := b.syncer()
 	if err != nil {
 		return nil, err
 	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
 	defer cancel()
-	diff, err := b.repo.Diff(ctx, bp)
+	return s.diff(ctx)
+}
+
+// Diff returns a list of local file changes since the last sync.
+func (s *Syncer) Diff(ctx context.Context) ([]Change, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	return s.diff(ctx)
+}
+
+func (s *Syncer) diff(ctx context.Context) ([]Change, error) {
+	if s.repo == nil {
+		return nil, ErrNotABucket
+	}
+	diff, err := s.repo.Diff(ctx, s.root)
 	if err != nil {
 		return nil, err
 	}
@@ -72,23 +130,23 @@ func (b *Bucket) DiffLocal() ([]Change, error) {
 		return all, nil
 	}
 	for _, c := range diff {
-		fp := filepath.Join(bp, c.Path)
+		fp := filepath.Join(s.root, c.Path)
 		switch c.Type {
 		case du.Mod, du.Add:
-			names, err := b.walkPath(fp)
+			names, err := s.walkPath(fp)
 			if err != nil {
 				return nil, err
 			}
 			for _, n := range names {
-				p := strings.TrimPrefix(n, bp+string(os.PathSeparator))
-				r, err := filepath.Rel(b.cwd, n)
+				p := trimRoot(n, s.root)
+				r, err := s.rel(n)
 				if err != nil {
 					return nil, err
 				}
 				all = append(all, Change{Type: c.Type, Name: n, Path: p, Rel: r})
 			}
 		case du.Remove:
-			r, err := filepath.Rel(b.cwd, fp)
+			r, err := s.rel(fp)
 			if err != nil {
 				return nil, err
 			}
@@ -98,17 +156,17 @@ func (b *Bucket) DiffLocal() ([]Change, error) {
 	return all, nil
 }
 
-func (b *Bucket) walkPath(pth string) (names []string, err error) {
-	err = filepath.Walk(pth, func(n string, info os.FileInfo, err error) error {
+func (s *Syncer) walkPath(pth string) (names []string, err error) {
+	err = afero.Walk(s.fs, pth, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 		if !info.IsDir() {
-			f := strings.TrimPrefix(n, pth+string(os.PathSeparator))
+			f := trimRoot(n, pth)
 			if Ignore(n) ||
 				f == buckets.SeedName ||
-				strings.HasPrefix(f, b.conf.Dir) ||
-				strings.HasSuffix(f, patchExt) {
+				strings.HasSuffix(f, patchExt) ||
+				(s.ignore != nil && s.ignore(trimRoot(n, s.root))) {
 				return nil
 			}
 			names = append(names, n)
@@ -0,0 +1,94 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+// Domain is a custom domain attached to a bucket.
+type Domain struct {
+	// Name is the domain name.
+	Name string `json:"name"`
+	// Verified indicates the domain's ownership challenge has been verified.
+	Verified bool `json:"verified"`
+	// ChallengeName is the name of the TXT record used to verify ownership.
+	ChallengeName string `json:"challengeName"`
+	// ChallengeValue is the value of the TXT record used to verify ownership.
+	ChallengeValue string `json:"challengeValue"`
+	// Target is the host the domain's CNAME record should point to.
+	Target string `json:"target"`
+	// CreatedAt is the time the domain was added.
+	CreatedAt time.Time `json:"createdAt"`
+	// VerifiedAt is the time the domain was verified.
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+}
+
+// AddDomain attaches a custom domain to the bucket.
+func (b *Bucket) AddDomain(ctx context.Context, domain string) (d Domain, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	res, err := b.clients.Buckets.AddDomain(ctx, b.Key(), domain)
+	if err != nil {
+		return
+	}
+	return domainFromPb(res), nil
+}
+
+// VerifyDomain checks a custom domain's TXT challenge record.
+func (b *Bucket) VerifyDomain(ctx context.Context, domain string) (d Domain, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	res, err := b.clients.Buckets.VerifyDomain(ctx, b.Key(), domain)
+	if err != nil {
+		return
+	}
+	return domainFromPb(res), nil
+}
+
+// Domains returns the custom domains attached to the bucket.
+func (b *Bucket) Domains(ctx context.Context) (list []Domain, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	res, err := b.clients.Buckets.ListDomains(ctx, b.Key())
+	if err != nil {
+		return
+	}
+	list = make([]Domain, len(res))
+	for i, d := range res {
+		list[i] = domainFromPb(d)
+	}
+	return list, nil
+}
+
+// RemoveDomain detaches a custom domain from the bucket.
+func (b *Bucket) RemoveDomain(ctx context.Context, domain string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.RemoveDomain(ctx, b.Key(), domain)
+}
+
+func domainFromPb(d *pb.Domain) Domain {
+	domain := Domain{
+		Name:           d.Name,
+		Verified:       d.Verified,
+		ChallengeName:  d.ChallengeName,
+		ChallengeValue: d.ChallengeValue,
+		Target:         d.Target,
+		CreatedAt:      time.Unix(0, d.CreatedAt),
+	}
+	if d.VerifiedAt != 0 {
+		verified := time.Unix(0, d.VerifiedAt)
+		domain.VerifiedAt = &verified
+	}
+	return domain
+}
@@ -0,0 +1,129 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+var (
+	// errNoSymlinks indicates the file system cannot read symbolic links.
+	errNoSymlinks = errors.New("file system does not support symbolic links")
+	// errUnsafePath indicates a pulled path would be written outside the bucket root.
+	errUnsafePath = errors.New("path is outside the bucket root")
+)
+
+// Linker is an optional interface implemented by file systems that support symbolic links.
+// Symbolic links in file systems that don't implement it are read and written as regular files
+// containing the link target.
+type Linker interface {
+	// SymlinkIfPossible creates newname as a symbolic link to oldname.
+	SymlinkIfPossible(oldname, newname string) error
+	// ReadlinkIfPossible returns the destination of the named symbolic link.
+	ReadlinkIfPossible(name string) (string, error)
+}
+
+// osFs is an afero.OsFs with symbolic link support.
+type osFs struct {
+	*afero.OsFs
+}
+
+// NewOsFs returns a file system backed by the operating system.
+func NewOsFs() afero.Fs {
+	return &osFs{OsFs: &afero.OsFs{}}
+}
+
+func (f *osFs) SymlinkIfPossible(oldname, newname string) error {
+	return os.Symlink(oldname, newname)
+}
+
+func (f *osFs) ReadlinkIfPossible(name string) (string, error) {
+	return os.Readlink(name)
+}
+
+// lstat returns file info for name without following symbolic links if fs supports it.
+func lstat(fs afero.Fs, name string) (os.FileInfo, error) {
+	if l, ok := fs.(afero.Lstater); ok {
+		info, _, err := l.LstatIfPossible(name)
+		return info, err
+	}
+	return fs.Stat(name)
+}
+
+// symlink creates newname as a symbolic link to oldname.
+// File systems without symbolic link support get a regular file containing oldname.
+func symlink(fs afero.Fs, oldname, newname string) error {
+	if l, ok := fs.(Linker); ok {
+		return l.SymlinkIfPossible(oldname, newname)
+	}
+	return afero.WriteFile(fs, newname, []byte(oldname), 0644)
+}
+
+// readlink returns the destination of the named symbolic link.
+func readlink(fs afero.Fs, name string) (string, error) {
+	if l, ok := fs.(Linker); ok {
+		return l.ReadlinkIfPossible(name)
+	}
+	return "", &os.PathError{Op: "readlink", Path: name, Err: errNoSymlinks}
+}
+
+// mkdirParent creates the parent directory of name.
+func mkdirParent(fs afero.Fs, name string) error {
+	return fs.MkdirAll(filepath.Dir(name), os.ModePerm)
+}
+
+// trimRoot returns name relative to the directory root, which must contain name.
+func trimRoot(name, root string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, root), string(os.PathSeparator))
+}
+
+// within returns whether name is root or inside it.
+func within(root, name string) bool {
+	rel, err := filepath.Rel(root, name)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator))
+}
+
+// checkParents returns an error if name is not inside root, or if any of its existing
+// parent directories below root is a symbolic link, which would be followed when writing name.
+func checkParents(fs afero.Fs, root, name string) error {
+	if name == root || !within(root, name) {
+		return fmt.Errorf("%s: %w", name, errUnsafePath)
+	}
+	rel, err := filepath.Rel(root, filepath.Dir(name))
+	if err != nil || rel == "." {
+		return err
+	}
+	dir := root
+	for _, part := range strings.Split(rel, string(os.PathSeparator)) {
+		dir = filepath.Join(dir, part)
+		info, err := lstat(fs, dir)
+		if os.IsNotExist(err) {
+			return nil // The rest is created
+		} else if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("%s: parent %s is a symbolic link: %w", name, dir, errUnsafePath)
+		}
+	}
+	return nil
+}
+
+// checkSymlinkTarget returns an error if the target of a symbolic link at name
+// is absolute or resolves outside root.
+func checkSymlinkTarget(root, name, target string) error {
+	if target == "" || filepath.IsAbs(target) || strings.HasPrefix(target, "/") {
+		return fmt.Errorf("%s: symbolic link target %q: %w", name, target, errUnsafePath)
+	}
+	if !within(root, filepath.Join(filepath.Dir(name), filepath.FromSlash(target))) {
+		return fmt.Errorf("%s: symbolic link target %q: %w", name, target, errUnsafePath)
+	}
+	return nil
+}
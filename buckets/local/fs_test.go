@@ -0,0 +1,33 @@
+package local
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSymlinkTarget(t *testing.T) {
+	root := filepath.Join(string(os.PathSeparator), "bucket")
+	name := filepath.Join(root, "dir", "link")
+	assert.NoError(t, checkSymlinkTarget(root, name, "file"))
+	assert.NoError(t, checkSymlinkTarget(root, name, "../file"))
+	assert.True(t, errors.Is(checkSymlinkTarget(root, name, "../../file"), errUnsafePath))
+	assert.True(t, errors.Is(checkSymlinkTarget(root, name, "/etc/passwd"), errUnsafePath))
+	assert.True(t, errors.Is(checkSymlinkTarget(root, name, ""), errUnsafePath))
+}
+
+func TestCheckParents(t *testing.T) {
+	root := t.TempDir()
+	fs := NewOsFs()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dir"), os.ModePerm))
+	require.NoError(t, os.Symlink(t.TempDir(), filepath.Join(root, "link")))
+
+	assert.NoError(t, checkParents(fs, root, filepath.Join(root, "file")))
+	assert.NoError(t, checkParents(fs, root, filepath.Join(root, "dir", "new", "file")))
+	assert.True(t, errors.Is(checkParents(fs, root, filepath.Join(root, "link", "file")), errUnsafePath))
+	assert.True(t, errors.Is(checkParents(fs, root, filepath.Join(root, "..", "file")), errUnsafePath))
+}
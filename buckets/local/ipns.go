@@ -0,0 +1,83 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+// IPNSSettings controls how a bucket's IPNS name is published.
+type IPNSSettings struct {
+	// Disabled stops the bucket from being published to IPNS.
+	Disabled bool
+	// Lifetime is how long published records are valid. Zero uses the server default.
+	Lifetime time.Duration
+	// TTL is how long resolvers may cache published records. Zero uses the server default.
+	TTL time.Duration
+	// CoalesceInterval delays publishing so that updates within the interval are published once.
+	// Zero publishes every update.
+	CoalesceInterval time.Duration
+}
+
+// MarshalJSON encodes durations as strings, e.g., "24h0m0s".
+func (s IPNSSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"disabled":         s.Disabled,
+		"lifetime":         s.Lifetime.String(),
+		"ttl":              s.TTL.String(),
+		"coalesceInterval": s.CoalesceInterval.String(),
+	})
+}
+
+// IPNSSettings returns the bucket's IPNS publishing settings.
+func (b *Bucket) IPNSSettings(ctx context.Context) (settings IPNSSettings, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	res, err := b.clients.Buckets.IPNSSettings(ctx, b.Key())
+	if err != nil {
+		return
+	}
+	return ipnsSettingsFromPb(res), nil
+}
+
+// SetIPNSSettings replaces the bucket's IPNS publishing settings.
+func (b *Bucket) SetIPNSSettings(ctx context.Context, settings IPNSSettings) (res IPNSSettings, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	pbs, err := b.clients.Buckets.SetIPNSSettings(ctx, b.Key(), &pb.IPNSSettings{
+		Disabled:                settings.Disabled,
+		LifetimeSeconds:         int64(settings.Lifetime / time.Second),
+		TtlSeconds:              int64(settings.TTL / time.Second),
+		CoalesceIntervalSeconds: int64(settings.CoalesceInterval / time.Second),
+	})
+	if err != nil {
+		return
+	}
+	return ipnsSettingsFromPb(pbs), nil
+}
+
+func ipnsSettingsFromPb(settings *pb.IPNSSettings) IPNSSettings {
+	return IPNSSettings{
+		Disabled:         settings.Disabled,
+		Lifetime:         time.Duration(settings.LifetimeSeconds) * time.Second,
+		TTL:              time.Duration(settings.TtlSeconds) * time.Second,
+		CoalesceInterval: time.Duration(settings.CoalesceIntervalSeconds) * time.Second,
+	}
+}
+
+// ExportIPNSKey returns the bucket's IPNS private key encrypted with password or to identity.
+// Only the bucket owner can export its key.
+func (b *Bucket) ExportIPNSKey(ctx context.Context, password string, identity thread.PubKey) ([]byte, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.clients.Buckets.ExportIPNSKey(ctx, b.Key(), password, identity)
+}
@@ -8,19 +8,21 @@ import (
 
 	"github.com/ipfs/go-cid"
 	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
 )
 
 var errEmptyItem = fmt.Errorf("item is empty")
 
 // BucketItem describes an item (file/directory) in a bucket.
 type BucketItem struct {
-	Cid        cid.Cid      `json:"cid"`
-	Name       string       `json:"name"`
-	Path       string       `json:"path"`
-	Size       int64        `json:"size"`
-	IsDir      bool         `json:"is_dir"`
-	Items      []BucketItem `json:"items"`
-	ItemsCount int          `json:"items_count"`
+	Cid        cid.Cid           `json:"cid"`
+	Name       string            `json:"name"`
+	Path       string            `json:"path"`
+	Size       int64             `json:"size"`
+	IsDir      bool              `json:"is_dir"`
+	Info       *buckets.FileInfo `json:"info,omitempty"`
+	Items      []BucketItem      `json:"items"`
+	ItemsCount int               `json:"items_count"`
 }
 
 // ListRemotePath returns a list of all bucket items under path.
@@ -76,12 +78,17 @@ func pbItemToItem(pi *pb.PathItem) (item BucketItem, err error) {
 		}
 		items[j] = ii
 	}
+	var info *buckets.FileInfo
+	if pi.Metadata != nil {
+		info = buckets.FileInfoFromPb(pi.Metadata.Info)
+	}
 	return BucketItem{
 		Cid:        c,
 		Name:       pi.Name,
 		Path:       pi.Path,
 		Size:       pi.Size,
 		IsDir:      pi.IsDir,
+		Info:       info,
 		Items:      items,
 		ItemsCount: int(pi.ItemsCount),
 	}, nil
@@ -0,0 +1,26 @@
+package local
+
+import (
+	"context"
+
+	"github.com/textileio/textile/v2/buckets/mount"
+)
+
+// Mount serves the remote bucket as a file system at dir until ctx is done
+// or the file system is unmounted. Files are read from and written to the remote
+// directly, so no local bucket or pull is required. See mount.Mount for more.
+func (b *Bucket) Mount(ctx context.Context, dir string, cacheSize int64) error {
+	// Validate the thread up front so the context func below can't fail
+	if _, err := b.Thread(); err != nil {
+		return err
+	}
+	return mount.Mount(ctx, dir, mount.Config{
+		Client: b.clients.Buckets,
+		Key:    b.Key(),
+		Context: func(ctx context.Context) context.Context {
+			ctx, _ = b.context(ctx)
+			return ctx
+		},
+		CacheSize: cacheSize,
+	})
+}
@@ -0,0 +1,330 @@
+package local
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/radovskyb/watcher"
+)
+
+// fsEvent signals that the watched tree may have changed.
+type fsEvent struct {
+	// Rescan indicates that events were lost, e.g., a kernel queue overflow,
+	// so the entire tree must be reconciled with the repo.
+	Rescan bool
+}
+
+// fsWatcher reports local file system activity under a root directory.
+type fsWatcher interface {
+	// Events returns a channel of change notifications.
+	Events() <-chan fsEvent
+	// Errors returns a channel of non-recoverable watch errors.
+	Errors() <-chan error
+	// Close stops watching.
+	Close() error
+}
+
+// newFSWatcher returns an event-driven watcher for root if the platform supports it
+// (inotify on Linux, kqueue on BSD/macOS, ReadDirectoryChangesW on Windows).
+// If the native watcher cannot be created, e.g., the inotify watch limit is reached,
+// a polling watcher is returned instead.
+// Paths for which skip returns true are neither watched nor reported.
+func newFSWatcher(root string, skip func(string) bool, polling bool) (fsWatcher, error) {
+	if !polling {
+		if w, err := newNotifyWatcher(root, skip); err == nil {
+			return w, nil
+		}
+	}
+	return newPollWatcher(root, skip)
+}
+
+// notifyWatcher is an fsWatcher backed by native file system notifications.
+// Native notifications are not recursive, so a watch is added for every directory in the tree.
+type notifyWatcher struct {
+	root string
+	skip func(string) bool
+	w    *fsnotify.Watcher
+
+	events chan fsEvent
+	errors chan error
+	done   chan struct{}
+
+	dirs map[string]struct{}
+	lk   sync.Mutex
+}
+
+func newNotifyWatcher(root string, skip func(string) bool) (*notifyWatcher, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	nw := &notifyWatcher{
+		root:   root,
+		skip:   skip,
+		w:      w,
+		events: make(chan fsEvent, 1),
+		errors: make(chan error, 1),
+		done:   make(chan struct{}),
+		dirs:   make(map[string]struct{}),
+	}
+	if err := nw.addRecursive(root); err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+	go nw.loop()
+	return nw, nil
+}
+
+func (nw *notifyWatcher) Events() <-chan fsEvent {
+	return nw.events
+}
+
+func (nw *notifyWatcher) Errors() <-chan error {
+	return nw.errors
+}
+
+func (nw *notifyWatcher) Close() error {
+	close(nw.done)
+	return nw.w.Close()
+}
+
+func (nw *notifyWatcher) loop() {
+	for {
+		select {
+		case e, ok := <-nw.w.Events:
+			if !ok {
+				return
+			}
+			// Events for watches that were already removed carry no name
+			if e.Name == "" || nw.skip(e.Name) {
+				continue
+			}
+			nw.handle(e)
+		case err, ok := <-nw.w.Errors:
+			if !ok {
+				return
+			}
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				nw.rescan()
+				continue
+			}
+			select {
+			case nw.errors <- err:
+			case <-nw.done:
+				return
+			}
+		case <-nw.done:
+			return
+		}
+	}
+}
+
+func (nw *notifyWatcher) handle(e fsnotify.Event) {
+	if e.Op&(fsnotify.Rename|fsnotify.Remove) != 0 {
+		// The kernel keeps watching a directory after it's moved, but under a stale name.
+		// Drop watches for the old subtree; the new name is reported as a create.
+		nw.removeRecursive(e.Name)
+	}
+	if e.Op&fsnotify.Create != 0 {
+		if info, err := os.Lstat(e.Name); err == nil && info.IsDir() {
+			// Files created in the new directory before the watch was added
+			// are picked up by the subsequent diff.
+			if err := nw.addRecursive(e.Name); err != nil {
+				nw.rescan()
+				return
+			}
+		}
+	}
+	nw.notify(fsEvent{})
+}
+
+// rescan rebuilds all watches and requests a full reconciliation.
+func (nw *notifyWatcher) rescan() {
+	nw.removeRecursive(nw.root)
+	if err := nw.addRecursive(nw.root); err != nil {
+		select {
+		case nw.errors <- err:
+		case <-nw.done:
+		}
+		return
+	}
+	nw.notify(fsEvent{Rescan: true})
+}
+
+// notify queues an event without blocking.
+// A pending rescan is never replaced by a plain event.
+func (nw *notifyWatcher) notify(e fsEvent) {
+	for {
+		select {
+		case nw.events <- e:
+			return
+		case <-nw.done:
+			return
+		default:
+		}
+		select {
+		case p := <-nw.events:
+			e.Rescan = e.Rescan || p.Rescan
+		default:
+		}
+	}
+}
+
+func (nw *notifyWatcher) addRecursive(pth string) error {
+	return filepath.Walk(pth, func(n string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) { // Removed while walking
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if n != nw.root && nw.skip(n) {
+			return filepath.SkipDir
+		}
+		nw.lk.Lock()
+		defer nw.lk.Unlock()
+		if _, ok := nw.dirs[n]; ok {
+			return nil
+		}
+		if err := nw.w.Add(n); err != nil {
+			return err
+		}
+		nw.dirs[n] = struct{}{}
+		return nil
+	})
+}
+
+func (nw *notifyWatcher) removeRecursive(pth string) {
+	nw.lk.Lock()
+	defer nw.lk.Unlock()
+	prefix := pth + string(os.PathSeparator)
+	for d := range nw.dirs {
+		if d == pth || strings.HasPrefix(d, prefix) {
+			_ = nw.w.Remove(d)
+			delete(nw.dirs, d)
+		}
+	}
+}
+
+// pollWatcher is an fsWatcher that periodically scans the tree for changes.
+type pollWatcher struct {
+	w      *watcher.Watcher
+	events chan fsEvent
+	errors chan error
+	// done is closed by Close. The watcher's Closed channel is only closed if it started.
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newPollWatcher(root string, skip func(string) bool) (*pollWatcher, error) {
+	w := watcher.New()
+	w.SetMaxEvents(1)
+	w.AddFilterHook(func(info os.FileInfo, fullPath string) error {
+		if skip(fullPath) {
+			return watcher.ErrSkip
+		}
+		return nil
+	})
+	if err := w.AddRecursive(root); err != nil {
+		w.Close()
+		return nil, err
+	}
+	pw := &pollWatcher{
+		w:      w,
+		events: make(chan fsEvent),
+		errors: make(chan error),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		if err := w.Start(fileSystemWatchInterval); err != nil {
+			select {
+			case pw.errors <- err:
+			case <-pw.done:
+			}
+		}
+	}()
+	go func() {
+		for {
+			select {
+			case <-w.Event:
+				select {
+				case pw.events <- fsEvent{}:
+				case <-pw.done:
+					return
+				}
+			case err := <-w.Error:
+				select {
+				case pw.errors <- err:
+				case <-pw.done:
+					return
+				}
+			case <-pw.done:
+				return
+			}
+		}
+	}()
+	return pw, nil
+}
+
+func (pw *pollWatcher) Events() <-chan fsEvent {
+	return pw.events
+}
+
+func (pw *pollWatcher) Errors() <-chan error {
+	return pw.errors
+}
+
+func (pw *pollWatcher) Close() error {
+	pw.closeOnce.Do(func() {
+		close(pw.done)
+		pw.w.Close()
+	})
+	return nil
+}
+
+// debounce coalesces bursts of events, emitting once the tree has been quiet for delay.
+func debounce(in <-chan fsEvent, delay time.Duration, done <-chan struct{}) <-chan fsEvent {
+	out := make(chan fsEvent)
+	go func() {
+		var (
+			pending *fsEvent
+			timer   = time.NewTimer(delay)
+		)
+		timer.Stop()
+		defer timer.Stop()
+		for {
+			select {
+			case e, ok := <-in:
+				if !ok {
+					return
+				}
+				if pending == nil {
+					pending = &fsEvent{}
+				}
+				pending.Rescan = pending.Rescan || e.Rescan
+				timer.Reset(delay)
+			case <-timer.C:
+				if pending == nil {
+					continue
+				}
+				select {
+				case out <- *pending:
+					pending = nil
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
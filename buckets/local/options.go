@@ -2,6 +2,7 @@ package local
 
 import (
 	cid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/crypto"
 )
 
 type newOptions struct {
@@ -11,6 +12,7 @@ type newOptions struct {
 	strategy InitStrategy
 	events   chan<- Event
 	unfreeze bool
+	ipnsKey  crypto.PrivKey
 }
 
 // NewOption is used when creating a new bucket.
@@ -44,6 +46,13 @@ func WithUnfreeze(enabled bool) NewOption {
 	}
 }
 
+// WithIPNSKey imports an existing private key as the new remote bucket's IPNS key.
+func WithIPNSKey(sk crypto.PrivKey) NewOption {
+	return func(args *newOptions) {
+		args.ipnsKey = sk
+	}
+}
+
 // InitStrategy describes the type of init strategy.
 type InitStrategy int
 
@@ -151,6 +160,7 @@ func WithAddEvents(ch chan<- Event) AddOption {
 
 type watchOptions struct {
 	offline bool
+	polling bool
 	events  chan<- Event
 }
 
@@ -164,6 +174,15 @@ func WithOffline(offline bool) WatchOption {
 	}
 }
 
+// WithPolling forces the watcher to poll the file system for changes
+// instead of using native file system notifications.
+// Polling is used automatically if native notifications are unavailable.
+func WithPolling(polling bool) WatchOption {
+	return func(args *watchOptions) {
+		args.polling = polling
+	}
+}
+
 // WithWatchEvents allows the caller to receive events when watching a bucket for changes.
 func WithWatchEvents(ch chan<- Event) WatchOption {
 	return func(args *watchOptions) {
@@ -0,0 +1,161 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+
+	du "github.com/ipfs/go-merkledag/dagutils"
+)
+
+// Plan describes the changes a push or pull would make without making them.
+type Plan struct {
+	// Changes is the list of file changes sorted by path.
+	Changes []Change `json:"changes"`
+	// Adds is the number of files that would be added.
+	Adds int `json:"adds"`
+	// Mods is the number of files that would be modified.
+	Mods int `json:"mods"`
+	// Deletes is the number of files that would be deleted.
+	Deletes int `json:"deletes"`
+	// Bytes is the number of bytes that would be transferred.
+	Bytes int64 `json:"bytes"`
+}
+
+func newPlan(changes []Change) Plan {
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	p := Plan{Changes: changes}
+	if p.Changes == nil {
+		p.Changes = []Change{}
+	}
+	for _, c := range changes {
+		switch c.Type {
+		case du.Add:
+			p.Adds++
+		case du.Mod:
+			p.Mods++
+		case du.Remove:
+			p.Deletes++
+		}
+		p.Bytes += c.Size
+	}
+	return p
+}
+
+// PlanPushLocal returns the changes PushLocal would make with the same options.
+// Nothing is written locally or to the remote. The confirm and events options are ignored.
+func (b *Bucket) PlanPushLocal(ctx context.Context, opts ...PathOption) (plan Plan, err error) {
+	b.Lock()
+	defer b.Unlock()
+	s, err := b.syncer()
+	if err != nil {
+		return
+	}
+	return s.PlanPush(ctx, opts...)
+}
+
+// PlanPush returns the changes Push would make with the same options.
+// Nothing is written locally or to the remote. The confirm and events options are ignored.
+func (s *Syncer) PlanPush(ctx context.Context, opts ...PathOption) (plan Plan, err error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	ctx = s.context(ctx)
+	args := &pathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	diff, err := s.pushDiff(ctx, args)
+	if err != nil {
+		return
+	}
+	for i, c := range diff {
+		if c.Type == du.Remove {
+			continue
+		}
+		fi, err := lstat(s.fs, c.Name)
+		if err != nil {
+			return plan, err
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			target, err := readlink(s.fs, c.Name)
+			if err != nil {
+				return plan, err
+			}
+			diff[i].Size = int64(len(target))
+		} else {
+			diff[i].Size = fi.Size()
+		}
+	}
+	return newPlan(diff), nil
+}
+
+// PlanPullRemote returns the changes PullRemote would make with the same options.
+// Nothing is written locally. The confirm and events options are ignored.
+func (b *Bucket) PlanPullRemote(ctx context.Context, opts ...PathOption) (plan Plan, err error) {
+	b.Lock()
+	defer b.Unlock()
+	s, err := b.syncer()
+	if err != nil {
+		return
+	}
+	return s.PlanPull(ctx, opts...)
+}
+
+// PlanPull returns the changes Pull would make with the same options.
+// Nothing is written locally. The confirm and events options are ignored.
+func (s *Syncer) PlanPull(ctx context.Context, opts ...PathOption) (plan Plan, err error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	ctx = s.context(ctx)
+	args := &pathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	diff, err := s.diff(ctx)
+	if errors.Is(err, ErrNotABucket) {
+		args.force = true
+	} else if err != nil {
+		return
+	}
+	missing, remove, err := s.pullChanges(ctx, "", s.root, diff, args.force)
+	if err != nil {
+		return
+	}
+
+	// Local changes are re-applied after a pull unless pulling hard
+	keep := make(map[string]struct{})
+	if !args.hard {
+		for _, c := range diff {
+			keep[c.Path] = struct{}{}
+		}
+	}
+
+	var changes []Change
+	for _, o := range missing {
+		t := du.Add
+		if _, err := lstat(s.fs, o.name); err == nil {
+			t = du.Mod
+		}
+		r, err := s.rel(o.name)
+		if err != nil {
+			return plan, err
+		}
+		changes = append(changes, Change{Type: t, Name: o.name, Path: o.path, Rel: r, Size: o.size})
+	}
+	for p, n := range remove {
+		if _, ok := keep[p]; ok {
+			continue
+		}
+		r, err := s.rel(n)
+		if err != nil {
+			return plan, err
+		}
+		changes = append(changes, Change{Type: du.Remove, Name: n, Path: p, Rel: r})
+	}
+	return newPlan(changes), nil
+}
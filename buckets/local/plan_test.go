@@ -0,0 +1,26 @@
+package local_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	du "github.com/ipfs/go-merkledag/dagutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/v2/buckets/local"
+)
+
+func TestChange_JSON(t *testing.T) {
+	c := Change{Type: du.Mod, Name: "/bucket/foo.txt", Path: "foo.txt", Rel: "foo.txt", Size: 3}
+	data, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"modify","name":"/bucket/foo.txt","path":"foo.txt","rel":"foo.txt","size":3}`, string(data))
+
+	var c2 Change
+	err = json.Unmarshal(data, &c2)
+	require.NoError(t, err)
+	assert.Equal(t, c, c2)
+
+	err = json.Unmarshal([]byte(`{"type":"rename"}`), &c2)
+	assert.Error(t, err)
+}
@@ -24,16 +24,25 @@ var MaxPullConcurrency = 10
 func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
-	ctx, err = b.context(ctx)
+	s, err := b.syncer()
 	if err != nil {
 		return
 	}
+	return s.Pull(ctx, opts...)
+}
+
+// Pull pulls remote files.
+// By default, only missing files are pulled. See PathOption for more info.
+func (s *Syncer) Pull(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	ctx = s.context(ctx)
 	args := &pathOptions{}
 	for _, opt := range opts {
 		opt(args)
 	}
 
-	diff, err := b.DiffLocal()
+	diff, err := s.diff(ctx)
 	if errors.Is(err, ErrNotABucket) {
 		args.force = true
 	} else if err != nil {
@@ -47,16 +56,12 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 
 	// Stash local modifications and additions if not pulling hard
 	if !args.hard {
-		if err := stashChanges(diff); err != nil {
+		if err := stashChanges(s.fs, diff); err != nil {
 			return roots, err
 		}
 	}
 
-	bp, err := b.Path()
-	if err != nil {
-		return
-	}
-	changes, err := b.getPath(ctx, "", bp, diff, args.force, args.events)
+	changes, err := s.getPath(ctx, "", s.root, diff, args.force, args.events)
 	if err != nil {
 		return
 	}
@@ -64,41 +69,56 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 		return roots, ErrUpToDate
 	}
 
-	if b.repo != nil {
-		if err := b.repo.Save(ctx); err != nil {
+	if s.repo != nil {
+		if err := s.repo.Save(ctx); err != nil {
 			return roots, err
 		}
-		rc, err := b.getRemoteRoot(ctx)
+		rc, err := s.getRemoteRoot(ctx)
 		if err != nil {
 			return roots, err
 		}
-		if err := b.repo.SetRemotePath("", rc); err != nil {
+		if err := s.repo.SetRemotePath("", rc); err != nil {
 			return roots, err
 		}
 	}
 
 	// Re-apply local changes if not pulling hard
 	if !args.hard {
-		if err := applyChanges(diff); err != nil {
+		if err := applyChanges(s.fs, diff); err != nil {
 			return roots, err
 		}
 	}
-	return b.Roots(ctx)
+	return s.Roots(ctx)
 }
 
-func (b *Bucket) getPath(
+func (s *Syncer) getPath(
 	ctx context.Context,
 	pth, dest string,
 	diff []Change,
 	force bool,
 	events chan<- Event,
 ) (changes int, err error) {
-	all, missing, err := b.listPath(ctx, pth, dest, force)
+	missing, remove, err := s.pullChanges(ctx, pth, dest, diff, force)
 	if err != nil {
 		return
 	}
-	remove := make(map[string]string)
-	list, err := b.walkPath(dest)
+	return s.handleChanges(ctx, missing, remove, events)
+}
+
+// pullChanges returns the remote objects that are missing locally and the
+// local files that should be removed when pulling pth to dest.
+func (s *Syncer) pullChanges(
+	ctx context.Context,
+	pth, dest string,
+	diff []Change,
+	force bool,
+) (missing []object, remove map[string]string, err error) {
+	all, missing, err := s.listPath(ctx, pth, dest, force)
+	if err != nil {
+		return
+	}
+	remove = make(map[string]string)
+	list, err := s.walkPath(dest)
 	if err != nil {
 		return
 	}
@@ -109,7 +129,7 @@ loop:
 				continue loop
 			}
 		}
-		p := strings.TrimPrefix(n, dest+string(os.PathSeparator))
+		p := trimRoot(n, dest)
 		remove[p] = n
 	}
 looop:
@@ -123,11 +143,10 @@ looop:
 			remove[l.Path] = l.Name
 		}
 	}
-
-	return b.handleChanges(ctx, missing, remove, events)
+	return missing, remove, nil
 }
 
-func (b *Bucket) handleChanges(
+func (s *Syncer) handleChanges(
 	ctx context.Context,
 	missing []object,
 	remove map[string]string,
@@ -153,7 +172,7 @@ func (b *Bucket) handleChanges(
 				if gctx.Err() != nil {
 					return nil
 				}
-				return b.getFile(ctx, b.Key(), o, events, progress)
+				return s.getFile(ctx, s.key, o, events, progress)
 			})
 		}
 		for i := 0; i < cap(lim); i++ {
@@ -167,9 +186,9 @@ func (b *Bucket) handleChanges(
 		for p, n := range remove {
 			// The file may have been modified locally, in which case it will have been moved to a patch.
 			// So, we just ignore the error here.
-			_ = os.RemoveAll(n)
+			_ = s.fs.RemoveAll(n)
 			if events != nil {
-				rel, err := filepath.Rel(b.cwd, n)
+				rel, err := s.rel(n)
 				if err != nil {
 					return count, err
 				}
@@ -179,8 +198,8 @@ func (b *Bucket) handleChanges(
 				}
 			}
 
-			if b.repo != nil {
-				if err := b.repo.RemovePath(ctx, p); err != nil {
+			if s.repo != nil {
+				if err := s.repo.RemovePath(ctx, p); err != nil {
 					return count, err
 				}
 			}
@@ -189,17 +208,17 @@ func (b *Bucket) handleChanges(
 	return count, nil
 }
 
-func (b *Bucket) diffPath(
+func (s *Syncer) diffPath(
 	ctx context.Context,
 	pth, dest string,
 	ignoreDeletions bool,
 ) (diff []Change, missing []object, remove map[string]string, err error) {
-	all, missing, err := b.listPath(ctx, pth, dest, false)
+	all, missing, err := s.listPath(ctx, pth, dest, false)
 	if err != nil {
 		return
 	}
 	remove = make(map[string]string)
-	list, err := b.walkPath(dest)
+	list, err := s.walkPath(dest)
 	if err != nil {
 		return
 	}
@@ -210,8 +229,8 @@ loop:
 				continue loop
 			}
 		}
-		p := strings.TrimPrefix(n, dest+string(os.PathSeparator))
-		r, err := filepath.Rel(b.cwd, n)
+		p := trimRoot(n, dest)
+		r, err := s.rel(n)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -223,7 +242,7 @@ loop:
 			continue
 		}
 		var ct du.ChangeType
-		if _, err = os.Stat(o.name); err == nil {
+		if _, err = lstat(s.fs, o.name); err == nil {
 			ct = du.Mod
 		} else if os.IsNotExist(err) {
 			if ignoreDeletions {
@@ -233,7 +252,7 @@ loop:
 		} else {
 			return
 		}
-		r, err := filepath.Rel(b.cwd, o.name)
+		r, err := s.rel(o.name)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -247,20 +266,21 @@ type object struct {
 	name string
 	cid  cid.Cid
 	size int64
+	info *buckets.FileInfo
 }
 
-func (b *Bucket) listPath(
+func (s *Syncer) listPath(
 	ctx context.Context,
 	pth, dest string,
 	force bool,
 ) (all, missing []object, err error) {
-	rep, err := b.clients.Buckets.ListPath(ctx, b.Key(), pth)
+	rep, err := s.client.ListPath(ctx, s.key, pth)
 	if err != nil {
 		return
 	}
 	if rep.Item.IsDir {
 		for _, i := range rep.Item.Items {
-			a, m, err := b.listPath(ctx, filepath.Join(pth, filepath.Base(i.Path)), dest, force)
+			a, m, err := s.listPath(ctx, filepath.Join(pth, filepath.Base(i.Path)), dest, force)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -274,17 +294,20 @@ func (b *Bucket) listPath(
 			return nil, nil, err
 		}
 		o := object{path: pth, name: name, size: rep.Item.Size, cid: c}
+		if rep.Item.Metadata != nil {
+			o.info = buckets.FileInfoFromPb(rep.Item.Metadata.Info)
+		}
 		all = append(all, o)
-		if !force && b.repo != nil {
+		if !force && s.repo != nil && !s.modeChanged(name, o.info) {
 			c, err := cid.Decode(rep.Item.Cid)
 			if err != nil {
 				return nil, nil, err
 			}
-			lc, err := b.repo.HashFile(name)
+			lc, err := s.repo.HashFile(name)
 			if err == nil && lc.Equals(c) { // File exists, skip it
 				return all, missing, nil
 			} else {
-				match, err := b.repo.MatchPath(pth, lc, c)
+				match, err := s.repo.MatchPath(pth, lc, c)
 				if err != nil {
 					if !errors.Is(err, ds.ErrNotFound) {
 						return nil, nil, err
@@ -299,29 +322,46 @@ func (b *Bucket) listPath(
 	return all, missing, nil
 }
 
-func (b *Bucket) getFile(ctx context.Context, key string, o object, events chan<- Event, progress chan<- int64) error {
-	if err := os.MkdirAll(filepath.Dir(o.name), os.ModePerm); err != nil {
+func (s *Syncer) getFile(ctx context.Context, key string, o object, events chan<- Event, progress chan<- int64) error {
+	// Remote paths and link targets are untrusted, so never write outside the root
+	if err := checkParents(s.fs, s.root, o.name); err != nil {
 		return err
 	}
-	file, err := os.Create(o.name)
-	if err != nil {
+	if o.info != nil && o.info.IsSymlink() {
+		if err := checkSymlinkTarget(s.root, o.name, o.info.Symlink); err != nil {
+			return err
+		}
+	}
+	if err := mkdirParent(s.fs, o.name); err != nil {
 		return err
 	}
-	defer file.Close()
+	// Don't write through an existing symlink
+	if info, err := lstat(s.fs, o.name); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err := s.fs.Remove(o.name); err != nil {
+			return err
+		}
+	}
 
-	rel, err := filepath.Rel(b.cwd, o.name)
+	rel, err := s.rel(o.name)
 	if err != nil {
 		return err
 	}
 
 	prog, finish := handlePullProgress(progress, o.size)
 	defer finish()
-	if err := b.clients.Buckets.PullPath(ctx, key, o.path, file, client.WithProgress(prog)); err != nil {
-		return err
+	if o.info != nil && o.info.IsSymlink() {
+		_ = s.fs.Remove(o.name)
+		if err := symlink(s.fs, o.info.Symlink, o.name); err != nil {
+			return err
+		}
+	} else {
+		if err := s.pullFile(ctx, key, o, client.WithProgress(prog)); err != nil {
+			return err
+		}
 	}
 
-	if b.repo != nil {
-		if err := b.repo.SetRemotePath(o.path, o.cid); err != nil {
+	if s.repo != nil {
+		if err := s.repo.SetRemotePath(o.path, o.cid); err != nil {
 			return err
 		}
 	}
@@ -337,6 +377,48 @@ func (b *Bucket) getFile(ctx context.Context, key string, o object, events chan<
 	return nil
 }
 
+func (s *Syncer) pullFile(ctx context.Context, key string, o object, opts ...client.Option) error {
+	file, err := s.fs.Create(o.name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := s.client.PullPath(ctx, key, o.path, file, opts...); err != nil {
+		return err
+	}
+	if o.info == nil {
+		return nil
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if o.info.Mode != 0 {
+		if err := s.fs.Chmod(o.name, o.info.Mode); err != nil {
+			return err
+		}
+	}
+	if !o.info.ModTime.IsZero() {
+		return s.fs.Chtimes(o.name, o.info.ModTime, o.info.ModTime)
+	}
+	return nil
+}
+
+// modeChanged returns whether or not the local file at name has different
+// permission bits or symlink-ness than described by info.
+func (s *Syncer) modeChanged(name string, info *buckets.FileInfo) bool {
+	if info == nil {
+		return false
+	}
+	fi, err := lstat(s.fs, name)
+	if err != nil {
+		return false
+	}
+	if (fi.Mode()&os.ModeSymlink != 0) != info.IsSymlink() {
+		return true
+	}
+	return !info.IsSymlink() && info.Mode != 0 && fi.Mode().Perm() != info.Mode
+}
+
 func handlePullProgress(global chan<- int64, fsize int64) (chan<- int64, func()) {
 	progress := make(chan int64)
 	var current int64
@@ -10,6 +10,7 @@ import (
 	du "github.com/ipfs/go-merkledag/dagutils"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/textile/v2/api/bucketsd/client"
+	"github.com/textileio/textile/v2/buckets"
 )
 
 // PushRemote pushes local files.
@@ -17,49 +18,27 @@ import (
 func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
-	ctx, err = b.context(ctx)
+	s, err := b.syncer()
 	if err != nil {
 		return
 	}
+	return s.Push(ctx, opts...)
+}
+
+// Push pushes local files.
+// By default, only changes since the last sync are pushed. See PathOption for more info.
+func (s *Syncer) Push(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	ctx = s.context(ctx)
 	args := &pathOptions{}
 	for _, opt := range opts {
 		opt(args)
 	}
 
-	diff, err := b.DiffLocal()
-	if errors.Is(err, ErrNotABucket) {
-		args.force = true
-	} else if err != nil {
-		return
-	}
-	bp, err := b.Path()
+	diff, err := s.pushDiff(ctx, args)
 	if err != nil {
-		return roots, err
-	}
-	if args.force { // Reset the diff to show all files as additions
-		var reset []Change
-		names, err := b.walkPath(bp)
-		if err != nil {
-			return roots, err
-		}
-		for _, n := range names {
-			r, err := filepath.Rel(b.cwd, n)
-			if err != nil {
-				return roots, err
-			}
-			p := strings.TrimPrefix(n, bp+string(os.PathSeparator))
-			reset = append(reset, Change{Type: du.Add, Name: n, Path: p, Rel: r})
-		}
-		// Add unique additions
-	loop:
-		for _, c := range reset {
-			for _, x := range diff {
-				if c.Path == x.Path {
-					continue loop
-				}
-			}
-			diff = append(diff, c)
-		}
+		return
 	}
 	if len(diff) == 0 {
 		return roots, ErrUpToDate
@@ -70,13 +49,13 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 		}
 	}
 
-	r, err := b.Roots(ctx)
+	r, err := s.Roots(ctx)
 	if err != nil {
 		return
 	}
 	xr := path.IpfsPath(r.Remote)
 	var rm, add []Change
-	key := b.Key()
+	key := s.key
 	for _, c := range diff {
 		switch c.Type {
 		case du.Mod, du.Add:
@@ -85,33 +64,70 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 			rm = append(rm, c)
 		}
 	}
-	xr, err = b.addFiles(ctx, key, xr, add, args.force, args.events)
+	xr, err = s.addFiles(ctx, key, xr, add, args.force, args.events)
 	if err != nil {
 		return roots, err
 	}
 	if len(rm) > 0 {
 		for _, c := range rm {
 			var err error
-			xr, err = b.rmFile(ctx, key, xr, c, args.force, args.events)
+			xr, err = s.rmFile(ctx, key, xr, c, args.force, args.events)
 			if err != nil {
 				return roots, err
 			}
 		}
 	}
 
-	if b.repo != nil {
-		if err := b.repo.Save(ctx); err != nil {
+	if s.repo != nil {
+		if err := s.repo.Save(ctx); err != nil {
 			return roots, err
 		}
-		rc, err := b.getRemoteRoot(ctx)
+		rc, err := s.getRemoteRoot(ctx)
 		if err != nil {
 			return roots, err
 		}
-		if err := b.repo.SetRemotePath("", rc); err != nil {
+		if err := s.repo.SetRemotePath("", rc); err != nil {
 			return roots, err
 		}
 	}
-	return b.Roots(ctx)
+	return s.Roots(ctx)
+}
+
+// pushDiff returns the list of changes a push with args would send to the remote.
+// If the local repo doesn't exist, args.force is set.
+func (s *Syncer) pushDiff(ctx context.Context, args *pathOptions) ([]Change, error) {
+	diff, err := s.diff(ctx)
+	if errors.Is(err, ErrNotABucket) {
+		args.force = true
+	} else if err != nil {
+		return nil, err
+	}
+	if args.force { // Reset the diff to show all files as additions
+		var reset []Change
+		names, err := s.walkPath(s.root)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range names {
+			r, err := s.rel(n)
+			if err != nil {
+				return nil, err
+			}
+			p := trimRoot(n, s.root)
+			reset = append(reset, Change{Type: du.Add, Name: n, Path: p, Rel: r})
+		}
+		// Add unique additions
+	loop:
+		for _, c := range reset {
+			for _, x := range diff {
+				if c.Path == x.Path {
+					continue loop
+				}
+			}
+			diff = append(diff, c)
+		}
+	}
+	return diff, nil
 }
 
 type pendingFile struct {
@@ -119,7 +135,7 @@ type pendingFile struct {
 	rel  string
 }
 
-func (b *Bucket) addFiles(
+func (s *Syncer) addFiles(
 	ctx context.Context,
 	key string,
 	xroot path.Resolved,
@@ -135,7 +151,7 @@ func (b *Bucket) addFiles(
 	if !force {
 		opts = append(opts, client.WithFastForwardOnly(xroot))
 	}
-	q, err := b.clients.Buckets.PushPaths(ctx, key, opts...)
+	q, err := s.client.PushPaths(ctx, key, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +164,7 @@ func (b *Bucket) addFiles(
 		}
 		pth := filepath.ToSlash(c.Path)
 		files[pth] = file
-		if err := q.AddFile(file.path, c.Name); err != nil {
+		if err := s.addFile(q, file.path, c.Name); err != nil {
 			return nil, err
 		}
 	}
@@ -180,8 +196,8 @@ func (b *Bucket) addFiles(
 		file := files[q.Current.Path]
 		root = q.Current.Root
 
-		if b.repo != nil {
-			if err := b.repo.SetRemotePath(file.path, q.Current.Cid); err != nil {
+		if s.repo != nil {
+			if err := s.repo.SetRemotePath(file.path, q.Current.Cid); err != nil {
 				return nil, err
 			}
 		}
@@ -198,7 +214,35 @@ func (b *Bucket) addFiles(
 	return root, nil
 }
 
-func (b *Bucket) rmFile(
+// addFile adds the file at name to the push queue.
+// Symbolic links are not followed. Instead, the link target is pushed as the file content.
+func (s *Syncer) addFile(q *client.PushPathsQueue, pth, name string) error {
+	fi, err := lstat(s.fs, name)
+	if err != nil {
+		return err
+	}
+	info := buckets.FileInfo{
+		Mode:    fi.Mode().Perm(),
+		ModTime: fi.ModTime(),
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		if info.Symlink, err = readlink(s.fs, name); err != nil {
+			return err
+		}
+		return q.AddReaderWithInfo(pth, strings.NewReader(info.Symlink), int64(len(info.Symlink)), info)
+	}
+	f, err := s.fs.Open(name)
+	if err != nil {
+		return err
+	}
+	if err := q.AddReaderWithInfo(pth, f, fi.Size(), info); err != nil {
+		f.Close()
+		return err
+	}
+	return nil
+}
+
+func (s *Syncer) rmFile(
 	ctx context.Context,
 	key string,
 	xroot path.Resolved,
@@ -210,15 +254,15 @@ func (b *Bucket) rmFile(
 	if !force {
 		opts = append(opts, client.WithFastForwardOnly(xroot))
 	}
-	root, err := b.clients.Buckets.RemovePath(ctx, key, c.Path, opts...)
+	root, err := s.client.RemovePath(ctx, key, c.Path, opts...)
 	if err != nil {
 		if !strings.HasSuffix(err.Error(), "no link by that name") {
 			return nil, err
 		}
 	}
 
-	if b.repo != nil {
-		if err := b.repo.RemovePath(ctx, c.Path); err != nil {
+	if s.repo != nil {
+		if err := s.repo.RemovePath(ctx, c.Path); err != nil {
 			return nil, err
 		}
 	}
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	bserv "github.com/ipfs/go-blockservice"
@@ -30,6 +32,7 @@ import (
 	"github.com/ipfs/go-unixfs/importer/trickle"
 	options "github.com/ipfs/interface-go-ipfs-core/options"
 	mh "github.com/multiformats/go-multihash"
+	"github.com/spf13/afero"
 )
 
 func init() {
@@ -54,12 +57,17 @@ var (
 type pathMap struct {
 	Local  cid.Cid
 	Remote cid.Cid
+	// Mode is the file's permission and symlink bits as of the last save.
+	// Zero means the mode is unknown, e.g., the map was created by an older version.
+	Mode os.FileMode
 }
 
 // Repo tracks a local bucket tree structure.
 type Repo struct {
 	path   string
 	name   string
+	fs     afero.Fs
+	ignore func(string) bool
 	ds     ds.Batching
 	bsrv   bserv.BlockService
 	dag    ipld.DAGService
@@ -68,6 +76,7 @@ type Repo struct {
 }
 
 // NewRepo creates a new bucket with the given path.
+// The repo is stored on disk under name, which is relative to path.
 func NewRepo(pth, name string, layout options.Layout) (*Repo, error) {
 	repo := filepath.Join(pth, name)
 	if err := os.MkdirAll(repo, os.ModePerm); err != nil {
@@ -77,17 +86,25 @@ func NewRepo(pth, name string, layout options.Layout) (*Repo, error) {
 	if err != nil {
 		return nil, err
 	}
-	bs := bstore.NewBlockstore(bd)
+	r := NewRepoWithFS(NewOsFs(), pth, bd, layout)
+	r.name = name
+	return r, nil
+}
+
+// NewRepoWithFS creates a new bucket with the given path in fs.
+// Path maps and blocks are kept in store, which is closed along with the repo.
+func NewRepoWithFS(fs afero.Fs, pth string, store ds.Batching, layout options.Layout) *Repo {
+	bs := bstore.NewBlockstore(store)
 	bsrv := bserv.New(bs, offline.Exchange(bs))
 	return &Repo{
 		path:   pth,
-		name:   name,
-		ds:     bd,
+		fs:     fs,
+		ds:     store,
 		bsrv:   bsrv,
 		dag:    md.NewDAGService(bsrv),
 		layout: layout,
 		cidver: 1,
-	}, nil
+	}
 }
 
 // Path returns the repo path.
@@ -95,6 +112,23 @@ func (b *Repo) Path() string {
 	return filepath.Join(b.path, b.name)
 }
 
+// SetIgnore sets a function used to exclude paths from the bucket.
+// Paths passed to ignore are relative to the bucket path.
+func (b *Repo) SetIgnore(ignore func(string) bool) {
+	b.ignore = ignore
+}
+
+// skip returns whether or not the path relative to the bucket path should be excluded.
+func (b *Repo) skip(rel string) bool {
+	if b.name != "" && strings.HasPrefix(rel, filepath.Dir(b.name)+string(os.PathSeparator)) {
+		return true
+	}
+	if strings.HasSuffix(rel, patchExt) {
+		return true
+	}
+	return b.ignore != nil && b.ignore(rel)
+}
+
 // Root returns the local and remote root cids.
 func (b *Repo) Root() (local, remote cid.Cid, err error) {
 	k, err := getPathKey("")
@@ -159,32 +193,33 @@ func (b *Repo) SetCidVersion(v int) {
 
 // Save saves the bucket as a node describing the file tree at the current path.
 func (b *Repo) Save(ctx context.Context) error {
-	_, maps, err := b.recursiveAddPath(ctx, b.path, b.dag)
+	_, maps, modes, err := b.recursiveAddPath(ctx, b.path, b.dag)
 	if err != nil {
 		return err
 	}
 	for p, c := range maps {
-		if err := b.setLocalPath(p, c); err != nil {
+		if err := b.setLocalPath(p, c, modes[p]); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// setLocalPath sets the local cid for an existing path map.
-func (b *Repo) setLocalPath(pth string, local cid.Cid) error {
+// setLocalPath sets the local cid and file mode for an existing path map.
+func (b *Repo) setLocalPath(pth string, local cid.Cid, mode os.FileMode) error {
 	k, err := getPathKey(pth)
 	if err != nil {
 		return err
 	}
 	xm, err := b.getPathMap(k)
 	if errors.Is(err, ds.ErrNotFound) {
-		return b.putPathMap(k, pathMap{Local: local})
+		return b.putPathMap(k, pathMap{Local: local, Mode: mode})
 	}
 	if err != nil {
 		return err
 	}
 	xm.Local = local
+	xm.Mode = mode
 	return b.putPathMap(k, xm)
 }
 
@@ -199,25 +234,26 @@ func (b *Repo) putPathMap(k ds.Key, pm pathMap) error {
 }
 
 // recursiveAddPath walks path and adds files to the dag service.
-// This method returns the resulting root node and a list of path maps.
+// This method returns the resulting root node, a list of path maps, and a list of file modes.
 func (b *Repo) recursiveAddPath(
 	ctx context.Context,
 	pth string,
 	dag ipld.DAGService,
-) (ipld.Node, map[string]cid.Cid, error) {
+) (ipld.Node, map[string]cid.Cid, map[string]os.FileMode, error) {
 	root := unixfs.EmptyDirNode()
 	prefix, err := md.PrefixForCidVersion(b.cidver)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	root.SetCidBuilder(prefix)
 	editor := du.NewDagEditor(root, dag)
 	maps := make(map[string]cid.Cid)
+	modes := make(map[string]os.FileMode)
 	abs, err := filepath.Abs(pth)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	if err = filepath.Walk(abs, func(n string, info os.FileInfo, err error) error {
+	if err = afero.Walk(b.fs, abs, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -226,11 +262,11 @@ func (b *Repo) recursiveAddPath(
 				return nil
 			}
 			p := n
-			n = strings.TrimPrefix(n, abs+string(os.PathSeparator))
-			if strings.HasPrefix(n, filepath.Dir(b.name)+string(os.PathSeparator)) || strings.HasSuffix(n, patchExt) {
+			n = trimRoot(n, abs)
+			if b.skip(n) {
 				return nil
 			}
-			file, err := os.Open(p)
+			file, mode, err := b.openFile(p)
 			if err != nil {
 				return err
 			}
@@ -242,18 +278,42 @@ func (b *Repo) recursiveAddPath(
 			if err = editor.InsertNodeAtPath(ctx, n, nd, unixfs.EmptyDirNode); err != nil {
 				return err
 			}
-			maps[strings.TrimPrefix(p, abs+string(os.PathSeparator))] = nd.Cid()
+			maps[n] = nd.Cid()
+			modes[n] = mode
 		}
 		return nil
 	}); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	en := editor.GetNode()
 	if err := copyLinks(ctx, en, editor.GetDagService(), dag); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	maps[strings.TrimPrefix(pth, b.path)] = en.Cid()
-	return en, maps, nil
+	return en, maps, modes, nil
+}
+
+// openFile opens the file at name for hashing without following symbolic links.
+// The content of a symbolic link is its target.
+// The returned mode contains the file's permission bits and symlink type bit.
+func (b *Repo) openFile(name string) (io.ReadCloser, os.FileMode, error) {
+	info, err := lstat(b.fs, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	mode := info.Mode() & (os.ModePerm | os.ModeSymlink)
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := readlink(b.fs, name)
+		if err != nil {
+			return nil, 0, err
+		}
+		return ioutil.NopCloser(strings.NewReader(target)), mode, nil
+	}
+	f, err := b.fs.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	return f, mode, nil
 }
 
 // copyLinks recursively adds all link nodes in node to the dag service.
@@ -287,7 +347,7 @@ func copyLinks(ctx context.Context, nd ipld.Node, from, to ipld.DAGService) erro
 
 // SaveFile saves the file at path to the repo.
 func (b *Repo) SaveFile(ctx context.Context, pth string, name string) error {
-	r, err := os.Open(pth)
+	r, mode, err := b.openFile(pth)
 	if err != nil {
 		return err
 	}
@@ -310,16 +370,17 @@ func (b *Repo) SaveFile(ctx context.Context, pth string, name string) error {
 	if err := copyLinks(ctx, n, editor.GetDagService(), b.dag); err != nil {
 		return err
 	}
-	if err := b.setLocalPath(pth, n.Cid()); err != nil {
+	if err := b.setLocalPath(pth, n.Cid(), mode); err != nil {
 		return err
 	}
-	return b.setLocalPath("", n.Cid())
+	return b.setLocalPath("", n.Cid(), 0)
 }
 
 // HashFile returns the cid of the file at path.
+// Symbolic links are not followed, i.e., the cid is that of the link target.
 // This method does not alter the bucket.
 func (b *Repo) HashFile(pth string) (cid.Cid, error) {
-	r, err := os.Open(pth)
+	r, _, err := b.openFile(pth)
 	if err != nil {
 		return cid.Undef, err
 	}
@@ -341,6 +402,8 @@ func (b *Repo) GetNode(ctx context.Context, c cid.Cid) (ipld.Node, error) {
 }
 
 // Diff returns a list of changes that are present in path compared to the bucket.
+// Files with unchanged content but a changed mode, e.g., the executable bit was set,
+// are reported as modified.
 func (b *Repo) Diff(ctx context.Context, pth string) (diff []*du.Change, err error) {
 	tmp := du.NewMemoryDagService()
 	var an ipld.Node
@@ -362,11 +425,52 @@ func (b *Repo) Diff(ctx context.Context, pth string) (diff []*du.Change, err err
 	if err = tmp.Add(ctx, an); err != nil {
 		return
 	}
-	bn, _, err := b.recursiveAddPath(ctx, pth, tmp)
+	bn, _, modes, err := b.recursiveAddPath(ctx, pth, tmp)
+	if err != nil {
+		return
+	}
+	diff, err = Diff(ctx, tmp, an, bn)
 	if err != nil {
 		return
 	}
-	return Diff(ctx, tmp, an, bn)
+	return b.appendModeChanges(diff, modes)
+}
+
+// appendModeChanges adds a change for every file whose mode differs from the saved mode.
+// Files already covered by a change are skipped.
+func (b *Repo) appendModeChanges(diff []*du.Change, modes map[string]os.FileMode) ([]*du.Change, error) {
+	names := make([]string, 0, len(modes))
+	for p := range modes {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+loop:
+	for _, p := range names {
+		for _, c := range diff {
+			if p == c.Path || strings.HasPrefix(p, c.Path+string(os.PathSeparator)) {
+				continue loop
+			}
+		}
+		k, err := getPathKey(p)
+		if err != nil {
+			return nil, err
+		}
+		pm, err := b.getPathMap(k)
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if pm.Mode != 0 && pm.Mode != modes[p] {
+			diff = append(diff, &du.Change{
+				Type:   du.Mod,
+				Path:   p,
+				Before: pm.Local,
+				After:  pm.Local,
+			})
+		}
+	}
+	return diff, nil
 }
 
 // SetRemotePath sets or creates a mapping from a local path to a remote cid.
@@ -579,11 +683,11 @@ func isFileNode(n ipld.Node) bool {
 	return false
 }
 
-func stashChanges(diff []Change) error {
+func stashChanges(fs afero.Fs, diff []Change) error {
 	for _, c := range diff {
 		switch c.Type {
 		case du.Mod, du.Add:
-			if err := os.Rename(c.Name, c.Name+patchExt); err != nil {
+			if err := fs.Rename(c.Name, c.Name+patchExt); err != nil {
 				return err
 			}
 		}
@@ -591,18 +695,18 @@ func stashChanges(diff []Change) error {
 	return nil
 }
 
-func applyChanges(diff []Change) error {
+func applyChanges(fs afero.Fs, diff []Change) error {
 	for _, c := range diff {
 		switch c.Type {
 		case du.Mod, du.Add:
-			if err := os.Rename(c.Name+patchExt, c.Name); err != nil {
+			if err := fs.Rename(c.Name+patchExt, c.Name); err != nil {
 				return err
 			}
 		case du.Remove:
 			// If the file was also deleted on the remote,
 			// the local deletion will already have been handled by getPath.
 			// So, we just ignore the error here.
-			_ = os.RemoveAll(c.Name)
+			_ = fs.RemoveAll(c.Name)
 		}
 	}
 	return nil
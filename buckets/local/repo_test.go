@@ -7,13 +7,17 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
 	ipld "github.com/ipfs/go-ipld-format"
 	du "github.com/ipfs/go-merkledag/dagutils"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	mh "github.com/multiformats/go-multihash"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	. "github.com/textileio/textile/v2/buckets/local"
@@ -23,6 +27,41 @@ func TestNewRepo(t *testing.T) {
 	makeRepo(t, "testdata/a", options.BalancedLayout)
 }
 
+func TestNewRepoWithFS(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	err := afero.WriteFile(fs, "/bucket/foo.txt", []byte("foo"), 0644)
+	require.NoError(t, err)
+	err = afero.WriteFile(fs, "/bucket/one/bar.txt", []byte("bar"), 0644)
+	require.NoError(t, err)
+	err = afero.WriteFile(fs, "/bucket/tmp/baz.txt", []byte("baz"), 0644)
+	require.NoError(t, err)
+
+	repo := NewRepoWithFS(fs, "/bucket", dssync.MutexWrap(ds.NewMapDatastore()), options.BalancedLayout)
+	defer repo.Close()
+	repo.SetIgnore(func(pth string) bool {
+		return strings.HasPrefix(pth, "tmp/")
+	})
+	err = repo.Save(context.Background())
+	require.NoError(t, err)
+	lc, _, err := repo.Root()
+	require.NoError(t, err)
+	assert.True(t, lc.Defined())
+
+	err = afero.WriteFile(fs, "/bucket/tmp/baz.txt", []byte("changed"), 0644)
+	require.NoError(t, err)
+	diff, err := repo.Diff(context.Background(), "/bucket")
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	err = afero.WriteFile(fs, "/bucket/one/bar.txt", []byte("changed"), 0644)
+	require.NoError(t, err)
+	diff, err = repo.Diff(context.Background(), "/bucket")
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, "one/bar.txt", diff[0].Path)
+	assert.Equal(t, du.Mod, diff[0].Type)
+}
+
 func TestRepo_SetCidVersion(t *testing.T) {
 	repo0 := makeRepo(t, "testdata/a", options.BalancedLayout)
 	repo0.SetCidVersion(0)
@@ -215,6 +254,68 @@ func TestRepo_Diff(t *testing.T) {
 			assert.Equal(t, changes[i].Type, c.Type)
 		}
 	})
+
+	t.Run("file modes", func(t *testing.T) {
+		dir := newDir(t)
+		repo := makeRepo(t, dir, options.BalancedLayout)
+		defer repo.Close()
+
+		name := filepath.Join(dir, "script.sh")
+		err := ioutil.WriteFile(name, []byte("#!/bin/sh\necho hi\n"), 0644)
+		require.NoError(t, err)
+		err = repo.Save(context.Background())
+		require.NoError(t, err)
+
+		// Only the executable bit changes
+		err = os.Chmod(name, 0755)
+		require.NoError(t, err)
+		diff, err := repo.Diff(context.Background(), dir)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		assert.Equal(t, "script.sh", diff[0].Path)
+		assert.Equal(t, du.Mod, diff[0].Type)
+
+		err = repo.Save(context.Background())
+		require.NoError(t, err)
+		diff, err = repo.Diff(context.Background(), dir)
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+
+	t.Run("symlinks", func(t *testing.T) {
+		dir := newDir(t)
+		repo := makeRepo(t, dir, options.BalancedLayout)
+		defer repo.Close()
+
+		err := os.MkdirAll(filepath.Join(dir, "target"), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(dir, "target", "file"), []byte("hello"), 0644)
+		require.NoError(t, err)
+		// Links to directories and missing targets must not be followed
+		err = os.Symlink("target", filepath.Join(dir, "dirlink"))
+		require.NoError(t, err)
+		err = os.Symlink("missing", filepath.Join(dir, "badlink"))
+		require.NoError(t, err)
+		err = repo.Save(context.Background())
+		require.NoError(t, err)
+
+		lc, err := repo.HashFile(filepath.Join(dir, "dirlink"))
+		require.NoError(t, err)
+		local, _, err := repo.GetPathMap("dirlink")
+		require.NoError(t, err)
+		assert.True(t, lc.Equals(local))
+
+		// Retarget a link
+		err = os.Remove(filepath.Join(dir, "badlink"))
+		require.NoError(t, err)
+		err = os.Symlink("target/file", filepath.Join(dir, "badlink"))
+		require.NoError(t, err)
+		diff, err := repo.Diff(context.Background(), dir)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		assert.Equal(t, "badlink", diff[0].Path)
+		assert.Equal(t, du.Mod, diff[0].Type)
+	})
 }
 
 func TestRepo_Get(t *testing.T) {
@@ -0,0 +1,280 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/spf13/afero"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/bucketsd/client"
+	"github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/buckets"
+	"github.com/textileio/textile/v2/util"
+)
+
+// SyncConfig contains details for a Syncer.
+type SyncConfig struct {
+	// Client is the buckets API client (required).
+	Client *client.Client
+	// Auth adds credentials, e.g., an API key and signature or a thread token,
+	// to outgoing API requests (optional).
+	Auth AuthFunc
+	// Thread is the thread ID of the bucket (required).
+	Thread thread.ID
+	// Key is the key of an existing bucket (optional).
+	// If empty, a new remote bucket is created by Init.
+	Key string
+	// FS is the file system containing the bucket files (optional).
+	// Defaults to the operating system file system.
+	FS afero.Fs
+	// Root is the absolute path of the bucket's top-level directory in FS (required).
+	Root string
+	// Cwd is the absolute path against which event and change paths are made relative (optional).
+	// Defaults to Root.
+	Cwd string
+	// Store persists sync state, i.e., local and remote path cids and blocks (optional).
+	// Defaults to an in-memory store, meaning all files are considered new on the first sync.
+	// The store is closed by Syncer.Close.
+	Store ds.Batching
+	// Ignore returns true for paths relative to Root that should not be synced (optional).
+	Ignore func(pth string) bool
+}
+
+// Syncer synchronizes a file system tree with a remote bucket.
+// Unlike Bucket, it does not depend on a local config file or directory layout.
+type Syncer struct {
+	client *client.Client
+	auth   AuthFunc
+	thread thread.ID
+	key    string
+	fs     afero.Fs
+	root   string
+	cwd    string
+	ignore func(string) bool
+	repo   *Repo
+	retrID string
+
+	lk sync.Mutex
+}
+
+// NewSyncer returns a new Syncer from config.
+func NewSyncer(conf SyncConfig) (*Syncer, error) {
+	if conf.Client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if !conf.Thread.Defined() {
+		return nil, ErrThreadRequired
+	}
+	if conf.Root == "" {
+		return nil, fmt.Errorf("root is required")
+	}
+	if conf.FS == nil {
+		conf.FS = NewOsFs()
+	}
+	if conf.Cwd == "" {
+		conf.Cwd = conf.Root
+	}
+	if conf.Store == nil {
+		conf.Store = dssync.MutexWrap(ds.NewMapDatastore())
+	}
+	repo := NewRepoWithFS(conf.FS, conf.Root, conf.Store, options.BalancedLayout)
+	repo.SetIgnore(conf.Ignore)
+	return &Syncer{
+		client: conf.Client,
+		auth:   conf.Auth,
+		thread: conf.Thread,
+		key:    conf.Key,
+		fs:     conf.FS,
+		root:   filepath.Clean(conf.Root),
+		cwd:    filepath.Clean(conf.Cwd),
+		ignore: conf.Ignore,
+		repo:   repo,
+	}, nil
+}
+
+// Key returns the bucket's unique key identifier.
+func (s *Syncer) Key() string {
+	return s.key
+}
+
+// Thread returns the bucket's thread ID.
+func (s *Syncer) Thread() thread.ID {
+	return s.thread
+}
+
+// Root returns the bucket's top-level directory.
+func (s *Syncer) Root() string {
+	return s.root
+}
+
+// RetrievalID returns the retrieval-id if Init bootstrapped the bucket from a Filecoin archive.
+func (s *Syncer) RetrievalID() string {
+	return s.retrID
+}
+
+// Init prepares the bucket for syncing.
+// If the config did not include a key, a new remote bucket is created,
+// otherwise the remote bucket contents are pulled according to the init strategy.
+// See NewOption for more info.
+// If the Unfreeze option is set, the remote bucket gets created async,
+// in which case Key will remain empty and the retrieval-id is available with RetrievalID.
+func (s *Syncer) Init(ctx context.Context, opts ...NewOption) (links Links, err error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	args := &newOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	ctx = s.context(ctx)
+
+	initRemote := s.key == ""
+	if initRemote {
+		rep, err := s.client.Create(
+			ctx,
+			client.WithName(args.name),
+			client.WithPrivate(args.private),
+			client.WithCid(args.fromCid),
+			client.WithUnfreeze(args.unfreeze),
+			client.WithIPNSKey(args.ipnsKey))
+		if err != nil {
+			return links, err
+		}
+
+		// If we're unfreezing, we simply return since the
+		// bucket will get created async if the Filecoin retrieval
+		// is successful.
+		if args.unfreeze {
+			s.retrID = rep.RetrievalId
+			return links, nil
+		}
+		s.key = rep.Root.Key
+
+		seed := filepath.Join(s.root, buckets.SeedName)
+		if err = afero.WriteFile(s.fs, seed, rep.Seed, 0644); err != nil {
+			return links, err
+		}
+		if err = s.repo.SaveFile(ctx, seed, buckets.SeedName); err != nil {
+			return links, err
+		}
+		sc, err := cid.Decode(rep.SeedCid)
+		if err != nil {
+			return links, err
+		}
+		if err = s.repo.SetRemotePath(buckets.SeedName, sc); err != nil {
+			return links, err
+		}
+		rp, err := util.NewResolvedPath(rep.Root.Path)
+		if err != nil {
+			return links, err
+		}
+		if err = s.repo.SetRemotePath("", rp.Cid()); err != nil {
+			return links, err
+		}
+		links = Links{URL: rep.Links.Url, WWW: rep.Links.Www, IPNS: rep.Links.Ipns}
+	} else {
+		rc, err := s.getRemoteRoot(ctx)
+		if err != nil {
+			return links, err
+		}
+		s.repo.SetCidVersion(int(rc.Version()))
+		if err = s.repo.SetRemotePath("", rc); err != nil {
+			return links, err
+		}
+		res, err := s.client.Links(ctx, s.key, "")
+		if err != nil {
+			return links, err
+		}
+		links = Links{URL: res.Url, WWW: res.Www, IPNS: res.Ipns}
+	}
+
+	// Pull remote bucket contents
+	if !initRemote || args.fromCid.Defined() {
+		if err := s.repo.Save(ctx); err != nil {
+			return links, err
+		}
+		switch args.strategy {
+		case Soft, Hybrid:
+			diff, missing, remove, err := s.diffPath(ctx, "", s.root, args.strategy == Hybrid)
+			if err != nil {
+				return links, err
+			}
+			if err = stashChanges(s.fs, diff); err != nil {
+				return links, err
+			}
+			if _, err = s.handleChanges(ctx, missing, remove, args.events); err != nil {
+				return links, err
+			}
+			if err := s.repo.Save(ctx); err != nil {
+				return links, err
+			}
+			if err = applyChanges(s.fs, diff); err != nil {
+				return links, err
+			}
+		case Hard:
+			if _, err := s.getPath(ctx, "", s.root, nil, false, args.events); err != nil {
+				return links, err
+			}
+			if err := s.repo.Save(ctx); err != nil {
+				return links, err
+			}
+		}
+	}
+	return links, nil
+}
+
+// Roots returns the bucket's current local and remote root cids.
+func (s *Syncer) Roots(ctx context.Context) (roots Roots, err error) {
+	var lc, rc cid.Cid
+	if s.repo != nil {
+		lc, rc, err = s.repo.Root()
+		if err != nil {
+			return
+		}
+	}
+	if !rc.Defined() {
+		rc, err = s.getRemoteRoot(s.context(ctx))
+		if err != nil {
+			return
+		}
+	}
+	return Roots{Local: lc, Remote: rc}, nil
+}
+
+// Close closes the sync state store.
+func (s *Syncer) Close() error {
+	if s.repo == nil {
+		return nil
+	}
+	return s.repo.Close()
+}
+
+func (s *Syncer) getRemoteRoot(ctx context.Context) (cid.Cid, error) {
+	rr, err := s.client.Root(ctx, s.key)
+	if err != nil {
+		return cid.Undef, err
+	}
+	rp, err := util.NewResolvedPath(rr.Root.Path)
+	if err != nil {
+		return cid.Undef, err
+	}
+	return rp.Cid(), nil
+}
+
+func (s *Syncer) context(ctx context.Context) context.Context {
+	ctx = common.NewThreadIDContext(ctx, s.thread)
+	if s.auth != nil {
+		ctx = s.auth(ctx)
+	}
+	return ctx
+}
+
+// rel returns name relative to the syncer's cwd.
+func (s *Syncer) rel(name string) (string, error) {
+	return filepath.Rel(s.cwd, name)
+}
@@ -0,0 +1,86 @@
+package local_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/v2/buckets/local"
+)
+
+func TestSyncer(t *testing.T) {
+	buckets := setup(t)
+	conf := getConf(t, buckets)
+	ctx := context.Background()
+
+	fs1 := afero.NewMemMapFs()
+	err := afero.WriteFile(fs1, "/bucket/foo.txt", []byte("foo"), 0644)
+	require.NoError(t, err)
+	err = afero.WriteFile(fs1, "/bucket/one/bar.txt", []byte("bar"), 0755)
+	require.NoError(t, err)
+	s1, err := NewSyncer(SyncConfig{
+		Client: buckets.Clients().Buckets,
+		Thread: conf.Thread,
+		FS:     fs1,
+		Root:   "/bucket",
+	})
+	require.NoError(t, err)
+	defer s1.Close()
+	_, err = s1.Init(ctx, WithName("mybuck"))
+	require.NoError(t, err)
+	require.NotEmpty(t, s1.Key())
+
+	diff, err := s1.Diff(ctx)
+	require.NoError(t, err)
+	assert.Len(t, diff, 2)
+	plan, err := s1.PlanPush(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, plan.Adds)
+	assert.Equal(t, int64(6), plan.Bytes)
+	roots, err := s1.Push(ctx)
+	require.NoError(t, err)
+	assert.True(t, roots.Local.Defined())
+	_, err = s1.Push(ctx)
+	assert.True(t, errors.Is(err, ErrUpToDate))
+
+	fs2 := afero.NewMemMapFs()
+	s2, err := NewSyncer(SyncConfig{
+		Client: buckets.Clients().Buckets,
+		Thread: conf.Thread,
+		Key:    s1.Key(),
+		FS:     fs2,
+		Root:   "/other",
+	})
+	require.NoError(t, err)
+	defer s2.Close()
+	_, err = s2.Init(ctx)
+	require.NoError(t, err)
+	data, err := afero.ReadFile(fs2, "/other/one/bar.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "bar", string(data))
+	info, err := fs2.Stat("/other/one/bar.txt")
+	require.NoError(t, err)
+	assert.Equal(t, 0755, int(info.Mode().Perm()))
+
+	err = afero.WriteFile(fs2, "/other/foo.txt", []byte("changed"), 0644)
+	require.NoError(t, err)
+	_, err = s2.Push(ctx)
+	require.NoError(t, err)
+	plan, err = s1.PlanPull(ctx)
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, "foo.txt", plan.Changes[0].Path)
+	assert.Equal(t, 1, plan.Mods)
+	assert.Equal(t, int64(7), plan.Bytes)
+	data, err = afero.ReadFile(fs1, "/bucket/foo.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(data), "plan should not change local files")
+	_, err = s1.Pull(ctx)
+	require.NoError(t, err)
+	data, err = afero.ReadFile(fs1, "/bucket/foo.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "changed", string(data))
+}
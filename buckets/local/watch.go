@@ -3,9 +3,10 @@ package local
 import (
 	"context"
 	"errors"
+	"os"
+	"strings"
 	"time"
 
-	"github.com/radovskyb/watcher"
 	"github.com/textileio/go-threads/api/client"
 	"github.com/textileio/textile/v2/buckets"
 	"github.com/textileio/textile/v2/cmd"
@@ -13,11 +14,14 @@ import (
 
 const (
 	fileSystemWatchInterval = time.Millisecond * 100
+	fileSystemEventDelay    = time.Millisecond * 100
 	reconnectInterval       = time.Second * 5
 )
 
-// Watch watches for and auto-pushes local bucket changes at an interval,
+// Watch watches for and auto-pushes local bucket changes as they occur,
 // and listens for and auto-pulls remote changes as they arrive.
+// Local changes are detected with native file system notifications where available,
+// falling back to polling at an interval. Use WithPolling to always poll.
 // Use the WithOffline option to keep watching during network interruptions.
 // Returns a channel of watch connectivity states.
 // Cancel context to stop watching.
@@ -31,15 +35,15 @@ func (b *Bucket) Watch(ctx context.Context, opts ...WatchOption) (<-chan cmd.Wat
 		opt(args)
 	}
 	if !args.offline {
-		return b.watchWhileConnected(ctx, args.events)
+		return b.watchWhileConnected(ctx, args.events, args.polling)
 	}
 	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
-		return b.watchWhileConnected(ctx, args.events)
+		return b.watchWhileConnected(ctx, args.events, args.polling)
 	}, reconnectInterval)
 }
 
 // watchWhileConnected will watch until context is canceled or an error occurs.
-func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event) (<-chan cmd.WatchState, error) {
+func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event, polling bool) (<-chan cmd.WatchState, error) {
 	id, err := b.Thread()
 	if err != nil {
 		return nil, err
@@ -52,13 +56,12 @@ func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event)
 	state := make(chan cmd.WatchState)
 	go func() {
 		defer close(state)
-		w := watcher.New()
-		defer w.Close()
-		w.SetMaxEvents(1)
-		if err := w.AddRecursive(bp); err != nil {
+		w, err := newFSWatcher(bp, b.watchSkip(bp), polling)
+		if err != nil {
 			state <- cmd.WatchState{Err: err, Aborted: true}
 			return
 		}
+		defer w.Close()
 
 		// Start listening for remote changes
 		events, err := b.clients.Threads.Listen(ctx, id, []client.ListenOption{{
@@ -82,21 +85,24 @@ func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- Event)
 		}()
 
 		// Start listening for local changes
-		go func() {
-			if err := w.Start(fileSystemWatchInterval); err != nil {
-				errs <- err
-			}
-		}()
+		done := make(chan struct{})
+		defer close(done)
+		local := debounce(w.Events(), fileSystemEventDelay, done)
 		go func() {
 			for {
 				select {
-				case <-w.Event:
-					if err := b.watchPush(ctx, pevents); err != nil {
+				case e := <-local:
+					if e.Rescan {
+						// Events were dropped, reconcile the whole tree with the repo
+						if err := b.watchRescan(ctx, pevents); err != nil {
+							errs <- err
+						}
+					} else if err := b.watchPush(ctx, pevents); err != nil {
 						errs <- err
 					}
-				case err := <-w.Error:
+				case err := <-w.Errors():
 					errs <- err
-				case <-w.Closed:
+				case <-done:
 					return
 				}
 			}
@@ -146,6 +152,40 @@ func (b *Bucket) watchPush(ctx context.Context, events chan<- Event) error {
 	return nil
 }
 
+// watchRescan pushes local changes only if the repo diff is non-empty.
+func (b *Bucket) watchRescan(ctx context.Context, events chan<- Event) error {
+	if b.repo == nil {
+		return b.watchPush(ctx, events)
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return err
+	}
+	b.Lock()
+	diff, err := b.repo.Diff(ctx, bp)
+	b.Unlock()
+	if err != nil {
+		return err
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+	return b.watchPush(ctx, events)
+}
+
+// watchSkip returns a function that reports whether or not a path under
+// the bucket root should be ignored by the local watcher.
+func (b *Bucket) watchSkip(bp string) func(string) bool {
+	return func(n string) bool {
+		f := strings.TrimPrefix(n, bp+string(os.PathSeparator))
+		return Ignore(n) ||
+			f == buckets.SeedName ||
+			f == b.conf.Dir ||
+			strings.HasPrefix(f, b.conf.Dir+string(os.PathSeparator)) ||
+			strings.HasSuffix(f, patchExt)
+	}
+}
+
 func (b *Bucket) watchPull(ctx context.Context, events chan<- Event) error {
 	select {
 	case b.pushBlock <- struct{}{}:
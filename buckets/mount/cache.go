@@ -0,0 +1,63 @@
+package mount
+
+import (
+	"container/list"
+	"sync"
+)
+
+// cache is a size-bounded LRU cache of file content keyed by cid.
+type cache struct {
+	max   int64
+	size  int64
+	ll    *list.List
+	items map[string]*list.Element
+	lk    sync.Mutex
+}
+
+type cacheEntry struct {
+	key  string
+	data []byte
+}
+
+func newCache(max int64) *cache {
+	return &cache{
+		max:   max,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// get returns the content under key if it exists.
+func (c *cache) get(key string) ([]byte, bool) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*cacheEntry).data, true
+}
+
+// put adds content under key, evicting the least recently used entries as needed.
+// Content larger than the cache is not added.
+func (c *cache) put(key string, data []byte) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if int64(len(data)) > c.max {
+		return
+	}
+	if e, ok := c.items[key]; ok {
+		c.ll.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, data: data})
+	c.size += int64(len(data))
+	for c.size > c.max {
+		e := c.ll.Back()
+		ce := e.Value.(*cacheEntry)
+		c.ll.Remove(e)
+		delete(c.items, ce.key)
+		c.size -= int64(len(ce.data))
+	}
+}
@@ -0,0 +1,644 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mount
+
+import (
+	"bytes"
+	"context"
+	"os"
+	gopath "path"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	"github.com/textileio/textile/v2/api/bucketsd/client"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+// listTTL is the amount of time directory listings are cached.
+// It's also the amount of time the kernel may cache node attributes.
+var listTTL = time.Second
+
+// Mount serves the bucket as a file system at dir until ctx is done or the file system is unmounted.
+// Remote directory listings and file content are cached in memory.
+// Written files are buffered in memory and pushed to the remote when closed.
+// Private buckets are decrypted by the remote, so no key handling is required.
+func Mount(ctx context.Context, dir string, conf Config) error {
+	c, err := fuse.Mount(
+		dir,
+		fuse.FSName("textile"),
+		fuse.Subtype("buckfs"),
+	)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	go func() {
+		<-ctx.Done()
+		_ = fuse.Unmount(dir)
+	}()
+	if err := fs.Serve(c, newFS(conf)); err != nil {
+		return err
+	}
+	<-c.Ready
+	return c.MountError
+}
+
+// FS is a FUSE file system backed by a remote bucket.
+type FS struct {
+	conf  Config
+	cache *cache
+
+	// lists holds cached directory listings by path.
+	lists map[string]listing
+	// files holds files that are open or have unpushed changes by path.
+	files map[string]*File
+	// dirs holds local directories that don't yet exist in the remote.
+	dirs map[string]struct{}
+	lk   sync.Mutex
+}
+
+type listing struct {
+	item *pb.PathItem
+	at   time.Time
+}
+
+var _ fs.FS = (*FS)(nil)
+
+func newFS(conf Config) *FS {
+	if conf.CacheSize == 0 {
+		conf.CacheSize = DefaultCacheSize
+	}
+	return &FS{
+		conf:  conf,
+		cache: newCache(conf.CacheSize),
+		lists: make(map[string]listing),
+		files: make(map[string]*File),
+		dirs:  make(map[string]struct{}),
+	}
+}
+
+// Root returns the bucket root directory.
+func (f *FS) Root() (fs.Node, error) {
+	return &Dir{fs: f}, nil
+}
+
+func (f *FS) context(ctx context.Context) context.Context {
+	if f.conf.Context != nil {
+		ctx = f.conf.Context(ctx)
+	}
+	return ctx
+}
+
+// list returns the remote directory item at pth, using a cached listing if it's fresh.
+func (f *FS) list(ctx context.Context, pth string) (*pb.PathItem, error) {
+	f.lk.Lock()
+	l, ok := f.lists[pth]
+	f.lk.Unlock()
+	if ok && time.Since(l.at) < listTTL {
+		return l.item, nil
+	}
+	rep, err := f.conf.Client.ListPath(f.context(ctx), f.conf.Key, pth)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fuse.ENOENT
+		}
+		return nil, err
+	}
+	f.lk.Lock()
+	f.lists[pth] = listing{item: rep.Item, at: time.Now()}
+	f.lk.Unlock()
+	return rep.Item, nil
+}
+
+// invalidate drops cached listings for pth and all of its parents.
+func (f *FS) invalidate(pth string) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	for {
+		delete(f.lists, pth)
+		if pth == "" {
+			return
+		}
+		pth = parent(pth)
+	}
+}
+
+// forget drops pending directories for pth and all of its parents,
+// which is called once a file has been pushed below them.
+func (f *FS) forget(pth string) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	for pth != "" {
+		delete(f.dirs, pth)
+		pth = parent(pth)
+	}
+}
+
+// empty returns whether or not the directory at pth has no remote or pending entries.
+func (f *FS) empty(ctx context.Context, pth string) (bool, error) {
+	f.lk.Lock()
+	for p := range f.files {
+		if parent(p) == pth {
+			f.lk.Unlock()
+			return false, nil
+		}
+	}
+	for p := range f.dirs {
+		if parent(p) == pth {
+			f.lk.Unlock()
+			return false, nil
+		}
+	}
+	f.lk.Unlock()
+	item, err := f.list(ctx, pth)
+	if err == fuse.ENOENT {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	return len(item.Items) == 0, nil
+}
+
+// Dir is a bucket directory.
+type Dir struct {
+	fs   *FS
+	path string
+}
+
+var (
+	_ fs.Node               = (*Dir)(nil)
+	_ fs.NodeStringLookuper = (*Dir)(nil)
+	_ fs.HandleReadDirAller = (*Dir)(nil)
+	_ fs.NodeCreater        = (*Dir)(nil)
+	_ fs.NodeMkdirer        = (*Dir)(nil)
+	_ fs.NodeRemover        = (*Dir)(nil)
+	_ fs.NodeRenamer        = (*Dir)(nil)
+)
+
+func (d *Dir) Attr(_ context.Context, a *fuse.Attr) error {
+	a.Mode = os.ModeDir | 0755
+	a.Valid = listTTL
+	return nil
+}
+
+func (d *Dir) Lookup(ctx context.Context, name string) (fs.Node, error) {
+	pth := gopath.Join(d.path, name)
+	d.fs.lk.Lock()
+	if f, ok := d.fs.files[pth]; ok {
+		d.fs.lk.Unlock()
+		return f, nil
+	}
+	if _, ok := d.fs.dirs[pth]; ok {
+		d.fs.lk.Unlock()
+		return &Dir{fs: d.fs, path: pth}, nil
+	}
+	d.fs.lk.Unlock()
+
+	item, err := d.fs.list(ctx, d.path)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range item.Items {
+		if i.Name != name {
+			continue
+		}
+		if i.IsDir {
+			return &Dir{fs: d.fs, path: pth}, nil
+		}
+		return newFile(d.fs, pth, i), nil
+	}
+	return nil, fuse.ENOENT
+}
+
+func (d *Dir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	var ents []fuse.Dirent
+	seen := make(map[string]struct{})
+	add := func(name string, dir bool) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		t := fuse.DT_File
+		if dir {
+			t = fuse.DT_Dir
+		}
+		ents = append(ents, fuse.Dirent{Name: name, Type: t})
+	}
+	item, err := d.fs.list(ctx, d.path)
+	if err != nil && err != fuse.ENOENT {
+		return nil, err
+	}
+	if item != nil {
+		for _, i := range item.Items {
+			add(i.Name, i.IsDir)
+		}
+	}
+	d.fs.lk.Lock()
+	defer d.fs.lk.Unlock()
+	for p := range d.fs.files {
+		if parent(p) == d.path {
+			add(gopath.Base(p), false)
+		}
+	}
+	for p := range d.fs.dirs {
+		if parent(p) == d.path {
+			add(gopath.Base(p), true)
+		}
+	}
+	return ents, nil
+}
+
+func (d *Dir) Create(_ context.Context, req *fuse.CreateRequest, _ *fuse.CreateResponse) (fs.Node, fs.Handle, error) {
+	pth := gopath.Join(d.path, req.Name)
+	f := &File{
+		fs:     d.fs,
+		path:   pth,
+		mode:   req.Mode.Perm(),
+		mtime:  time.Now(),
+		loaded: true,
+		dirty:  true,
+		open:   1,
+	}
+	d.fs.lk.Lock()
+	d.fs.files[pth] = f
+	d.fs.lk.Unlock()
+	return f, f, nil
+}
+
+func (d *Dir) Mkdir(_ context.Context, req *fuse.MkdirRequest) (fs.Node, error) {
+	pth := gopath.Join(d.path, req.Name)
+	d.fs.lk.Lock()
+	d.fs.dirs[pth] = struct{}{}
+	d.fs.lk.Unlock()
+	return &Dir{fs: d.fs, path: pth}, nil
+}
+
+// Remove removes a file or an empty directory.
+func (d *Dir) Remove(ctx context.Context, req *fuse.RemoveRequest) error {
+	pth := gopath.Join(d.path, req.Name)
+	n, err := d.Lookup(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+	if _, isDir := n.(*Dir); isDir != req.Dir {
+		if req.Dir {
+			return fuse.Errno(syscall.ENOTDIR)
+		}
+		return fuse.Errno(syscall.EISDIR)
+	}
+	if req.Dir {
+		empty, err := d.fs.empty(ctx, pth)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return fuse.Errno(syscall.ENOTEMPTY)
+		}
+	}
+
+	d.fs.lk.Lock()
+	_, pending := d.fs.dirs[pth]
+	delete(d.fs.dirs, pth)
+	f, open := d.fs.files[pth]
+	delete(d.fs.files, pth)
+	d.fs.lk.Unlock()
+	if open {
+		f.lk.Lock()
+		f.removed = true
+		f.lk.Unlock()
+	}
+	if pending {
+		return nil
+	}
+	if _, err := d.fs.conf.Client.RemovePath(d.fs.context(ctx), d.fs.conf.Key, pth); err != nil {
+		if !isNotFound(err) {
+			return err
+		}
+	}
+	d.fs.invalidate(pth)
+	return nil
+}
+
+// Rename moves a file by pushing its content to the new path and removing the old path.
+// Renaming directories is not supported; tools like mv fall back to copying on EXDEV.
+func (d *Dir) Rename(ctx context.Context, req *fuse.RenameRequest, newDir fs.Node) error {
+	nd, ok := newDir.(*Dir)
+	if !ok {
+		return fuse.Errno(syscall.EXDEV)
+	}
+	n, err := d.Lookup(ctx, req.OldName)
+	if err != nil {
+		return err
+	}
+	f, ok := n.(*File)
+	if !ok {
+		return fuse.Errno(syscall.EXDEV)
+	}
+	to := gopath.Join(nd.path, req.NewName)
+
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	if err := f.load(ctx); err != nil {
+		return err
+	}
+	from := f.path
+	remote := f.remote
+	f.path = to
+	f.dirty = true
+	if err := f.push(ctx); err != nil {
+		f.path = from
+		return err
+	}
+	if remote {
+		if _, err := d.fs.conf.Client.RemovePath(d.fs.context(ctx), d.fs.conf.Key, from); err != nil {
+			if !isNotFound(err) {
+				return err
+			}
+		}
+	}
+	d.fs.lk.Lock()
+	if _, ok := d.fs.files[from]; ok {
+		delete(d.fs.files, from)
+		d.fs.files[to] = f
+	}
+	d.fs.lk.Unlock()
+	d.fs.invalidate(from)
+	return nil
+}
+
+// File is a bucket file.
+// Content is loaded on first read or write, and changes are pushed when the file is closed.
+type File struct {
+	fs    *FS
+	path  string
+	cid   string
+	size  uint64
+	mode  os.FileMode
+	mtime time.Time
+
+	data    []byte
+	loaded  bool
+	dirty   bool
+	remote  bool
+	removed bool
+	open    int
+	lk      sync.Mutex
+}
+
+var (
+	_ fs.Node           = (*File)(nil)
+	_ fs.NodeOpener     = (*File)(nil)
+	_ fs.NodeSetattrer  = (*File)(nil)
+	_ fs.NodeFsyncer    = (*File)(nil)
+	_ fs.HandleReader   = (*File)(nil)
+	_ fs.HandleWriter   = (*File)(nil)
+	_ fs.HandleFlusher  = (*File)(nil)
+	_ fs.HandleReleaser = (*File)(nil)
+)
+
+func newFile(f *FS, pth string, item *pb.PathItem) *File {
+	file := &File{
+		fs:     f,
+		path:   pth,
+		cid:    item.Cid,
+		size:   uint64(item.Size),
+		mode:   0644,
+		remote: true,
+	}
+	if item.Metadata != nil {
+		if info := buckets.FileInfoFromPb(item.Metadata.Info); info != nil {
+			if info.Mode != 0 {
+				file.mode = info.Mode
+			}
+			file.mtime = info.ModTime
+		}
+	}
+	return file
+}
+
+func (f *File) Attr(_ context.Context, a *fuse.Attr) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	a.Mode = f.mode
+	a.Size = f.size
+	if f.loaded {
+		a.Size = uint64(len(f.data))
+	}
+	a.Mtime = f.mtime
+	a.Valid = listTTL
+	return nil
+}
+
+func (f *File) Open(ctx context.Context, req *fuse.OpenRequest, _ *fuse.OpenResponse) (fs.Handle, error) {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	if req.Flags&fuse.OpenTruncate != 0 {
+		f.data = nil
+		f.loaded = true
+		f.dirty = true
+	}
+	f.open++
+	f.fs.lk.Lock()
+	if x, ok := f.fs.files[f.path]; !ok || x != f {
+		f.fs.files[f.path] = f
+	}
+	f.fs.lk.Unlock()
+	return f, nil
+}
+
+func (f *File) Setattr(ctx context.Context, req *fuse.SetattrRequest, resp *fuse.SetattrResponse) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	if req.Valid.Size() {
+		if err := f.load(ctx); err != nil {
+			return err
+		}
+		if int(req.Size) <= len(f.data) {
+			f.data = f.data[:req.Size]
+		} else {
+			f.data = append(f.data, make([]byte, int(req.Size)-len(f.data))...)
+		}
+		f.dirty = true
+		f.mtime = time.Now()
+	}
+	if req.Valid.Mode() && req.Mode.Perm() != f.mode {
+		// Mode is stored in the remote path metadata, which is only written with content
+		if err := f.load(ctx); err != nil {
+			return err
+		}
+		f.mode = req.Mode.Perm()
+		f.dirty = true
+	}
+	if req.Valid.Mtime() {
+		f.mtime = req.Mtime
+	}
+	// Changes made without an open handle, e.g., by truncate or chmod, are pushed now.
+	// Otherwise, they're pushed with any writes when the file is flushed.
+	if f.open == 0 {
+		if err := f.push(ctx); err != nil {
+			return err
+		}
+	}
+	resp.Attr.Mode = f.mode
+	resp.Attr.Size = uint64(len(f.data))
+	if !f.loaded {
+		resp.Attr.Size = f.size
+	}
+	resp.Attr.Mtime = f.mtime
+	return nil
+}
+
+func (f *File) Read(ctx context.Context, req *fuse.ReadRequest, resp *fuse.ReadResponse) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	if err := f.load(ctx); err != nil {
+		return err
+	}
+	if req.Offset >= int64(len(f.data)) {
+		return nil
+	}
+	end := req.Offset + int64(req.Size)
+	if end > int64(len(f.data)) {
+		end = int64(len(f.data))
+	}
+	resp.Data = append(resp.Data[:0], f.data[req.Offset:end]...)
+	return nil
+}
+
+func (f *File) Write(ctx context.Context, req *fuse.WriteRequest, resp *fuse.WriteResponse) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	if err := f.load(ctx); err != nil {
+		return err
+	}
+	end := int(req.Offset) + len(req.Data)
+	if end > len(f.data) {
+		f.data = append(f.data, make([]byte, end-len(f.data))...)
+	}
+	copy(f.data[req.Offset:], req.Data)
+	f.dirty = true
+	f.mtime = time.Now()
+	resp.Size = len(req.Data)
+	return nil
+}
+
+func (f *File) Flush(ctx context.Context, _ *fuse.FlushRequest) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	return f.push(ctx)
+}
+
+func (f *File) Fsync(ctx context.Context, _ *fuse.FsyncRequest) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	return f.push(ctx)
+}
+
+func (f *File) Release(ctx context.Context, _ *fuse.ReleaseRequest) error {
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	f.open--
+	if err := f.push(ctx); err != nil {
+		return err
+	}
+	if f.open > 0 {
+		return nil
+	}
+	// Content remains available in the read cache
+	f.data = nil
+	f.loaded = false
+	f.fs.lk.Lock()
+	if x, ok := f.fs.files[f.path]; ok && x == f {
+		delete(f.fs.files, f.path)
+	}
+	f.fs.lk.Unlock()
+	return nil
+}
+
+// load reads the remote file content if it hasn't been loaded.
+// The caller must hold the file lock.
+func (f *File) load(ctx context.Context) error {
+	if f.loaded {
+		return nil
+	}
+	// Cached content is shared, so it's copied before it can be modified by writes
+	if data, ok := f.fs.cache.get(f.cid); ok {
+		f.data = clone(data)
+		f.loaded = true
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := f.fs.conf.Client.PullPath(f.fs.context(ctx), f.fs.conf.Key, f.path, &buf); err != nil {
+		if isNotFound(err) {
+			return fuse.ENOENT
+		}
+		return err
+	}
+	f.data = buf.Bytes()
+	f.loaded = true
+	f.fs.cache.put(f.cid, clone(f.data))
+	return nil
+}
+
+// push writes changed content to the remote.
+// The caller must hold the file lock.
+func (f *File) push(ctx context.Context) error {
+	if !f.dirty || f.removed {
+		return nil
+	}
+	info := buckets.FileInfo{
+		Mode:    f.mode,
+		ModTime: f.mtime,
+	}
+	if info.ModTime.IsZero() {
+		info.ModTime = time.Now()
+	}
+	res, _, err := f.fs.conf.Client.PushPath(
+		f.fs.context(ctx),
+		f.fs.conf.Key,
+		f.path,
+		bytes.NewReader(f.data),
+		client.WithFileInfo(info),
+	)
+	if err != nil {
+		return err
+	}
+	f.cid = res.Cid().String()
+	f.size = uint64(len(f.data))
+	f.fs.cache.put(f.cid, clone(f.data))
+	f.dirty = false
+	f.remote = true
+	f.fs.invalidate(f.path)
+	f.fs.forget(parent(f.path))
+	return nil
+}
+
+func clone(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// parent returns the parent of a bucket path.
+// The root path is "".
+func parent(pth string) string {
+	p := gopath.Dir(pth)
+	if p == "." || p == "/" {
+		return ""
+	}
+	return p
+}
+
+// isNotFound returns whether or not err indicates a missing remote path.
+func isNotFound(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "no link by that name") ||
+		strings.Contains(msg, "no link named") ||
+		strings.Contains(msg, "could not resolve path")
+}
@@ -0,0 +1,278 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package mount
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	gopath "path"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/api/bucketsd/client"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+func TestFS_Read(t *testing.T) {
+	c := newMemClient(map[string]string{
+		"foo.txt":     "foo",
+		"one/bar.txt": "bar",
+	})
+	root := newRoot(t, c)
+	ctx := context.Background()
+
+	ents, err := root.ReadDirAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo.txt", "one"}, names(ents))
+
+	n, err := root.Lookup(ctx, "one")
+	require.NoError(t, err)
+	one, ok := n.(*Dir)
+	require.True(t, ok)
+	n, err = one.Lookup(ctx, "bar.txt")
+	require.NoError(t, err)
+	bar, ok := n.(*File)
+	require.True(t, ok)
+	var attr fuse.Attr
+	require.NoError(t, bar.Attr(ctx, &attr))
+	assert.Equal(t, uint64(3), attr.Size)
+
+	assert.Equal(t, "bar", readFile(t, bar))
+	assert.Equal(t, "bar", readFile(t, bar))
+	assert.Equal(t, 1, c.pulls, "content should be read from cache")
+
+	_, err = root.Lookup(ctx, "missing")
+	assert.Equal(t, fuse.ENOENT, err)
+}
+
+func TestFS_Write(t *testing.T) {
+	c := newMemClient(map[string]string{
+		"foo.txt": "foo",
+	})
+	root := newRoot(t, c)
+	ctx := context.Background()
+
+	t.Run("create", func(t *testing.T) {
+		_, err := root.Mkdir(ctx, &fuse.MkdirRequest{Name: "new"})
+		require.NoError(t, err)
+		n, err := root.Lookup(ctx, "new")
+		require.NoError(t, err)
+		dir := n.(*Dir)
+		n, h, err := dir.Create(ctx, &fuse.CreateRequest{Name: "baz.txt", Mode: 0644}, &fuse.CreateResponse{})
+		require.NoError(t, err)
+		writeAt(t, h, 0, "baz")
+		assert.Empty(t, c.files["new/baz.txt"], "content should not be pushed before close")
+		closeFile(t, h)
+		assert.Equal(t, "baz", c.files["new/baz.txt"])
+		assert.Equal(t, 1, c.pushes)
+	})
+
+	t.Run("modify", func(t *testing.T) {
+		n, err := root.Lookup(ctx, "foo.txt")
+		require.NoError(t, err)
+		h, err := n.(fs.NodeOpener).Open(ctx, &fuse.OpenRequest{Flags: fuse.OpenReadWrite}, &fuse.OpenResponse{})
+		require.NoError(t, err)
+		writeAt(t, h, 3, "bar")
+		closeFile(t, h)
+		assert.Equal(t, "foobar", c.files["foo.txt"])
+
+		n, err = root.Lookup(ctx, "foo.txt")
+		require.NoError(t, err)
+		err = n.(fs.NodeSetattrer).Setattr(ctx, &fuse.SetattrRequest{Valid: fuse.SetattrSize, Size: 1}, &fuse.SetattrResponse{})
+		require.NoError(t, err)
+		require.NoError(t, n.(fs.NodeFsyncer).Fsync(ctx, &fuse.FsyncRequest{}))
+		assert.Equal(t, "f", c.files["foo.txt"])
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		n, err := root.Lookup(ctx, "new")
+		require.NoError(t, err)
+		err = root.Rename(ctx, &fuse.RenameRequest{OldName: "foo.txt", NewName: "moved.txt"}, n)
+		require.NoError(t, err)
+		_, ok := c.files["foo.txt"]
+		assert.False(t, ok)
+		assert.Equal(t, "f", c.files["new/moved.txt"])
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := root.Remove(ctx, &fuse.RemoveRequest{Name: "new", Dir: true})
+		assert.Equal(t, fuse.Errno(syscall.ENOTEMPTY), err)
+		err = root.Remove(ctx, &fuse.RemoveRequest{Name: "new"})
+		assert.Equal(t, fuse.Errno(syscall.EISDIR), err)
+		assert.Equal(t, "f", c.files["new/moved.txt"])
+
+		n, err := root.Lookup(ctx, "new")
+		require.NoError(t, err)
+		dir := n.(*Dir)
+		err = dir.Remove(ctx, &fuse.RemoveRequest{Name: "moved.txt", Dir: true})
+		assert.Equal(t, fuse.Errno(syscall.ENOTDIR), err)
+		err = dir.Remove(ctx, &fuse.RemoveRequest{Name: "moved.txt"})
+		require.NoError(t, err)
+		_, ok := c.files["new/moved.txt"]
+		assert.False(t, ok)
+	})
+}
+
+func TestCache(t *testing.T) {
+	c := newCache(6)
+	c.put("a", []byte("aaa"))
+	c.put("b", []byte("bbb"))
+	_, ok := c.get("a")
+	assert.True(t, ok)
+	c.put("c", []byte("ccc"))
+	_, ok = c.get("b")
+	assert.False(t, ok, "least recently used entry should be evicted")
+	_, ok = c.get("a")
+	assert.True(t, ok)
+	c.put("d", []byte("too large"))
+	_, ok = c.get("d")
+	assert.False(t, ok)
+}
+
+func newRoot(t *testing.T, c Client) *Dir {
+	n, err := newFS(Config{Client: c, Key: "key"}).Root()
+	require.NoError(t, err)
+	return n.(*Dir)
+}
+
+func names(ents []fuse.Dirent) []string {
+	var list []string
+	for _, e := range ents {
+		list = append(list, e.Name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func readFile(t *testing.T, n fs.Node) string {
+	ctx := context.Background()
+	h, err := n.(fs.NodeOpener).Open(ctx, &fuse.OpenRequest{}, &fuse.OpenResponse{})
+	require.NoError(t, err)
+	resp := &fuse.ReadResponse{}
+	err = h.(fs.HandleReader).Read(ctx, &fuse.ReadRequest{Size: 1024}, resp)
+	require.NoError(t, err)
+	closeFile(t, h)
+	return string(resp.Data)
+}
+
+func writeAt(t *testing.T, h fs.Handle, off int64, data string) {
+	resp := &fuse.WriteResponse{}
+	err := h.(fs.HandleWriter).Write(context.Background(), &fuse.WriteRequest{Offset: off, Data: []byte(data)}, resp)
+	require.NoError(t, err)
+	assert.Equal(t, len(data), resp.Size)
+}
+
+func closeFile(t *testing.T, h fs.Handle) {
+	ctx := context.Background()
+	require.NoError(t, h.(fs.HandleFlusher).Flush(ctx, &fuse.FlushRequest{}))
+	require.NoError(t, h.(fs.HandleReleaser).Release(ctx, &fuse.ReleaseRequest{}))
+}
+
+// memClient is an in-memory bucket.
+type memClient struct {
+	files  map[string]string
+	pulls  int
+	pushes int
+	lk     sync.Mutex
+}
+
+func newMemClient(files map[string]string) *memClient {
+	return &memClient{files: files}
+}
+
+func (c *memClient) ListPath(_ context.Context, _, pth string) (*pb.ListPathResponse, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if data, ok := c.files[pth]; ok {
+		return &pb.ListPathResponse{Item: fileItem(pth, data)}, nil
+	}
+	item := &pb.PathItem{Name: gopath.Base(pth), Path: pth, IsDir: true}
+	seen := make(map[string]struct{})
+	for p, data := range c.files {
+		rel := p
+		if pth != "" {
+			if !strings.HasPrefix(p, pth+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(p, pth+"/")
+		}
+		parts := strings.SplitN(rel, "/", 2)
+		if _, ok := seen[parts[0]]; ok {
+			continue
+		}
+		seen[parts[0]] = struct{}{}
+		if len(parts) == 1 {
+			item.Items = append(item.Items, fileItem(p, data))
+		} else {
+			item.Items = append(item.Items, &pb.PathItem{Name: parts[0], IsDir: true})
+		}
+	}
+	if pth != "" && len(item.Items) == 0 {
+		return nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+	return &pb.ListPathResponse{Item: item}, nil
+}
+
+func (c *memClient) PullPath(_ context.Context, _, pth string, w io.Writer, _ ...client.Option) error {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	data, ok := c.files[pth]
+	if !ok {
+		return fmt.Errorf("could not resolve path: %s", pth)
+	}
+	c.pulls++
+	_, err := io.WriteString(w, data)
+	return err
+}
+
+func (c *memClient) PushPath(_ context.Context, _, pth string, r io.Reader, _ ...client.Option) (path.Resolved, path.Resolved, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	c.files[pth] = string(data)
+	c.pushes++
+	p := path.IpfsPath(makeCid(string(data)))
+	return p, p, nil
+}
+
+func (c *memClient) RemovePath(_ context.Context, _, pth string, _ ...client.Option) (path.Resolved, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if _, ok := c.files[pth]; !ok {
+		return nil, fmt.Errorf("no link by that name")
+	}
+	delete(c.files, pth)
+	return path.IpfsPath(makeCid(pth)), nil
+}
+
+func fileItem(pth, data string) *pb.PathItem {
+	return &pb.PathItem{
+		Cid:  makeCid(data).String(),
+		Name: gopath.Base(pth),
+		Path: pth,
+		Size: int64(len(data)),
+	}
+}
+
+func makeCid(data string) cid.Cid {
+	sum := sha256.Sum256([]byte(data))
+	h, _ := mh.Encode(sum[:], mh.SHA2_256)
+	return cid.NewCidV1(cid.Raw, h)
+}
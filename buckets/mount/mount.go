@@ -0,0 +1,41 @@
+package mount
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/textile/v2/api/bucketsd/client"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+var (
+	// ErrNotSupported indicates FUSE is not available on this platform.
+	ErrNotSupported = errors.New("mounting buckets is not supported on this platform")
+
+	// DefaultCacheSize is the default maximum number of bytes of file content kept in memory.
+	DefaultCacheSize int64 = 64 << 20
+)
+
+// Client is the subset of the buckets API used to serve a mounted bucket.
+// It's satisfied by *client.Client.
+type Client interface {
+	ListPath(ctx context.Context, key, pth string) (*pb.ListPathResponse, error)
+	PullPath(ctx context.Context, key, pth string, writer io.Writer, opts ...client.Option) error
+	PushPath(ctx context.Context, key, pth string, reader io.Reader, opts ...client.Option) (path.Resolved, path.Resolved, error)
+	RemovePath(ctx context.Context, key, pth string, opts ...client.Option) (path.Resolved, error)
+}
+
+// Config contains details for a mounted bucket.
+type Config struct {
+	// Client is used to access the remote bucket (required).
+	Client Client
+	// Key is the bucket key (required).
+	Key string
+	// Context adds thread and auth info to outgoing API requests (optional).
+	Context func(context.Context) context.Context
+	// CacheSize is the maximum number of bytes of file content kept in memory (optional).
+	// Defaults to DefaultCacheSize.
+	CacheSize int64
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package mount
+
+import "context"
+
+// Mount is not supported on this platform.
+func Mount(_ context.Context, _ string, _ Config) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,113 @@
+// Package car writes IPLD DAGs as CARv1 archives.
+// See https://ipld.io/specs/transport/car/carv1/.
+package car
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	cbornode "github.com/ipfs/go-ipld-cbor"
+	ipld "github.com/ipfs/go-ipld-format"
+)
+
+// LinksFunc returns the links of a node to follow when walking a DAG.
+type LinksFunc func(ipld.Node) []*ipld.Link
+
+// AllLinks follows every link of a node.
+func AllLinks(n ipld.Node) []*ipld.Link {
+	return n.Links()
+}
+
+// NoLinks follows no links.
+func NoLinks(ipld.Node) []*ipld.Link {
+	return nil
+}
+
+// Writer writes blocks to a CAR. Each block is written only once.
+type Writer struct {
+	bw   *bufio.Writer
+	seen *cid.Set
+}
+
+// NewWriter returns a Writer after writing a CAR header with roots to w.
+func NewWriter(w io.Writer, roots []cid.Cid) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	header, err := cbornode.DumpObject(map[string]interface{}{
+		"roots":   roots,
+		"version": 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeSection(bw, header); err != nil {
+		return nil, err
+	}
+	return &Writer{bw: bw, seen: cid.NewSet()}, nil
+}
+
+// Put writes the block of n.
+func (w *Writer) Put(n ipld.Node) error {
+	if !w.seen.Visit(n.Cid()) {
+		return nil
+	}
+	return writeSection(w.bw, n.Cid().Bytes(), n.RawData())
+}
+
+// Walk writes the block of c and the blocks linked from it by follow in depth-first order.
+func (w *Writer) Walk(ctx context.Context, ng ipld.NodeGetter, c cid.Cid, follow LinksFunc) error {
+	if w.seen.Has(c) {
+		return nil
+	}
+	n, err := ng.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	if err := w.Put(n); err != nil {
+		return err
+	}
+	for _, l := range follow(n) {
+		if err := w.Walk(ctx, ng, l.Cid, follow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.bw.Flush()
+}
+
+// Write writes a CAR with the entire DAG below root.
+func Write(ctx context.Context, w io.Writer, ng ipld.NodeGetter, root cid.Cid) error {
+	cw, err := NewWriter(w, []cid.Cid{root})
+	if err != nil {
+		return err
+	}
+	if err := cw.Walk(ctx, ng, root, AllLinks); err != nil {
+		return err
+	}
+	return cw.Flush()
+}
+
+// writeSection writes a varint length prefixed CAR section.
+func writeSection(w io.Writer, data ...[]byte) error {
+	var l int
+	for _, d := range data {
+		l += len(d)
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(l))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	for _, d := range data {
+		if _, err := w.Write(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
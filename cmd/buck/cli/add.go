@@ -18,6 +18,10 @@ var addCmd = &cobra.Command{
 	Run: func(c *cobra.Command, args []string) {
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
+		format := getFormat(c)
+		if format == JSONFormat && !yes {
+			cmd.Fatal(errYesRequired)
+		}
 		target, err := cid.Decode(args[0])
 		cmd.ErrCheck(err)
 		conf, err := bucks.NewConfigFromCmd(c, ".")
@@ -26,9 +30,15 @@ var addCmd = &cobra.Command{
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
+		var res syncResult
+		wait := func() {}
 		events := make(chan local.Event)
-		defer close(events)
-		go handleEvents(events)
+		if format == JSONFormat {
+			wait = collectEvents(events, &res)
+		} else {
+			defer close(events)
+			go handleEvents(events)
+		}
 		err = buck.AddRemoteCid(
 			ctx,
 			target,
@@ -36,7 +46,12 @@ var addCmd = &cobra.Command{
 			local.WithSelectMerge(getSelectMergeStrategy(yes)),
 			local.WithAddEvents(events),
 		)
+		wait()
 		cmd.ErrCheck(err)
+		if format == JSONFormat {
+			printJSON(res)
+			return
+		}
 		cmd.Success("Merged %s with %s", target, args[1])
 	},
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 
@@ -28,6 +29,10 @@ var defaultArchiveConfigCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		config, err := buck.DefaultArchiveConfig(ctx)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(config)
+			return
+		}
 		bytes, err := json.MarshalIndent(config, "", "  ")
 		cmd.ErrCheck(err)
 		cmd.Message("%s", string(bytes))
@@ -68,6 +73,10 @@ var setDefaultArchiveConfigCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		err = buck.SetDefaultArchiveConfig(ctx, config)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(config)
+			return
+		}
 		cmd.Success("Bucket default archive config updated")
 	},
 }
@@ -80,6 +89,10 @@ var archiveCmd = &cobra.Command{
 	Run: func(c *cobra.Command, args []string) {
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
+		format := getFormat(c)
+		if format == JSONFormat && !yes {
+			cmd.Fatal(errYesRequired)
+		}
 		if !yes {
 			cmd.Warn("Archives are Filecoin Mainnet. Use with caution.")
 			prompt := promptui.Prompt{
@@ -141,6 +154,10 @@ var archiveCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		err = buck.ArchiveRemote(ctx, opts...)
 		cmd.ErrCheck(err)
+		if format == JSONFormat {
+			printJSON(map[string]string{"key": buck.Key()})
+			return
+		}
 		cmd.Success("Archive queued successfully")
 	},
 }
@@ -163,6 +180,10 @@ var archiveLsCmd = &cobra.Command{
 		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
 		cmd.ErrCheck(err)
 
+		if getFormat(c) == JSONFormat {
+			fmt.Println(string(json))
+			return
+		}
 		cmd.Success("\n%v", string(json))
 	},
 }
@@ -179,9 +200,17 @@ var archiveWatchCmd = &cobra.Command{
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
+		format := getFormat(c)
 		msgs, err := buck.ArchiveWatch(ctx)
 		cmd.ErrCheck(err)
 		for m := range msgs {
+			if format == JSONFormat {
+				printJSONLine(m)
+				if m.Type == local.ArchiveError && !m.InactivityClose {
+					os.Exit(1)
+				}
+				continue
+			}
 			switch m.Type {
 			case local.ArchiveMessage:
 				cmd.Message(m.Message)
@@ -39,18 +39,24 @@ func Init(baseCmd *cobra.Command) {
 		pullCmd,
 		addCmd,
 		watchCmd,
+		mountCmd,
 		catCmd,
 		destroyCmd,
 		encryptCmd,
 		decryptCmd,
 		archiveCmd,
 		rolesCmd,
+		domainsCmd,
+		ipnsCmd,
 	)
 	archiveCmd.AddCommand(defaultArchiveConfigCmd, setDefaultArchiveConfigCmd, archiveWatchCmd, archiveLsCmd)
 	rolesCmd.AddCommand(rolesGrantCmd, rolesLsCmd, rolesAcceptCmd)
+	domainsCmd.AddCommand(domainsAddCmd, domainsVerifyCmd, domainsLsCmd, domainsRmCmd)
+	ipnsCmd.AddCommand(ipnsSetCmd, ipnsExportCmd)
 
 	baseCmd.PersistentFlags().String("key", "", "Bucket key")
 	baseCmd.PersistentFlags().String("thread", "", "Thread ID")
+	baseCmd.PersistentFlags().String("output", string(DefaultFormat), "Output format. Options: [default,json]")
 
 	initCmd.Flags().StringP("name", "n", "", "Bucket name")
 	initCmd.Flags().BoolP("private", "p", false, "Obfuscates files and folders with encryption")
@@ -60,26 +66,44 @@ func Init(baseCmd *cobra.Command) {
 	initCmd.Flags().Bool("hard", false, "Discards all local changes if true")
 	initCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	initCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
+	initCmd.Flags().String("ipns-key", "", "Imports an exported IPNS key file for the new bucket")
+	initCmd.Flags().String("ipns-key-password", "", "Password for a password-encrypted --ipns-key")
 	// (jsign): disabled until this feature is usable in mainnet.
 	// initCmd.Flags().Bool("unfreeze", false, "Unfreeze --cid from a known or imported deals in Filecoin.")
 
 	pushCmd.Flags().BoolP("force", "f", false, "Allows non-fast-forward updates if true")
 	pushCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	pushCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
+	pushCmd.Flags().Bool("dry-run", false, "Shows the changes that would be pushed without pushing them if true")
 
 	pullCmd.Flags().BoolP("force", "f", false, "Force pull all remote files if true")
 	pullCmd.Flags().Bool("hard", false, "Discards local changes if true")
 	pullCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 	pullCmd.Flags().BoolP("quiet", "q", false, "Write minimal output")
+	pullCmd.Flags().Bool("dry-run", false, "Shows the changes that would be pulled without pulling them if true")
+
+	watchCmd.Flags().Bool("poll", false, "Polls for local changes instead of using file system notifications if true")
+
+	mountCmd.Flags().Int64("cache-size", 64, "Maximum size of cached file content in MiB")
 
 	addCmd.Flags().BoolP("yes", "y", false, "Skips confirmations prompts to always overwrite files and merge folders")
 
+	destroyCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+
 	encryptCmd.Flags().StringP("password", "p", "", "Encryption password")
 	decryptCmd.Flags().StringP("password", "p", "", "Decryption password")
 
 	archiveCmd.Flags().StringP("file", "f", "", "Optional path to a file containing archive config json that will override the default")
 	archiveCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
 
+	ipnsSetCmd.Flags().Bool("disabled", false, "Disables IPNS publishing if true")
+	ipnsSetCmd.Flags().Duration("lifetime", 0, "Lifetime of published IPNS records, e.g., 48h")
+	ipnsSetCmd.Flags().Duration("ttl", 0, "Time resolvers may cache published IPNS records, e.g., 5m")
+	ipnsSetCmd.Flags().Duration("coalesce", 0, "Publish at most once per interval instead of on every update, e.g., 10m")
+
+	ipnsExportCmd.Flags().String("password", "", "Encrypts the key with a password")
+	ipnsExportCmd.Flags().String("identity", "", "Encrypts the key to a multibase encoded public key")
+
 	rolesGrantCmd.Flags().StringP("role", "r", "", "Access role: none, reader, writer, admin")
 
 	linksCmd.Flags().String("format", "default", "Display URL links in the provided format. Options: [default,json]")
@@ -103,6 +127,10 @@ var getCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		info, err := buck.Info(ctx)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(info)
+			return
+		}
 		cmd.JSON(info)
 	},
 }
@@ -119,6 +147,13 @@ var existingCmd = &cobra.Command{
 		defer cancel()
 		list, err := bucks.RemoteBuckets(ctx, conf.Thread)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			if list == nil {
+				list = []local.Info{}
+			}
+			printJSON(list)
+			return
+		}
 		var data [][]string
 		if len(list) > 0 {
 			for _, item := range list {
@@ -154,13 +189,17 @@ var statusCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		diff, err := buck.DiffLocal()
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			if diff == nil {
+				diff = []local.Change{}
+			}
+			printJSON(diff)
+			return
+		}
 		if len(diff) == 0 {
 			cmd.End("Everything up-to-date")
 		}
-		for _, c := range diff {
-			cf := local.ChangeColor(c.Type)
-			cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
-		}
+		printChanges(diff)
 	},
 }
 
@@ -178,6 +217,10 @@ var rootCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		r, err := buck.Roots(ctx)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(r)
+			return
+		}
 		if r.Local.Defined() {
 			cmd.Message("%s (local)", aurora.White(r.Local).Bold())
 		}
@@ -209,6 +252,9 @@ var linksCmd = &cobra.Command{
 
 		format, err := c.Flags().GetString("format")
 		cmd.ErrCheck(err)
+		if !c.Flags().Changed("format") {
+			format = string(getFormat(c))
+		}
 
 		printLinks(links, Format(format))
 	},
@@ -217,7 +263,7 @@ var linksCmd = &cobra.Command{
 func printLinks(reply local.Links, format Format) {
 	switch format {
 	case JSONFormat:
-		cmd.JSON(reply)
+		printJSON(reply)
 	default:
 		cmd.Message("Your bucket links:")
 		cmd.Message("%s Thread link", aurora.White(reply.URL).Bold())
@@ -249,6 +295,13 @@ var lsCmd = &cobra.Command{
 		}
 		items, err := buck.ListRemotePath(ctx, pth)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			if items == nil {
+				items = []local.BucketItem{}
+			}
+			printJSON(items)
+			return
+		}
 		var data [][]string
 		if len(items) > 0 {
 			for _, item := range items {
@@ -331,24 +384,36 @@ var destroyCmd = &cobra.Command{
 	Long:  `Destroys the bucket and all objects.`,
 	Args:  cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		format := getFormat(c)
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
-		cmd.Warn("%s",
-			aurora.Red(
-				"This action cannot be undone. The bucket and all associated data will be permanently deleted."))
-		prompt := promptui.Prompt{
-			Label:     "Are you absolutely sure",
-			IsConfirm: true,
+		if format == JSONFormat && !yes {
+			cmd.Fatal(errYesRequired)
 		}
-		if _, err = prompt.Run(); err != nil {
-			cmd.End("")
+		if !yes {
+			cmd.Warn("%s",
+				aurora.Red(
+					"This action cannot be undone. The bucket and all associated data will be permanently deleted."))
+			prompt := promptui.Prompt{
+				Label:     "Are you absolutely sure",
+				IsConfirm: true,
+			}
+			if _, err = prompt.Run(); err != nil {
+				cmd.End("")
+			}
 		}
 		err = buck.Destroy(ctx)
 		cmd.ErrCheck(err)
+		if format == JSONFormat {
+			printJSON(map[string]string{"key": buck.Key()})
+			return
+		}
 		cmd.Success("Your bucket has been deleted")
 	},
 }
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/buckets/local"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var domainsCmd = &cobra.Command{
+	Use: "domains",
+	Aliases: []string{
+		"domain",
+	},
+	Short: "Custom domain management",
+	Long:  `Manages custom domains that serve the bucket website.`,
+	Args:  cobra.ExactArgs(0),
+}
+
+var domainsAddCmd = &cobra.Command{
+	Use:   "add [domain]",
+	Short: "Add a custom domain",
+	Long: `Attaches a custom domain to the bucket.
+
+To prove ownership, create the printed TXT record with your DNS provider and run 'buck domains verify'.
+Point the domain at the bucket with a CNAME record to the printed target.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		d, err := buck.AddDomain(ctx, args[0])
+		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(d)
+			return
+		}
+		if d.Verified {
+			cmd.Success("Domain %s is already verified", aurora.White(d.Name).Bold())
+			return
+		}
+		printDomainRecords(d)
+		cmd.Success("Added %s. Verify it with %s once the records are published.",
+			aurora.White(d.Name).Bold(), aurora.Cyan("buck domains verify "+d.Name))
+	},
+}
+
+var domainsVerifyCmd = &cobra.Command{
+	Use:   "verify [domain]",
+	Short: "Verify a custom domain",
+	Long:  `Verifies ownership of a custom domain by checking its TXT challenge record.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		d, err := buck.VerifyDomain(ctx, args[0])
+		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(d)
+			return
+		}
+		cmd.Success("Verified %s", aurora.White(d.Name).Bold())
+	},
+}
+
+var domainsLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List custom domains",
+	Long:  `Lists custom domains attached to the bucket.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		list, err := buck.Domains(ctx)
+		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(list)
+			return
+		}
+		if len(list) == 0 {
+			cmd.End("No custom domains")
+		}
+		data := make([][]string, len(list))
+		for i, d := range list {
+			data[i] = []string{d.Name, strconv.FormatBool(d.Verified), d.Target}
+		}
+		cmd.RenderTable([]string{"domain", "verified", "target"}, data)
+	},
+}
+
+var domainsRmCmd = &cobra.Command{
+	Use: "rm [domain]",
+	Aliases: []string{
+		"remove",
+	},
+	Short: "Remove a custom domain",
+	Long:  `Detaches a custom domain from the bucket.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		err = buck.RemoveDomain(ctx, args[0])
+		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(map[string]string{"removed": args[0]})
+			return
+		}
+		cmd.Success("Removed %s", aurora.White(args[0]).Bold())
+	},
+}
+
+func printDomainRecords(d local.Domain) {
+	cmd.RenderTable([]string{"type", "name", "value"}, [][]string{
+		{"TXT", d.ChallengeName, d.ChallengeValue},
+		{"CNAME", d.Name, d.Target},
+	})
+}
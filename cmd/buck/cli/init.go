@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	cid "github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 	"github.com/textileio/go-threads/core/thread"
@@ -13,6 +15,7 @@ import (
 	"github.com/textileio/textile/v2/api/common"
 	"github.com/textileio/textile/v2/buckets/local"
 	"github.com/textileio/textile/v2/cmd"
+	"github.com/textileio/textile/v2/ipns"
 )
 
 var initCmd = &cobra.Command{
@@ -26,6 +29,7 @@ Existing configs will not be overwritten.
 Use the '--existing' flag to interactively select an existing remote bucket.
 Use the '--cid' flag to initialize from an existing UnixFS DAG.
 Use the '--unfreeze' flag to retrieve '--cid' from known or imported deals.
+Use the '--ipns-key' flag to import an IPNS key exported with 'buck ipns export'.
 
 By default, if the remote bucket exists, remote objects are pulled and merged with local changes.
 Use the '--soft' flag to accept all local changes, including deletions.
@@ -38,6 +42,7 @@ Use the '--hard' flag to discard all local changes.
 
 		quiet, err := c.Flags().GetBool("quiet")
 		cmd.ErrCheck(err)
+		format := getFormat(c)
 
 		existing := conf.Thread.Defined() && conf.Key != ""
 		chooseExisting, err := c.Flags().GetBool("existing")
@@ -45,6 +50,12 @@ Use the '--hard' flag to discard all local changes.
 		if existing && chooseExisting {
 			chooseExisting = false // Nothing left to choose
 		}
+		if format == JSONFormat && chooseExisting {
+			cmd.Fatal(errors.New("--existing cannot be used with --output json"))
+		}
+		if format == JSONFormat && !conf.Thread.Defined() {
+			cmd.Fatal(errors.New("--thread is required with --output json"))
+		}
 
 		var strategy local.InitStrategy
 		soft, err := c.Flags().GetBool("soft")
@@ -81,10 +92,25 @@ Use the '--hard' flag to discard all local changes.
 			cmd.Fatal(errors.New("--unfreeze requires specifying --cid"))
 		}
 
+		var ipnsKey crypto.PrivKey
+		ipnsKeyFile, err := c.Flags().GetString("ipns-key")
+		cmd.ErrCheck(err)
+		if ipnsKeyFile != "" {
+			if existing || chooseExisting {
+				cmd.Fatal(errors.New("--ipns-key cannot be used with an existing bucket"))
+			}
+			data, err := ioutil.ReadFile(ipnsKeyFile)
+			cmd.ErrCheck(err)
+			password, err := c.Flags().GetString("ipns-key-password")
+			cmd.ErrCheck(err)
+			ipnsKey, err = ipns.DecryptKey(context.Background(), data, password, nil)
+			cmd.ErrCheck(err)
+		}
+
 		var name string
 		var private bool
 		if !existing && !chooseExisting {
-			if c.Flags().Changed("name") {
+			if c.Flags().Changed("name") || format == JSONFormat {
 				name, err = c.Flags().GetString("name")
 				cmd.ErrCheck(err)
 			} else {
@@ -96,7 +122,7 @@ Use the '--hard' flag to discard all local changes.
 					cmd.End("")
 				}
 			}
-			if c.Flags().Changed("private") {
+			if c.Flags().Changed("private") || format == JSONFormat {
 				private, err = c.Flags().GetBool("private")
 				cmd.ErrCheck(err)
 			} else {
@@ -170,7 +196,7 @@ Use the '--hard' flag to discard all local changes.
 		defer cancel()
 
 		var events chan local.Event
-		if !quiet {
+		if !quiet && format != JSONFormat {
 			events = make(chan local.Event)
 			defer close(events)
 			go handleEvents(events)
@@ -182,11 +208,16 @@ Use the '--hard' flag to discard all local changes.
 			local.WithPrivate(private),
 			local.WithCid(xcid),
 			local.WithUnfreeze(unfreeze),
+			local.WithIPNSKey(ipnsKey),
 			local.WithStrategy(strategy),
 			local.WithInitEvents(events))
 		cmd.ErrCheck(err)
 
 		if unfreeze {
+			if format == JSONFormat {
+				printJSON(map[string]string{"retrieval_id": buck.RetrievalID()})
+				return
+			}
 			cmd.Message("The retrieval-id is: %s", buck.RetrievalID())
 			cmd.Message("The bucket will be automatically created if the Filecoin retrieval succeeds.")
 			cmd.Message("Track progress using `hub retrievals [ls | logs]`.")
@@ -195,6 +226,17 @@ Use the '--hard' flag to discard all local changes.
 
 		links, err := buck.RemoteLinks(ctx, "")
 		cmd.ErrCheck(err)
+		if format == JSONFormat {
+			bp, err := buck.Path()
+			cmd.ErrCheck(err)
+			printJSON(initResult{
+				Thread: conf.Thread,
+				Key:    buck.Key(),
+				Path:   bp,
+				Links:  links,
+			})
+			return
+		}
 		printLinks(links, DefaultFormat)
 
 		var msg string
@@ -212,3 +254,11 @@ Use the '--hard' flag to discard all local changes.
 		cmd.Success(msg, aurora.White(bp).Bold())
 	},
 }
+
+// initResult is the JSON output of init.
+type initResult struct {
+	Thread thread.ID   `json:"thread"`
+	Key    string      `json:"key"`
+	Path   string      `json:"path"`
+	Links  local.Links `json:"links"`
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/buckets/local"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var ipnsCmd = &cobra.Command{
+	Use:   "ipns",
+	Short: "Show IPNS publishing settings",
+	Long:  `Shows the remote bucket's IPNS publishing settings.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		settings, err := buck.IPNSSettings(ctx)
+		cmd.ErrCheck(err)
+		printIPNSSettings(settings, getFormat(c))
+	},
+}
+
+var ipnsSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Update IPNS publishing settings",
+	Long: `Updates the remote bucket's IPNS publishing settings.
+
+Only the provided flags are changed. Zero durations use the server defaults.
+Use '--coalesce' to publish at most once per interval instead of on every update.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		settings, err := buck.IPNSSettings(ctx)
+		cmd.ErrCheck(err)
+		if c.Flags().Changed("disabled") {
+			settings.Disabled, err = c.Flags().GetBool("disabled")
+			cmd.ErrCheck(err)
+		}
+		if c.Flags().Changed("lifetime") {
+			settings.Lifetime, err = c.Flags().GetDuration("lifetime")
+			cmd.ErrCheck(err)
+		}
+		if c.Flags().Changed("ttl") {
+			settings.TTL, err = c.Flags().GetDuration("ttl")
+			cmd.ErrCheck(err)
+		}
+		if c.Flags().Changed("coalesce") {
+			settings.CoalesceInterval, err = c.Flags().GetDuration("coalesce")
+			cmd.ErrCheck(err)
+		}
+		settings, err = buck.SetIPNSSettings(ctx, settings)
+		cmd.ErrCheck(err)
+		printIPNSSettings(settings, getFormat(c))
+	},
+}
+
+var ipnsExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export the IPNS key",
+	Long: `Exports the remote bucket's IPNS private key to a file.
+
+The key is encrypted with '--password' or to the public key of '--identity'.
+Only the bucket owner can export its key.
+Import the key into a new bucket with 'buck init --ipns-key'.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		password, err := c.Flags().GetString("password")
+		cmd.ErrCheck(err)
+		identity, err := c.Flags().GetString("identity")
+		cmd.ErrCheck(err)
+		if (password == "") == (identity == "") {
+			cmd.Fatal(errors.New("either --password or --identity is required"))
+		}
+		var pk thread.PubKey
+		if identity != "" {
+			lpk := &thread.Libp2pPubKey{}
+			cmd.ErrCheck(lpk.UnmarshalString(identity))
+			pk = lpk
+		}
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		data, err := buck.ExportIPNSKey(ctx, password, pk)
+		cmd.ErrCheck(err)
+		cmd.ErrCheck(ioutil.WriteFile(args[0], data, 0600))
+		if getFormat(c) == JSONFormat {
+			printJSON(map[string]string{"key": buck.Key(), "file": args[0]})
+			return
+		}
+		cmd.Success("Exported IPNS key for %s to %s", aurora.White(buck.Key()).Bold(), aurora.White(args[0]).Bold())
+	},
+}
+
+func printIPNSSettings(settings local.IPNSSettings, format Format) {
+	if format == JSONFormat {
+		printJSON(settings)
+		return
+	}
+	durationOrDefault := func(v string, zero bool) string {
+		if zero {
+			return "default"
+		}
+		return v
+	}
+	coalesce := "every update"
+	if settings.CoalesceInterval > 0 {
+		coalesce = "every " + settings.CoalesceInterval.String()
+	}
+	cmd.RenderTable([]string{"publishing", "lifetime", "ttl", "publish"}, [][]string{{
+		strconv.FormatBool(!settings.Disabled),
+		durationOrDefault(settings.Lifetime.String(), settings.Lifetime == 0),
+		durationOrDefault(settings.TTL.String(), settings.TTL == 0),
+		coalesce,
+	}})
+}
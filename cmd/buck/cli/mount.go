@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var mountCmd = &cobra.Command{
+	Use:   "mount [dir]",
+	Short: "Mount the remote bucket as a local file system",
+	Long: `Mounts the remote bucket at dir using FUSE (Linux, macOS, and FreeBSD).
+
+Files are fetched on demand and cached in memory. Changes are pushed to the remote when a file is closed.
+Press Ctrl+C to unmount.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		conf, err := bucks.NewConfigFromCmd(c, ".")
+		cmd.ErrCheck(err)
+		cacheSize, err := c.Flags().GetInt64("cache-size")
+		cmd.ErrCheck(err)
+		dir, err := filepath.Abs(args[0])
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, conf)
+		cmd.ErrCheck(err)
+		done := make(chan struct{})
+		go cmd.HandleInterrupt(func() {
+			cancel()
+			<-done
+		})
+		if getFormat(c) == JSONFormat {
+			printJSONLine(map[string]string{"event": "mounted", "dir": dir})
+		} else {
+			cmd.Success("Mounted bucket at %s", aurora.White(dir).Bold())
+		}
+		err = buck.Mount(ctx, dir, cacheSize<<20)
+		close(done)
+		cmd.ErrCheck(err)
+	},
+}
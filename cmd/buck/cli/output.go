@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/buckets/local"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var errYesRequired = errors.New("--yes is required with --output json")
+
+// getFormat returns the output format selected with the --output flag.
+func getFormat(c *cobra.Command) Format {
+	o, err := c.Flags().GetString("output")
+	cmd.ErrCheck(err)
+	switch f := Format(o); f {
+	case DefaultFormat, JSONFormat:
+		return f
+	default:
+		cmd.Fatal(fmt.Errorf("invalid output format %s (options: default, json)", o))
+		return ""
+	}
+}
+
+// printJSON writes data to stdout as uncolored JSON so it can be consumed by other programs.
+func printJSON(data interface{}) {
+	bytes, err := json.MarshalIndent(data, "", "  ")
+	cmd.ErrCheck(err)
+	fmt.Println(string(bytes))
+}
+
+// printJSONLine writes data to stdout as a single line of uncolored JSON.
+func printJSONLine(data interface{}) {
+	bytes, err := json.Marshal(data)
+	cmd.ErrCheck(err)
+	fmt.Println(string(bytes))
+}
+
+func printChanges(changes []local.Change) {
+	for _, c := range changes {
+		cf := local.ChangeColor(c.Type)
+		cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
+	}
+}
+
+func printPlan(plan local.Plan, format Format) {
+	switch format {
+	case JSONFormat:
+		printJSON(plan)
+	default:
+		if len(plan.Changes) == 0 {
+			cmd.End("Everything up-to-date")
+		}
+		printChanges(plan.Changes)
+		cmd.Message("%d to add, %d to modify, %d to delete (%s to transfer)",
+			aurora.White(plan.Adds).Bold(),
+			aurora.White(plan.Mods).Bold(),
+			aurora.White(plan.Deletes).Bold(),
+			aurora.White(formatBytes(plan.Bytes, false)).Bold())
+	}
+}
+
+// syncResult is the JSON output of commands that transfer files.
+type syncResult struct {
+	Roots   *local.Roots `json:"roots,omitempty"`
+	Files   []syncedFile `json:"files"`
+	Removed []string     `json:"removed"`
+}
+
+type syncedFile struct {
+	Path string  `json:"path"`
+	Cid  cid.Cid `json:"cid"`
+	Size int64   `json:"size"`
+}
+
+// collectEvents records file events in res.
+// The returned function closes events and waits for all events to be recorded.
+func collectEvents(events chan local.Event, res *syncResult) func() {
+	res.Files = []syncedFile{}
+	res.Removed = []string{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for e := range events {
+			switch e.Type {
+			case local.EventFileComplete:
+				res.Files = append(res.Files, syncedFile{Path: e.Path, Cid: e.Cid, Size: e.Size})
+			case local.EventFileRemoved:
+				res.Removed = append(res.Removed, e.Path)
+			}
+		}
+	}()
+	return func() {
+		close(events)
+		<-done
+	}
+}
@@ -16,6 +16,7 @@ var pullCmd = &cobra.Command{
 
 Use the '--hard' flag to discard all local changes.
 Use the '--force' flag to pull all remote objects, even if they already exist locally.
+Use the '--dry-run' flag to show the changes that would be pulled without pulling them.
 `,
 	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
@@ -27,14 +28,33 @@ Use the '--force' flag to pull all remote objects, even if they already exist lo
 		cmd.ErrCheck(err)
 		quiet, err := c.Flags().GetBool("quiet")
 		cmd.ErrCheck(err)
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
+		format := getFormat(c)
+		if format == JSONFormat && hard && !yes && !dryRun {
+			cmd.Fatal(errYesRequired)
+		}
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
+
+		if dryRun {
+			plan, err := buck.PlanPullRemote(ctx, local.WithForce(force), local.WithHard(hard))
+			cmd.ErrCheck(err)
+			printPlan(plan, format)
+			return
+		}
+
+		var res syncResult
+		wait := func() {}
 		var events chan local.Event
-		if !quiet {
+		if format == JSONFormat {
+			events = make(chan local.Event)
+			wait = collectEvents(events, &res)
+		} else if !quiet {
 			events = make(chan local.Event)
 			defer close(events)
 			go handleEvents(events)
@@ -45,13 +65,24 @@ Use the '--force' flag to pull all remote objects, even if they already exist lo
 			local.WithForce(force),
 			local.WithHard(hard),
 			local.WithEvents(events))
+		wait()
 		if errors.Is(err, local.ErrAborted) {
 			cmd.End("")
 		} else if errors.Is(err, local.ErrUpToDate) {
-			cmd.End("Everything up-to-date")
+			if format == JSONFormat {
+				roots, err = buck.Roots(ctx)
+				cmd.ErrCheck(err)
+			} else {
+				cmd.End("Everything up-to-date")
+			}
 		} else if err != nil {
 			cmd.Fatal(err)
 		}
+		if format == JSONFormat {
+			res.Roots = &roots
+			printJSON(res)
+			return
+		}
 		cmd.Message("%s", aurora.White(roots.Remote).Bold())
 	},
 }
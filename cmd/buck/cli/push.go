@@ -19,6 +19,7 @@ var pushCmd = &cobra.Command{
 	Long: `Pushes paths that have been added to and paths that have been removed or differ from the local bucket root.
 
 Use the '--force' flag to allow a non-fast-forward update.
+Use the '--dry-run' flag to show the changes that would be pushed without pushing them.
 `,
 	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
@@ -28,6 +29,12 @@ Use the '--force' flag to allow a non-fast-forward update.
 		cmd.ErrCheck(err)
 		quiet, err := c.Flags().GetBool("quiet")
 		cmd.ErrCheck(err)
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
+		format := getFormat(c)
+		if format == JSONFormat && !yes && !dryRun {
+			cmd.Fatal(errYesRequired)
+		}
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
@@ -35,8 +42,20 @@ Use the '--force' flag to allow a non-fast-forward update.
 		buck, err := bucks.GetLocalBucket(ctx, conf)
 		cmd.ErrCheck(err)
 
+		if dryRun {
+			plan, err := buck.PlanPushLocal(ctx, local.WithForce(force))
+			cmd.ErrCheck(err)
+			printPlan(plan, format)
+			return
+		}
+
+		var res syncResult
+		wait := func() {}
 		var events chan local.Event
-		if !quiet {
+		if format == JSONFormat {
+			events = make(chan local.Event)
+			wait = collectEvents(events, &res)
+		} else if !quiet {
 			events = make(chan local.Event)
 			defer close(events)
 			go handleEvents(events)
@@ -47,15 +66,26 @@ Use the '--force' flag to allow a non-fast-forward update.
 			local.WithForce(force),
 			local.WithEvents(events),
 		)
+		wait()
 		if errors.Is(err, local.ErrAborted) {
 			cmd.End("")
 		} else if errors.Is(err, local.ErrUpToDate) {
-			cmd.End("Everything up-to-date")
+			if format == JSONFormat {
+				roots, err = buck.Roots(ctx)
+				cmd.ErrCheck(err)
+			} else {
+				cmd.End("Everything up-to-date")
+			}
 		} else if err != nil && strings.Contains(err.Error(), buckets.ErrNonFastForward.Error()) {
 			cmd.Fatal(errors.New(nonFastForwardMsg), aurora.Cyan("buck pull"))
 		} else if err != nil {
 			cmd.Fatal(err)
 		}
+		if format == JSONFormat {
+			res.Roots = &roots
+			printJSON(res)
+			return
+		}
 		cmd.Message("%s", aurora.White(roots.Remote).Bold())
 	},
 }
@@ -85,6 +85,10 @@ Access roles:
 
 		res, err := buck.PushPathAccessRoles(ctx, pth, map[string]buckets.Role{identityOrToken: role})
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(rolesToStrings(res))
+			return
+		}
 		var data [][]string
 		if len(res) > 0 {
 			for i, r := range res {
@@ -118,6 +122,10 @@ Identity must be a multibase encoded public key.
 		token := args[2]
 		res, err := buck.AcceptPathAccessRoles(ctx, pth, token, identity)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(map[string]string{"identity": identity, "role": res.String()})
+			return
+		}
 		cmd.Success("Access successfully granted to identity. Role granted is %s", aurora.White(res.String()).Bold())
 	},
 }
@@ -143,6 +151,10 @@ var rolesLsCmd = &cobra.Command{
 		}
 		res, err := buck.PullPathAccessRoles(ctx, pth)
 		cmd.ErrCheck(err)
+		if getFormat(c) == JSONFormat {
+			printJSON(rolesToStrings(res))
+			return
+		}
 		var data [][]string
 		if len(res) > 0 {
 			for i, r := range res {
@@ -156,3 +168,11 @@ var rolesLsCmd = &cobra.Command{
 	},
 }
 
+// rolesToStrings maps identities to role names for JSON output.
+func rolesToStrings(roles map[string]buckets.Role) map[string]string {
+	m := make(map[string]string, len(roles))
+	for i, r := range roles {
+		m[i] = r.String()
+	}
+	return m
+}
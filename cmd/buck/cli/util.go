@@ -8,7 +8,6 @@ import (
 	pb "github.com/cheggaaa/pb/v3"
 	"github.com/manifoldco/promptui"
 	"github.com/textileio/textile/v2/buckets/local"
-	"github.com/textileio/textile/v2/cmd"
 )
 
 func getConfirm(label string, auto bool) local.ConfirmDiffFunc {
@@ -16,10 +15,7 @@ func getConfirm(label string, auto bool) local.ConfirmDiffFunc {
 		if auto {
 			return true
 		}
-		for _, c := range diff {
-			cf := local.ChangeColor(c.Type)
-			cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
-		}
+		printChanges(diff)
 		prompt := promptui.Prompt{
 			Label:     fmt.Sprintf(label, len(diff)),
 			IsConfirm: true,
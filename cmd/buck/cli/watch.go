@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 
+	cid "github.com/ipfs/go-cid"
 	"github.com/spf13/cobra"
 	"github.com/textileio/textile/v2/buckets/local"
 	"github.com/textileio/textile/v2/cmd"
@@ -16,6 +17,9 @@ var watchCmd = &cobra.Command{
 	Run: func(c *cobra.Command, args []string) {
 		conf, err := bucks.NewConfigFromCmd(c, ".")
 		cmd.ErrCheck(err)
+		poll, err := c.Flags().GetBool("poll")
+		cmd.ErrCheck(err)
+		format := getFormat(c)
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, conf)
@@ -24,16 +28,22 @@ var watchCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		events := make(chan local.Event)
 		defer close(events)
-		go handleWatchEvents(events)
-		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true))
+		go handleWatchEvents(events, format)
+		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true), local.WithPolling(poll))
 		cmd.ErrCheck(err)
 		for s := range state {
 			switch s.State {
 			case cmd.Online:
+				if format == JSONFormat {
+					printJSONLine(watchEvent{Event: "online", Path: bp})
+					continue
+				}
 				cmd.Success("Watching %s for changes...", aurora.White(bp).Bold())
 			case cmd.Offline:
 				if s.Aborted {
 					cmd.Fatal(s.Err)
+				} else if format == JSONFormat {
+					printJSONLine(watchEvent{Event: "offline"})
 				} else {
 					cmd.Message("Not connected. Trying to connect...")
 				}
@@ -42,8 +52,26 @@ var watchCmd = &cobra.Command{
 	},
 }
 
-func handleWatchEvents(events chan local.Event) {
+// watchEvent is a line of JSON output from watch.
+type watchEvent struct {
+	Event string   `json:"event"`
+	Path  string   `json:"path,omitempty"`
+	Cid   *cid.Cid `json:"cid,omitempty"`
+	Size  int64    `json:"size,omitempty"`
+}
+
+func handleWatchEvents(events chan local.Event, format Format) {
 	for e := range events {
+		if format == JSONFormat {
+			switch e.Type {
+			case local.EventFileComplete:
+				c := e.Cid
+				printJSONLine(watchEvent{Event: "file_complete", Path: e.Path, Cid: &c, Size: e.Size})
+			case local.EventFileRemoved:
+				printJSONLine(watchEvent{Event: "file_removed", Path: e.Path})
+			}
+			continue
+		}
 		switch e.Type {
 		case local.EventFileComplete:
 			cmd.Message("%s: %s (%s)", aurora.Green("+ "+e.Path), e.Cid, formatBytes(e.Size, false))
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	logging "github.com/ipfs/go-log/v2"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/go-threads/util"
@@ -14,7 +16,11 @@ import (
 	"github.com/textileio/textile/v2/core"
 )
 
-const daemonName = "buckd"
+const (
+	daemonName = "buckd"
+
+	mib = 1024 * 1024
+)
 
 var (
 	log = logging.Logger(daemonName)
@@ -74,6 +80,10 @@ var (
 				Key:      "addr.gateway.url",
 				DefValue: "http://127.0.0.1:8006",
 			},
+			"addrGatewayTls": {
+				Key:      "addr.gateway.tls",
+				DefValue: "",
+			},
 			"addrIpfsApi": {
 				Key:      "addr.ipfs.api",
 				DefValue: "/ip4/127.0.0.1/tcp/5001",
@@ -90,12 +100,38 @@ var (
 				Key:      "ipns.republish_concurrency",
 				DefValue: 100,
 			},
+			"ipnsKeySecret": {
+				Key:      "ipns.key_secret",
+				DefValue: "",
+			},
 
 			// Gateway
 			"gatewaySubdomains": {
 				Key:      "gateway.subdomains",
 				DefValue: false,
 			},
+			"gatewayDomains": {
+				Key:      "gateway.domains",
+				DefValue: false,
+			},
+			"gatewayCacheSizeMb": {
+				Key:      "gateway.cache_size_mb",
+				DefValue: 256,
+			},
+
+			// ACME
+			"acmeDirectoryUrl": {
+				Key:      "acme.directory_url",
+				DefValue: "https://acme-v02.api.letsencrypt.org/directory",
+			},
+			"acmeEmail": {
+				Key:      "acme.email",
+				DefValue: "",
+			},
+			"acmeCaCert": {
+				Key:      "acme.ca_cert",
+				DefValue: "",
+			},
 
 			// Cloudflare
 			// @todo: Change these to cloudflareDnsDomain, etc.
@@ -111,6 +147,32 @@ var (
 				Key:      "dns.token",
 				DefValue: "",
 			},
+			"dnsProvider": {
+				Key:      "dns.provider",
+				DefValue: "cloudflare",
+			},
+
+			// RFC2136
+			"dnsRfc2136Server": {
+				Key:      "dns.rfc2136.server",
+				DefValue: "",
+			},
+			"dnsRfc2136Zone": {
+				Key:      "dns.rfc2136.zone",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigKey": {
+				Key:      "dns.rfc2136.tsig_key",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigSecret": {
+				Key:      "dns.rfc2136.tsig_secret",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigAlgorithm": {
+				Key:      "dns.rfc2136.tsig_algorithm",
+				DefValue: "hmac-sha256",
+			},
 		},
 		EnvPre: "BUCK",
 		Global: true,
@@ -178,6 +240,10 @@ func init() {
 		"addrGatewayUrl",
 		config.Flags["addrGatewayUrl"].DefValue.(string),
 		"Public gateway address")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayTls",
+		config.Flags["addrGatewayTls"].DefValue.(string),
+		"Local gateway TLS host address for custom domains")
 	rootCmd.PersistentFlags().String(
 		"addrIpfsApi",
 		config.Flags["addrIpfsApi"].DefValue.(string),
@@ -195,12 +261,38 @@ func init() {
 		"maxRepublishingConcurrency",
 		config.Flags["maxRepublishingConcurrency"].DefValue.(int),
 		"IPNS keys republishing batch size")
+	rootCmd.PersistentFlags().String(
+		"ipnsKeySecret",
+		config.Flags["ipnsKeySecret"].DefValue.(string),
+		"Secret for encrypting stored IPNS keys (keys are not exportable if empty)")
 
 	// Gateway
 	rootCmd.PersistentFlags().Bool(
 		"gatewaySubdomains",
 		config.Flags["gatewaySubdomains"].DefValue.(bool),
 		"Enable gateway namespace redirects to subdomains")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayDomains",
+		config.Flags["gatewayDomains"].DefValue.(bool),
+		"Enable serving bucket websites at verified custom domains")
+	rootCmd.PersistentFlags().Int(
+		"gatewayCacheSizeMb",
+		config.Flags["gatewayCacheSizeMb"].DefValue.(int),
+		"Max size in megabytes of gateway content cache (0 disables caching)")
+
+	// ACME
+	rootCmd.PersistentFlags().String(
+		"acmeDirectoryUrl",
+		config.Flags["acmeDirectoryUrl"].DefValue.(string),
+		"ACME directory URL used to issue certificates for custom domains")
+	rootCmd.PersistentFlags().String(
+		"acmeEmail",
+		config.Flags["acmeEmail"].DefValue.(string),
+		"ACME account contact email")
+	rootCmd.PersistentFlags().String(
+		"acmeCaCert",
+		config.Flags["acmeCaCert"].DefValue.(string),
+		"PEM file used to trust the ACME server, e.g., a local Pebble server")
 
 	// Cloudflare
 	rootCmd.PersistentFlags().String(
@@ -215,6 +307,32 @@ func init() {
 		"dnsToken",
 		config.Flags["dnsToken"].DefValue.(string),
 		"Cloudflare API Token for dnsDomain")
+	rootCmd.PersistentFlags().String(
+		"dnsProvider",
+		config.Flags["dnsProvider"].DefValue.(string),
+		"DNS provider for dnsDomain records. Options: [cloudflare,rfc2136,memory]")
+
+	// RFC2136
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136Server",
+		config.Flags["dnsRfc2136Server"].DefValue.(string),
+		"RFC2136 name server address (host:port) for dnsDomain")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136Zone",
+		config.Flags["dnsRfc2136Zone"].DefValue.(string),
+		"RFC2136 zone to update (defaults to dnsDomain)")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigKey",
+		config.Flags["dnsRfc2136TsigKey"].DefValue.(string),
+		"RFC2136 TSIG key name")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigSecret",
+		config.Flags["dnsRfc2136TsigSecret"].DefValue.(string),
+		"RFC2136 base64 encoded TSIG secret")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigAlgorithm",
+		config.Flags["dnsRfc2136TsigAlgorithm"].DefValue.(string),
+		"RFC2136 TSIG algorithm. Options: [hmac-sha1,hmac-sha256,hmac-sha512]")
 
 	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
 	cmd.ErrCheck(err)
@@ -260,14 +378,31 @@ var rootCmd = &cobra.Command{
 		addrThreadsMongoName := config.Viper.GetString("addr.threads.mongo_name")
 		ipnsRepublishSchedule := config.Viper.GetString("ipns.republish_schedule")
 		maxRepublishingConcurrency := config.Viper.GetInt("ipns.republish_concurrency")
+		ipnsKeySecret := config.Viper.GetString("ipns.key_secret")
 		addrGatewayHost := cmd.AddrFromStr(config.Viper.GetString("addr.gateway.host"))
 		addrGatewayUrl := config.Viper.GetString("addr.gateway.url")
+		var addrGatewayTls ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.tls"); str != "" {
+			addrGatewayTls = cmd.AddrFromStr(str)
+		}
 		addrIpfsApi := cmd.AddrFromStr(config.Viper.GetString("addr.ipfs.api"))
 		addrPowergateApi := config.Viper.GetString("addr.powergate.api")
 
 		dnsDomain := config.Viper.GetString("dns.domain")
 		dnsZoneID := config.Viper.GetString("dns.zone_id")
 		dnsToken := config.Viper.GetString("dns.token")
+		dnsProvider := config.Viper.GetString("dns.provider")
+		dnsRfc2136Server := config.Viper.GetString("dns.rfc2136.server")
+		dnsRfc2136Zone := config.Viper.GetString("dns.rfc2136.zone")
+		dnsRfc2136TsigKey := config.Viper.GetString("dns.rfc2136.tsig_key")
+		dnsRfc2136TsigSecret := config.Viper.GetString("dns.rfc2136.tsig_secret")
+		dnsRfc2136TsigAlgorithm := config.Viper.GetString("dns.rfc2136.tsig_algorithm")
+
+		// ACME
+		acmeDirectoryUrl := config.Viper.GetString("acme.directory_url")
+		acmeEmail := config.Viper.GetString("acme.email")
+		acmeCaCert := config.Viper.GetString("acme.ca_cert")
+		acmeCacheDir := filepath.Join(config.Viper.GetString("repo"), "acme")
 
 		var opts []core.Option
 		if addrThreadsMongoUri != "" {
@@ -291,15 +426,31 @@ var rootCmd = &cobra.Command{
 			AddrThreadsHost:          addrThreadsHost,
 			AddrGatewayHost:          addrGatewayHost,
 			AddrGatewayURL:           addrGatewayUrl,
+			AddrGatewayTLS:           addrGatewayTls,
 			AddrIPFSAPI:              addrIpfsApi,
 			AddrPowergateAPI:         addrPowergateApi,
 			IPNSRepublishSchedule:    ipnsRepublishSchedule,
 			IPNSRepublishConcurrency: maxRepublishingConcurrency,
+			IPNSKeySecret:            ipnsKeySecret,
 			UseSubdomains:            config.Viper.GetBool("gateway.subdomains"),
+			UseDomains:               config.Viper.GetBool("gateway.domains"),
+			GatewayCacheSize:         int64(config.Viper.GetInt("gateway.cache_size_mb")) * mib,
+
+			ACMEDirectoryURL: acmeDirectoryUrl,
+			ACMEEmail:        acmeEmail,
+			ACMECACert:       acmeCaCert,
+			ACMECacheDir:     acmeCacheDir,
+
+			DNSProvider: dnsProvider,
+			DNSDomain:   dnsDomain,
+			DNSZoneID:   dnsZoneID,
+			DNSToken:    dnsToken,
 
-			DNSDomain: dnsDomain,
-			DNSZoneID: dnsZoneID,
-			DNSToken:  dnsToken,
+			DNSRFC2136Server:        dnsRfc2136Server,
+			DNSRFC2136Zone:          dnsRfc2136Zone,
+			DNSRFC2136TSIGKey:       dnsRfc2136TsigKey,
+			DNSRFC2136TSIGSecret:    dnsRfc2136TsigSecret,
+			DNSRFC2136TSIGAlgorithm: dnsRfc2136TsigAlgorithm,
 		}, opts...)
 		cmd.ErrCheck(err)
 		textile.Bootstrap()
@@ -61,7 +61,9 @@ var (
 
 	clients *cmd.Clients
 
-	confirmTimeout = time.Hour
+	confirmTimeout     = time.Hour
+	loginPollInterval  = time.Second * 2
+	exportPollInterval = time.Second * 2
 )
 
 func Init(rootCmd *cobra.Command) {
@@ -72,6 +74,9 @@ func Init(rootCmd *cobra.Command) {
 		loginCmd,
 		logoutCmd,
 		whoamiCmd,
+		sessionsCmd,
+		twoFactorCmd,
+		exportCmd,
 		destroyCmd,
 		updateCmd,
 		versionCmd,
@@ -85,8 +90,26 @@ func Init(rootCmd *cobra.Command) {
 		// archivesCmd,
 		// retrievalsCmd,
 	)
-	orgsCmd.AddCommand(orgsCreateCmd, orgsLsCmd, orgsMembersCmd, orgsInviteCmd, orgsLeaveCmd, orgsDestroyCmd)
-	keysCmd.AddCommand(keysCreateCmd, keysInvalidateCmd, keysLsCmd)
+	orgsCmd.AddCommand(
+		orgsCreateCmd,
+		orgsLsCmd,
+		orgsMembersCmd,
+		orgsInviteCmd,
+		orgsInvitesCmd,
+		orgsTransferCmd,
+		orgsLeaveCmd,
+		orgsDestroyCmd,
+		orgsSSOCmd,
+		orgsTwoFactorCmd,
+	)
+	orgsMembersCmd.AddCommand(orgsMembersSetRoleCmd, orgsMembersRemoveCmd)
+	orgsInvitesCmd.AddCommand(orgsInvitesResendCmd, orgsInvitesRevokeCmd)
+	orgsTransferCmd.AddCommand(orgsTransferAcceptCmd)
+	orgsSSOCmd.AddCommand(orgsSSOSetCmd, orgsSSORemoveCmd)
+	orgsTwoFactorCmd.AddCommand(orgsTwoFactorRequireCmd, orgsTwoFactorOptionalCmd)
+	keysCmd.AddCommand(keysCreateCmd, keysInvalidateCmd, keysRotateCmd, keysLsCmd)
+	sessionsCmd.AddCommand(sessionsLsCmd, sessionsRevokeCmd)
+	twoFactorCmd.AddCommand(twoFactorEnableCmd, twoFactorDisableCmd)
 	threadsCmd.AddCommand(threadsLsCmd)
 	filCmd.AddCommand(filAddrsCmd, filBalanceCmd, filSignCmd, filVerifyCmd, filInfoCmd, filStorageCmd, filRetrievalsCmd)
 	billingCmd.AddCommand(billingSetupCmd, billingPortalCmd, billingUsageCmd, billingUsersCmd)
@@ -103,6 +126,29 @@ func Init(rootCmd *cobra.Command) {
 
 	rootCmd.PersistentFlags().Bool("newIdentity", false, "Generate a new user identity")
 
+	loginCmd.Flags().Bool("sso", false, "Sign on with an identity provider")
+	loginCmd.Flags().String("username", "", "Username to use if signing on creates a new account")
+	loginCmd.Flags().Bool("noBrowser", false, "Sign on in a browser on another device")
+	loginCmd.Flags().Bool("link", false, "Link an identity provider account to the logged in account")
+
+	keysCreateCmd.Flags().StringSlice("scope", nil, "Limit the key to resources and actions, e.g., buckets:read")
+	keysCreateCmd.Flags().Duration("expires", 0, "Time until the key expires, e.g., 720h")
+	keysCreateCmd.Flags().StringSlice("origin", nil, "Limit the key to browser origins")
+	keysCreateCmd.Flags().StringSlice("ip", nil, "Limit the key to IP addresses or CIDR ranges")
+	keysRotateCmd.Flags().Duration("grace", 24*time.Hour, "How long the old secret stays valid")
+
+	sessionsRevokeCmd.Flags().Bool("others", false, "Revoke all sessions except the current one")
+	sessionsRevokeCmd.Flags().Bool("all", false, "Revoke all sessions, including the current one")
+
+	orgsInviteCmd.Flags().String("username", "", "Invite an existing user by username")
+	orgsTransferCmd.Flags().Bool("cancel", false, "Cancel a pending ownership transfer")
+
+	orgsSSOSetCmd.Flags().String("clientId", "", "Client ID registered with the identity provider")
+	orgsSSOSetCmd.Flags().String("clientSecret", "", "Client secret registered with the identity provider")
+	orgsSSOSetCmd.Flags().Bool("required", false, "Require members to sign on with the identity provider")
+
+	exportCmd.Flags().String("output", "", "Path to write the export archive (default <name>-export-<date>.tar.gz)")
+
 	billingUsageCmd.Flags().StringP("user", "u", "", "User multibase encoded public key")
 
 	billingUsersCmd.Flags().Int64("limit", 25, "Page size (max 1000)")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/api/common"
 	"github.com/textileio/textile/v2/cmd"
 )
 
@@ -23,6 +24,7 @@ var destroyCmd = &cobra.Command{
 
 		cmd.Warn("%s", aurora.Red("Are you absolutely sure? This action cannot be undone."))
 		cmd.Warn("%s", aurora.Red("Your account and all associated data will be permanently deleted."))
+		cmd.Message("Use '%s' first to download a copy of your data.", aurora.Cyan("hub export"))
 		prompt := promptui.Prompt{
 			Label: fmt.Sprintf("Please type '%s' to confirm", who.Username),
 			Validate: func(s string) error {
@@ -35,6 +37,9 @@ var destroyCmd = &cobra.Command{
 		if _, err := prompt.Run(); err != nil {
 			cmd.End("")
 		}
+		if who.TwoFactorEnabled {
+			ctx = common.NewTwoFactorCodeContext(ctx, promptTwoFactorCode("Enter a two-factor or recovery code"))
+		}
 
 		err = clients.Hub.DestroyAccount(ctx)
 		cmd.ErrCheck(err)
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/caarlos0/spin"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/api/common"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export your account data",
+	Long: `Exports your Hub account data to a gzipped tarball.
+
+The archive contains your profile, org memberships, API key metadata (without secrets),
+threads, buckets as CAR files, mailbox messages, and Filecoin archive and deal history.
+Use the global '--org' flag to export an org (org owners only).
+
+If an export is already running, it's resumed instead of starting another.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		output, err := c.Flags().GetString("output")
+		cmd.ErrCheck(err)
+
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), confirmTimeout)
+		defer cancel()
+		who, err := clients.Hub.GetSessionInfo(ctx)
+		cmd.ErrCheck(err)
+		if output == "" {
+			name := who.Username
+			if org := config.Viper.GetString("org"); org != "" {
+				name = org
+			}
+			output = fmt.Sprintf("%s-export-%s.tar.gz", name, time.Now().Format("20060102"))
+		}
+
+		ectx := ctx
+		if who.TwoFactorEnabled {
+			ectx = common.NewTwoFactorCodeContext(ctx, promptTwoFactorCode("Enter a two-factor or recovery code"))
+		}
+		export, err := clients.Hub.ExportAccount(ectx)
+		cmd.ErrCheck(err)
+
+		var stage string
+		for export.Status != pb.ExportStatus_EXPORT_STATUS_COMPLETE {
+			if export.Status == pb.ExportStatus_EXPORT_STATUS_FAILED {
+				cmd.Fatal(fmt.Errorf("export failed: %s", export.Error))
+			}
+			if export.Stage != "" && export.Stage != stage {
+				stage = export.Stage
+				cmd.Message("%s", aurora.BrightBlack(fmt.Sprintf("[%d/%d] %s", export.Done+1, export.Total, stage)))
+			}
+			time.Sleep(exportPollInterval)
+			export, err = clients.Hub.GetExport(ctx, export.Id)
+			cmd.ErrCheck(err)
+		}
+
+		file, err := os.Create(output)
+		cmd.ErrCheck(err)
+		defer file.Close()
+		s := spin.New("%s Downloading export")
+		s.Start()
+		err = clients.Hub.DownloadExport(ctx, export.Id, file)
+		s.Stop()
+		if err != nil {
+			_ = os.Remove(output)
+			cmd.Fatal(err)
+		}
+		cmd.Success("Exported to %s", aurora.White(output).Bold())
+	},
+}
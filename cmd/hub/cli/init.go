@@ -45,14 +45,16 @@ var initCmd = &cobra.Command{
 			cmd.End("")
 		}
 
+		cctx, ccancel := context.WithTimeout(context.Background(), confirmTimeout)
+		defer ccancel()
+		login, err := clients.Hub.StartSignup(cctx, username, email)
+		cmd.ErrCheck(err)
+
 		cmd.Message("We sent an email to %s. Please follow the steps provided inside it.",
 			aurora.White(email).Bold())
 		s := spin.New("%s Waiting for your confirmation")
 		s.Start()
-
-		cctx, ccancel := context.WithTimeout(context.Background(), confirmTimeout)
-		defer ccancel()
-		res, err := clients.Hub.Signup(cctx, username, email)
+		res, err := pollLogin(cctx, login.LoginId)
 		s.Stop()
 		if err != nil {
 			if strings.Contains(err.Error(), "Account exists") {
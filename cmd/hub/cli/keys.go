@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/api/hubd/client"
 	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/cmd"
 )
@@ -35,9 +38,40 @@ There are two types of API keys:
 API secrets are used for Signature Authentication, which is a security measure that can prevent outsiders from using your API key. API secrets should be kept safely on a backend server, not in publicly readable client code.
 
 However, for development purposes, you may opt-out of Signature Authentication during key creation. 
+
+Keys can be restricted with the following flags:
+--scope limits the key to some resources and actions. Keys without scopes have full account access.
+  Available scopes: buckets:read, buckets:write, threads:read, threads:write, mail:read, mail:send, mail:write,
+  archives:read, archives:write, usage:read. Write scopes include the other actions for the resource.
+  Bucket scopes can be limited to a single bucket, e.g., buckets:write:<bucket-key>.
+--expires sets how long the key can be used for, e.g., 720h.
+--origin limits the key to requests from browser origins, e.g., https://example.com.
+--ip limits the key to requests from IP addresses or CIDR ranges.
 `,
 	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		var opts []client.KeyOption
+		scopes, err := c.Flags().GetStringSlice("scope")
+		cmd.ErrCheck(err)
+		if len(scopes) > 0 {
+			opts = append(opts, client.WithScopes(scopes...))
+		}
+		expires, err := c.Flags().GetDuration("expires")
+		cmd.ErrCheck(err)
+		if expires > 0 {
+			opts = append(opts, client.WithExpiry(time.Now().Add(expires)))
+		}
+		origins, err := c.Flags().GetStringSlice("origin")
+		cmd.ErrCheck(err)
+		if len(origins) > 0 {
+			opts = append(opts, client.WithAllowedOrigins(origins...))
+		}
+		ips, err := c.Flags().GetStringSlice("ip")
+		cmd.ErrCheck(err)
+		if len(ips) > 0 {
+			opts = append(opts, client.WithAllowedIPs(ips...))
+		}
+
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
 		defer cancel()
 
@@ -68,14 +102,23 @@ However, for development purposes, you may opt-out of Signature Authentication d
 			keyType = pb.KeyType_KEY_TYPE_USER
 		}
 
-		res, err := clients.Hub.CreateKey(ctx, keyType, secure)
+		res, err := clients.Hub.CreateKey(twoFactorContext(ctx), keyType, secure, opts...)
 		cmd.ErrCheck(err)
 		cmd.RenderTable([]string{
 			"key",
 			"secret",
 			"type",
-			"secure"},
-			[][]string{{res.KeyInfo.Key, res.KeyInfo.Secret, keyTypeDesc, strconv.FormatBool(secure)}},
+			"secure",
+			"scopes",
+			"expires"},
+			[][]string{{
+				res.KeyInfo.Key,
+				res.KeyInfo.Secret,
+				keyTypeDesc,
+				strconv.FormatBool(secure),
+				scopesToString(res.KeyInfo.Scopes),
+				unixToString(res.KeyInfo.ExpiresAt, "never"),
+			}},
 		)
 		cmd.Success("Created new API key and secret")
 	},
@@ -99,6 +142,41 @@ var keysInvalidateCmd = &cobra.Command{
 	},
 }
 
+var keysRotateCmd = &cobra.Command{
+	Use:   "rotate [key]",
+	Short: "Rotate an API key secret",
+	Long: `Issues a new secret for an API key. The key itself does not change.
+
+The old secret stays valid for the grace period (default 24h) so that deployed clients can be updated.
+Use a grace period of 0 to stop accepting the old secret immediately, e.g., if it was leaked.
+Only secure keys can be rotated.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		grace, err := c.Flags().GetDuration("grace")
+		cmd.ErrCheck(err)
+
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		var key string
+		if len(args) > 0 {
+			key = args[0]
+		} else {
+			key = selectKey(ctx, "Rotate key", aurora.Sprintf(
+				aurora.BrightBlack("> Rotating key {{ .Key | white | bold }}"))).Key
+		}
+
+		res, err := clients.Hub.RotateKey(twoFactorContext(ctx), key, grace)
+		cmd.ErrCheck(err)
+		cmd.RenderTable([]string{"key", "secret", "old secret expires"}, [][]string{{
+			res.KeyInfo.Key,
+			res.KeyInfo.Secret,
+			unixToString(res.KeyInfo.PreviousSecretExpiresAt, "now"),
+		}})
+		cmd.Success("Rotated key %s. Store the new secret somewhere safe.", aurora.White(res.KeyInfo.Key).Bold())
+	},
+}
+
 var keysLsCmd = &cobra.Command{
 	Use: "ls",
 	Aliases: []string{
@@ -124,9 +202,12 @@ var keysLsCmd = &cobra.Command{
 					secure,
 					strconv.FormatBool(k.Valid),
 					strconv.Itoa(int(k.Threads)),
+					scopesToString(k.Scopes),
+					unixToString(k.ExpiresAt, "never"),
+					unixToString(k.LastUsedAt, "never"),
 				}
 			}
-			cmd.RenderTable([]string{"key", "secret", "type", "secure", "valid", "threads"}, data)
+			cmd.RenderTable([]string{"key", "secret", "type", "secure", "valid", "threads", "scopes", "expires", "last used"}, data)
 		}
 		cmd.Message("Found %d keys", aurora.White(len(list.List)).Bold())
 	},
@@ -179,3 +260,17 @@ func keyTypeToString(t pb.KeyType) (s string) {
 	}
 	return
 }
+
+func scopesToString(scopes []string) string {
+	if len(scopes) == 0 {
+		return "all"
+	}
+	return strings.Join(scopes, ",")
+}
+
+func unixToString(t int64, zero string) string {
+	if t == 0 {
+		return zero
+	}
+	return time.Unix(t, 0).Format("02-Jan-06 15:04 -0700")
+}
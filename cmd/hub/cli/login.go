@@ -2,20 +2,42 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
 
 	"github.com/caarlos0/spin"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
 	"github.com/textileio/textile/v2/cmd"
+	"github.com/textileio/textile/v2/oidc"
 )
 
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login",
-	Long:  `Handles login to a Hub account.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Handles login to a Hub account.
+
+Use --sso to sign on with an identity provider instead of email. By default, the hub's identity provider is used.
+Use --org to sign on with an org's identity provider.
+
+Existing accounts must link an identity provider before signing on with it.
+Use --sso --link while logged in to link the identity provider account.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		sso, err := c.Flags().GetBool("sso")
+		cmd.ErrCheck(err)
+		if sso {
+			loginSSO(c)
+			return
+		}
+
 		prompt := promptui.Prompt{
 			Label: "Enter your username or email",
 		}
@@ -30,14 +52,180 @@ var loginCmd = &cobra.Command{
 
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), confirmTimeout)
 		defer cancel()
-		res, err := clients.Hub.Signin(ctx, usernameOrEmail)
+		login, err := clients.Hub.StartSignin(ctx, usernameOrEmail)
+		if err != nil {
+			s.Stop()
+			cmd.Fatal(err)
+		}
+		res, err := pollLogin(ctx, login.LoginId)
 		s.Stop()
 		cmd.ErrCheck(err)
+		fmt.Println(aurora.Sprintf("%s Email confirmed", aurora.Green("✔")))
+		if res.Status == pb.LoginStatus_LOGIN_STATUS_TWO_FACTOR_REQUIRED {
+			res = completeTwoFactorLogin(ctx, login.LoginId)
+		}
 		config.Viper.Set("session", res.Session)
 
 		cmd.WriteConfigToHome(config)
 
-		fmt.Println(aurora.Sprintf("%s Email confirmed", aurora.Green("✔")))
 		cmd.Success("You are now logged in. Initialize a new bucket with `%s`.", aurora.Cyan(Name+" buck init"))
 	},
 }
+
+// pollLogin waits for a pending login to complete or require a two-factor code.
+func pollLogin(ctx context.Context, loginID string) (*pb.PollLoginResponse, error) {
+	ticker := time.NewTicker(loginPollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := clients.Hub.PollLogin(ctx, loginID)
+		if err != nil {
+			return nil, err
+		}
+		if res.Status != pb.LoginStatus_LOGIN_STATUS_PENDING {
+			return res, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, errors.New("timed out waiting for your confirmation")
+		}
+	}
+}
+
+// pollSSOSignin waits for the hub gateway to receive the identity provider callback of a sign on.
+func pollSSOSignin(ctx context.Context, state string) (*pb.SSOSigninResponse, error) {
+	ticker := time.NewTicker(loginPollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := clients.Hub.SSOSignin(ctx, state, "", "")
+		if err != nil {
+			return nil, err
+		}
+		if !res.Pending {
+			return res, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, errors.New("timed out waiting for sign on")
+		}
+	}
+}
+
+// loginSSO signs on with an identity provider.
+// The provider redirects the browser to a local server, or to the hub gateway with --noBrowser.
+func loginSSO(c *cobra.Command) {
+	org, err := c.Flags().GetString("org")
+	cmd.ErrCheck(err)
+	username, err := c.Flags().GetString("username")
+	cmd.ErrCheck(err)
+	noBrowser, err := c.Flags().GetBool("noBrowser")
+	cmd.ErrCheck(err)
+	link, err := c.Flags().GetBool("link")
+	cmd.ErrCheck(err)
+
+	ctx, cancel := context.WithTimeout(Auth(context.Background()), confirmTimeout)
+	defer cancel()
+
+	getURL := func(redirectURI, challenge string) (*pb.GetSSOURLResponse, error) {
+		if link {
+			return clients.Hub.GetSSOLinkURL(ctx, org, redirectURI, challenge)
+		}
+		return clients.Hub.GetSSOURL(ctx, org, redirectURI, challenge, username)
+	}
+
+	var res *pb.SSOSigninResponse
+	if noBrowser {
+		u, err := getURL("", "")
+		cmd.ErrCheck(err)
+		cmd.Message("Open the following URL in a browser to sign on:")
+		cmd.Message("%s", aurora.Underline(u.Url))
+
+		s := spin.New("%s Waiting for you to sign on")
+		s.Start()
+		res, err = pollSSOSignin(ctx, u.State)
+		s.Stop()
+		cmd.ErrCheck(err)
+	} else {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		cmd.ErrCheck(err)
+		verifier := oidc.NewVerifier()
+		redirectURI := fmt.Sprintf("http://%s/callback", ln.Addr())
+		u, err := getURL(redirectURI, oidc.Challenge(verifier))
+		cmd.ErrCheck(err)
+
+		results := make(chan url.Values, 1)
+		server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if r.URL.Path != "/callback" || q.Get("state") != u.State {
+				http.NotFound(w, r)
+				return
+			}
+			if q.Get("error") != "" {
+				fmt.Fprintln(w, "Sign on failed. You may now close this window.")
+			} else {
+				fmt.Fprintln(w, "You have been signed on. You may now close this window!")
+			}
+			select {
+			case results <- q:
+			default:
+			}
+		})}
+		go func() {
+			_ = server.Serve(ln)
+		}()
+		defer server.Close()
+
+		if err := openBrowser(u.Url); err != nil {
+			cmd.Message("Open the following URL in a browser to sign on:")
+		} else {
+			cmd.Message("Your browser has been opened to sign on. If it didn't open, visit:")
+		}
+		cmd.Message("%s", aurora.Underline(u.Url))
+
+		s := spin.New("%s Waiting for you to sign on")
+		s.Start()
+		var q url.Values
+		select {
+		case q = <-results:
+		case <-ctx.Done():
+			s.Stop()
+			cmd.Fatal(errors.New("timed out waiting for sign on"))
+		}
+		if e := q.Get("error"); e != "" {
+			s.Stop()
+			cmd.Fatal(fmt.Errorf("sign on failed: %s %s", e, q.Get("error_description")))
+		}
+		res, err = clients.Hub.SSOSignin(ctx, u.State, q.Get("code"), verifier)
+		s.Stop()
+		cmd.ErrCheck(err)
+	}
+	fmt.Println(aurora.Sprintf("%s Signed on", aurora.Green("✔")))
+	if res.Linked {
+		cmd.Success("Your identity provider account is linked. You can now login with `%s`.", aurora.Cyan(Name+" login --sso"))
+		return
+	}
+	session := res.Session
+	if res.LoginId != "" {
+		session = completeTwoFactorLogin(ctx, res.LoginId).Session
+	}
+	config.Viper.Set("session", session)
+
+	cmd.WriteConfigToHome(config)
+
+	cmd.Success("You are now logged in. Initialize a new bucket with `%s`.", aurora.Cyan(Name+" buck init"))
+}
+
+// openBrowser opens u in the default browser.
+func openBrowser(u string) error {
+	var c *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("open", u)
+	case "windows":
+		c = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		c = exec.Command("xdg-open", u)
+	}
+	return c.Start()
+}
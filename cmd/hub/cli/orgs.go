@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/mail"
 	"strconv"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	mbase "github.com/multiformats/go-multibase"
@@ -113,26 +114,92 @@ var orgsMembersCmd = &cobra.Command{
 			for i, m := range res.OrgInfo.Members {
 				key, err := mbase.Encode(mbase.Base32, m.Key)
 				cmd.ErrCheck(err)
-				data[i] = []string{m.Username, key}
+				data[i] = []string{m.Username, key, m.Role}
 			}
-			cmd.RenderTable([]string{"username", "key"}, data)
+			cmd.RenderTable([]string{"username", "key", "role"}, data)
 		}
 		cmd.Message("Found %d members", aurora.White(len(res.OrgInfo.Members)).Bold())
+		if t := res.OrgInfo.Transfer; t != nil {
+			cmd.Message("Ownership transfer from %s to %s is pending until %s",
+				aurora.White(t.FromUsername).Bold(), aurora.White(t.ToUsername).Bold(),
+				aurora.White(unixToString(t.ExpiresAt, "")).Bold())
+		}
+	},
+}
+
+var orgsMembersSetRoleCmd = &cobra.Command{
+	Use:   "set-role [username] [role]",
+	Short: "Set an org member's role",
+	Long: `Sets the role of an organization member.
+
+Roles:
+owner: Full access, including destroying the org.
+admin: Everything except destroying the org. Admins can manage members other than owners.
+member: Invite members, manage API keys and billing, and read and write buckets and threads.
+developer: Manage API keys and read and write buckets and threads.
+billing-manager: Manage billing and read buckets and threads.
+read-only: Read buckets and threads.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		err := clients.Hub.SetOrgMemberRole(ctx, args[0], args[1])
+		cmd.ErrCheck(err)
+		cmd.Success("Set %s's role to %s in org %s", aurora.White(args[0]).Bold(),
+			aurora.White(args[1]).Bold(), aurora.White(selected.Name).Bold())
+	},
+}
+
+var orgsMembersRemoveCmd = &cobra.Command{
+	Use: "remove [username]",
+	Aliases: []string{
+		"rm",
+	},
+	Short: "Remove an org member",
+	Long:  `Removes a member from an organization. Only owners can remove other owners.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		err := clients.Hub.RemoveOrgMember(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Removed %s from org %s", aurora.White(args[0]).Bold(), aurora.White(selected.Name).Bold())
 	},
 }
 
 var orgsInviteCmd = &cobra.Command{
 	Use:   "invite",
 	Short: "Invite members to an org",
-	Long:  `Invites a new member to an organization.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Invites a new member to an organization by email.
+
+Use --username to invite an existing user. The invite is sent to the user's mailbox.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		username, err := c.Flags().GetString("username")
+		cmd.ErrCheck(err)
+
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
 		defer cancel()
 		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
 			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
 		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
 
+		if username != "" {
+			_, err = clients.Hub.InviteUserToOrg(ctx, username)
+			cmd.ErrCheck(err)
+			cmd.Success("We sent %s an invitation to the %s org", aurora.White(username).Bold(),
+				aurora.White(selected.Name).Bold())
+			return
+		}
+
 		prompt := promptui.Prompt{
 			Label: "Enter email to invite",
 			Validate: func(email string) error {
@@ -152,10 +219,142 @@ var orgsInviteCmd = &cobra.Command{
 	},
 }
 
+var orgsInvitesCmd = &cobra.Command{
+	Use:   "invites",
+	Short: "List org invites",
+	Long:  `Lists invites to an organization, including accepted invites that are waiting for the invitee to sign up.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		res, err := clients.Hub.ListInvites(ctx)
+		cmd.ErrCheck(err)
+		if len(res.List) > 0 {
+			data := make([][]string, len(res.List))
+			for i, inv := range res.List {
+				to := inv.EmailTo
+				if inv.UsernameTo != "" {
+					to = inv.UsernameTo
+				}
+				state := "pending"
+				if inv.Accepted {
+					state = "accepted"
+				} else if time.Now().Unix() > inv.ExpiresAt {
+					state = "expired"
+				}
+				data[i] = []string{
+					inv.Token,
+					to,
+					state,
+					unixToString(inv.SentAt, "-"),
+					unixToString(inv.ExpiresAt, "-"),
+				}
+			}
+			cmd.RenderTable([]string{"token", "to", "status", "sent", "expires"}, data)
+		}
+		cmd.Message("Found %d invites", aurora.White(len(res.List)).Bold())
+	},
+}
+
+var orgsInvitesResendCmd = &cobra.Command{
+	Use:   "resend [token]",
+	Short: "Resend an org invite",
+	Long:  `Sends an invite again and extends its expiry. Expired invites can be resent.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		res, err := clients.Hub.ResendInvite(ctx, args[0])
+		cmd.ErrCheck(err)
+		to := res.Invite.EmailTo
+		if res.Invite.UsernameTo != "" {
+			to = res.Invite.UsernameTo
+		}
+		cmd.Success("We sent %s the invitation to the %s org again", aurora.White(to).Bold(),
+			aurora.White(selected.Name).Bold())
+	},
+}
+
+var orgsInvitesRevokeCmd = &cobra.Command{
+	Use: "revoke [token]",
+	Aliases: []string{
+		"rm",
+	},
+	Short: "Revoke an org invite",
+	Long:  `Revokes an invite so that it can't be accepted. Only admins and owners can revoke invites sent by others.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		err := clients.Hub.RevokeInvite(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Revoked invite %s", aurora.White(args[0]).Bold())
+	},
+}
+
+var orgsTransferCmd = &cobra.Command{
+	Use:   "transfer [username]",
+	Short: "Transfer org ownership",
+	Long: `Offers ownership of an organization to another user.
+
+The recipient becomes an owner when they accept the transfer with 'hub orgs transfer accept'.
+You remain a member of the org as an admin.`,
+	Args: cobra.RangeArgs(0, 1),
+	Run: func(c *cobra.Command, args []string) {
+		cancelTransfer, err := c.Flags().GetBool("cancel")
+		cmd.ErrCheck(err)
+		if !cancelTransfer && len(args) == 0 {
+			cmd.Fatal(fmt.Errorf("you must provide a username or the --cancel flag"))
+		}
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		if cancelTransfer {
+			_, err := clients.Hub.TransferOrgOwnership(ctx, "")
+			cmd.ErrCheck(err)
+			cmd.Success("Canceled ownership transfer of org %s", aurora.White(selected.Name).Bold())
+			return
+		}
+		res, err := clients.Hub.TransferOrgOwnership(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Offered ownership of org %s to %s until %s", aurora.White(selected.Name).Bold(),
+			aurora.White(args[0]).Bold(), aurora.White(unixToString(res.ExpiresAt, "")).Bold())
+	},
+}
+
+var orgsTransferAcceptCmd = &cobra.Command{
+	Use:   "accept [org]",
+	Short: "Accept org ownership",
+	Long:  `Accepts a pending ownership transfer of an organization.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		res, err := clients.Hub.AcceptOrgOwnership(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("You are now an owner of org %s", aurora.White(res.OrgInfo.Name).Bold())
+	},
+}
+
 var orgsLeaveCmd = &cobra.Command{
 	Use:   "leave",
 	Short: "Leave an org",
-	Long:  `Leaves an organization.`,
+	Long:  `Leaves an organization. The last owner must transfer ownership or destroy the org instead.`,
 	Args:  cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
@@ -173,7 +372,7 @@ var orgsLeaveCmd = &cobra.Command{
 var orgsDestroyCmd = &cobra.Command{
 	Use:   "destroy",
 	Short: "Destroy an org",
-	Long:  `Destroys an organization and all associated data. You must be the org owner.`,
+	Long:  `Destroys an organization and all associated data. You must be an org owner.`,
 	Args:  cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
@@ -197,12 +396,145 @@ var orgsDestroyCmd = &cobra.Command{
 			cmd.End("")
 		}
 
-		err := clients.Hub.RemoveOrg(ctx)
+		err := clients.Hub.RemoveOrg(twoFactorContext(ctx))
 		cmd.ErrCheck(err)
 		cmd.Success("Org %s has been deleted", aurora.White(selected.Name).Bold())
 	},
 }
 
+var orgsTwoFactorCmd = &cobra.Command{
+	Use:   "2fa",
+	Short: "Show org two-factor authentication",
+	Long:  `Shows whether members must use two-factor authentication to access an organization.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		res, err := clients.Hub.GetOrg(ctx)
+		cmd.ErrCheck(err)
+		if res.OrgInfo.TwoFactorRequired {
+			cmd.Message("Org %s requires two-factor authentication", aurora.White(selected.Name).Bold())
+		} else {
+			cmd.Message("Org %s does not require two-factor authentication", aurora.White(selected.Name).Bold())
+		}
+	},
+}
+
+var orgsTwoFactorRequireCmd = &cobra.Command{
+	Use:   "require",
+	Short: "Require org two-factor authentication",
+	Long: `Requires members to enable two-factor authentication to access an organization (org owners only).
+
+You must enable two-factor authentication for your own account first.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		setOrgTwoFactor(true)
+	},
+}
+
+var orgsTwoFactorOptionalCmd = &cobra.Command{
+	Use:   "optional",
+	Short: "Make org two-factor authentication optional",
+	Long:  `Stops requiring members to use two-factor authentication to access an organization (org owners only).`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		setOrgTwoFactor(false)
+	},
+}
+
+func setOrgTwoFactor(required bool) {
+	ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+	defer cancel()
+	selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+		aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+	ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+	err := clients.Hub.SetOrgTwoFactor(ctx, required)
+	cmd.ErrCheck(err)
+	if required {
+		cmd.Success("Org %s now requires two-factor authentication", aurora.White(selected.Name).Bold())
+	} else {
+		cmd.Success("Two-factor authentication is optional for org %s", aurora.White(selected.Name).Bold())
+	}
+}
+
+var orgsSSOCmd = &cobra.Command{
+	Use:   "sso",
+	Short: "Show org single sign-on",
+	Long:  `Shows the identity provider used for single sign-on to an organization.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		res, err := clients.Hub.GetOrgSSO(ctx)
+		cmd.ErrCheck(err)
+		if res.Issuer == "" {
+			cmd.End("Org %s does not use single sign-on", aurora.White(selected.Name).Bold())
+		}
+		cmd.RenderTable([]string{"issuer", "client id", "required"}, [][]string{
+			{res.Issuer, res.ClientId, strconv.FormatBool(res.Required)},
+		})
+	},
+}
+
+var orgsSSOSetCmd = &cobra.Command{
+	Use:   "set [issuer]",
+	Short: "Set org single sign-on",
+	Long: `Sets the OpenID Connect identity provider used for single sign-on to an organization (org owners only).
+
+Register the hub gateway's /sso/callback URL and loopback addresses as redirect URIs with the provider.
+Members with a pending invite can join the org by signing on with the provider.
+With --required, members (except owners) must sign on with the provider to access the org.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		clientID, err := c.Flags().GetString("clientId")
+		cmd.ErrCheck(err)
+		clientSecret, err := c.Flags().GetString("clientSecret")
+		cmd.ErrCheck(err)
+		required, err := c.Flags().GetBool("required")
+		cmd.ErrCheck(err)
+
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		err = clients.Hub.SetOrgSSO(ctx, args[0], clientID, clientSecret, required)
+		cmd.ErrCheck(err)
+		cmd.Success("Set single sign-on for org %s", aurora.White(selected.Name).Bold())
+	},
+}
+
+var orgsSSORemoveCmd = &cobra.Command{
+	Use: "rm",
+	Aliases: []string{
+		"remove",
+	},
+	Short: "Remove org single sign-on",
+	Long:  `Removes single sign-on from an organization (org owners only).`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		selected := selectOrg(ctx, "Select org", aurora.Sprintf(
+			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
+		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
+
+		err := clients.Hub.SetOrgSSO(ctx, "", "", "", false)
+		cmd.ErrCheck(err)
+		cmd.Success("Removed single sign-on from org %s", aurora.White(selected.Name).Bold())
+	},
+}
+
 type orgItem struct {
 	Name    string
 	Slug    string
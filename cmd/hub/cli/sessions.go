@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var sessionsCmd = &cobra.Command{
+	Use: "sessions",
+	Aliases: []string{
+		"session",
+	},
+	Short: "Session management",
+	Long:  `Manages your active login sessions.`,
+	Args:  cobra.ExactArgs(0),
+}
+
+var sessionsLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List your sessions",
+	Long:  `Lists your active login sessions. The current session is marked with an asterisk.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		list, err := clients.Hub.ListSessions(ctx)
+		cmd.ErrCheck(err)
+		if len(list.List) > 0 {
+			data := make([][]string, len(list.List))
+			for i, s := range list.List {
+				id := s.Id
+				if s.Current {
+					id += "*"
+				}
+				data[i] = []string{
+					id,
+					s.UserAgent,
+					s.Ip,
+					unixToString(s.CreatedAt, "unknown"),
+					unixToString(s.LastSeenAt, "unknown"),
+					unixToString(s.ExpiresAt, ""),
+				}
+			}
+			cmd.RenderTable([]string{"id", "user agent", "ip", "created", "last seen", "expires"}, data)
+		}
+		cmd.Message("Found %d sessions", aurora.White(len(list.List)).Bold())
+	},
+}
+
+var sessionsRevokeCmd = &cobra.Command{
+	Use: "revoke [id]",
+	Aliases: []string{
+		"rm",
+	},
+	Short: "Revoke sessions",
+	Long: `Revokes a login session, signing out the device that uses it.
+
+Use '--others' to sign out everywhere except this device, or '--all' to sign out everywhere.`,
+	Args: cobra.RangeArgs(0, 1),
+	Run: func(c *cobra.Command, args []string) {
+		all, err := c.Flags().GetBool("all")
+		cmd.ErrCheck(err)
+		others, err := c.Flags().GetBool("others")
+		cmd.ErrCheck(err)
+		if all && others {
+			cmd.Fatal(fmt.Errorf("--all and --others cannot be used together"))
+		}
+		if !all && !others && len(args) == 0 {
+			cmd.Fatal(fmt.Errorf("you must provide a session id, --others, or --all"))
+		}
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		switch {
+		case all:
+			err := clients.Hub.RevokeAllSessions(ctx, false)
+			cmd.ErrCheck(err)
+			_ = os.RemoveAll(config.Viper.ConfigFileUsed())
+			cmd.Success("Signed out everywhere")
+		case others:
+			err := clients.Hub.RevokeAllSessions(ctx, true)
+			cmd.ErrCheck(err)
+			cmd.Success("Signed out of all other sessions")
+		default:
+			err := clients.Hub.RevokeSession(ctx, args[0])
+			cmd.ErrCheck(err)
+			cmd.Success("Revoked session %s", aurora.White(args[0]).Bold())
+		}
+	},
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/v2/api/common"
+	pb "github.com/textileio/textile/v2/api/hubd/pb"
+	"github.com/textileio/textile/v2/cmd"
+)
+
+var twoFactorCmd = &cobra.Command{
+	Use:   "2fa",
+	Short: "Two-factor authentication",
+	Long: `Manages two-factor authentication for your account.
+
+With two-factor authentication enabled, login and sensitive actions, like creating API keys
+and destroying orgs or your account, require a code from an authenticator app.`,
+	Args: cobra.ExactArgs(0),
+}
+
+var twoFactorEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Enable two-factor authentication",
+	Long:  `Enables two-factor authentication with an authenticator app, like Google Authenticator or 1Password.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), confirmTimeout)
+		defer cancel()
+
+		res, err := clients.Hub.EnableTwoFactor(ctx)
+		cmd.ErrCheck(err)
+		cmd.Message("Add the following secret to your authenticator app:")
+		cmd.Message("%s", aurora.White(res.Secret).Bold())
+		cmd.Message("Or, open or scan this URL as a QR code:")
+		cmd.Message("%s", aurora.Underline(res.Url))
+
+		code := promptTwoFactorCode("Enter the code from your authenticator app")
+		confirmed, err := clients.Hub.ConfirmTwoFactor(ctx, code)
+		cmd.ErrCheck(err)
+
+		cmd.Warn("%s", aurora.Red("Save these recovery codes somewhere safe. Each can be used once in place of a code."))
+		cmd.Message("%s", strings.Join(confirmed.RecoveryCodes, "\n"))
+		cmd.Success("Two-factor authentication is enabled")
+	},
+}
+
+var twoFactorDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable two-factor authentication",
+	Long:  `Disables two-factor authentication. You can't access orgs that require it while it's disabled.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), confirmTimeout)
+		defer cancel()
+
+		code := promptTwoFactorCode("Enter a two-factor or recovery code")
+		err := clients.Hub.DisableTwoFactor(ctx, code)
+		cmd.ErrCheck(err)
+		cmd.Success("Two-factor authentication is disabled")
+	},
+}
+
+// promptTwoFactorCode prompts for a two-factor code.
+func promptTwoFactorCode(label string) string {
+	prompt := promptui.Prompt{
+		Label: label,
+		Validate: func(s string) error {
+			if strings.TrimSpace(s) == "" {
+				return fmt.Errorf("")
+			}
+			return nil
+		},
+	}
+	code, err := prompt.Run()
+	if err != nil {
+		cmd.End("")
+	}
+	return strings.TrimSpace(code)
+}
+
+// twoFactorContext prompts for a two-factor code if the session user has enabled two-factor authentication,
+// and adds it to ctx.
+func twoFactorContext(ctx context.Context) context.Context {
+	who, err := clients.Hub.GetSessionInfo(ctx)
+	cmd.ErrCheck(err)
+	if !who.TwoFactorEnabled {
+		return ctx
+	}
+	return common.NewTwoFactorCodeContext(ctx, promptTwoFactorCode("Enter a two-factor or recovery code"))
+}
+
+// completeTwoFactorLogin completes a login that requires a two-factor code.
+func completeTwoFactorLogin(ctx context.Context, loginID string) *pb.PollLoginResponse {
+	code := promptTwoFactorCode("Enter a two-factor or recovery code")
+	res, err := clients.Hub.PollLogin(common.NewTwoFactorCodeContext(ctx, code), loginID)
+	cmd.ErrCheck(err)
+	return res
+}
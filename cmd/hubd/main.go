@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	logging "github.com/ipfs/go-log/v2"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/go-threads/util"
@@ -52,6 +55,10 @@ var (
 				Key:      "addr.api_proxy",
 				DefValue: "/ip4/127.0.0.1/tcp/3007",
 			},
+			"addrTrustedProxies": {
+				Key:      "addr.trusted_proxies",
+				DefValue: "",
+			},
 			"addrMongoUri": {
 				Key:      "addr.mongo_uri",
 				DefValue: "mongodb://127.0.0.1:27017",
@@ -80,6 +87,10 @@ var (
 				Key:      "addr.gateway.url",
 				DefValue: "http://127.0.0.1:8006",
 			},
+			"addrGatewayTls": {
+				Key:      "addr.gateway.tls",
+				DefValue: "",
+			},
 			"addrIpfsApi": {
 				Key:      "addr.ipfs.api",
 				DefValue: "/ip4/127.0.0.1/tcp/5001",
@@ -130,12 +141,38 @@ var (
 				Key:      "ipns.republish_concurrency",
 				DefValue: 100,
 			},
+			"ipnsKeySecret": {
+				Key:      "ipns.key_secret",
+				DefValue: "",
+			},
 
 			// Gateway
 			"gatewaySubdomains": {
 				Key:      "gateway.subdomains",
 				DefValue: false,
 			},
+			"gatewayDomains": {
+				Key:      "gateway.domains",
+				DefValue: false,
+			},
+			"gatewayCacheSizeMb": {
+				Key:      "gateway.cache_size_mb",
+				DefValue: 256,
+			},
+
+			// ACME
+			"acmeDirectoryUrl": {
+				Key:      "acme.directory_url",
+				DefValue: "https://acme-v02.api.letsencrypt.org/directory",
+			},
+			"acmeEmail": {
+				Key:      "acme.email",
+				DefValue: "",
+			},
+			"acmeCaCert": {
+				Key:      "acme.ca_cert",
+				DefValue: "",
+			},
 
 			// Cloudflare
 			"dnsDomain": {
@@ -150,6 +187,62 @@ var (
 				Key:      "dns.token",
 				DefValue: "",
 			},
+			"dnsProvider": {
+				Key:      "dns.provider",
+				DefValue: "cloudflare",
+			},
+
+			// RFC2136
+			"dnsRfc2136Server": {
+				Key:      "dns.rfc2136.server",
+				DefValue: "",
+			},
+			"dnsRfc2136Zone": {
+				Key:      "dns.rfc2136.zone",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigKey": {
+				Key:      "dns.rfc2136.tsig_key",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigSecret": {
+				Key:      "dns.rfc2136.tsig_secret",
+				DefValue: "",
+			},
+			"dnsRfc2136TsigAlgorithm": {
+				Key:      "dns.rfc2136.tsig_algorithm",
+				DefValue: "hmac-sha256",
+			},
+
+			// Email
+			"emailSender": {
+				Key:      "email.sender",
+				DefValue: "customerio",
+			},
+			"emailFrom": {
+				Key:      "email.from",
+				DefValue: "",
+			},
+			"emailTemplatesDir": {
+				Key:      "email.templates_dir",
+				DefValue: "",
+			},
+			"emailFileDir": {
+				Key:      "email.file_dir",
+				DefValue: "",
+			},
+			"emailSmtpAddr": {
+				Key:      "email.smtp.addr",
+				DefValue: "",
+			},
+			"emailSmtpUsername": {
+				Key:      "email.smtp.username",
+				DefValue: "",
+			},
+			"emailSmtpPassword": {
+				Key:      "email.smtp.password",
+				DefValue: "",
+			},
 
 			// Customer.io
 			"customerioApiKey": {
@@ -168,6 +261,26 @@ var (
 				Key:      "email.session_secret",
 				DefValue: "",
 			},
+
+			// Single sign-on
+			"ssoIssuer": {
+				Key:      "sso.issuer",
+				DefValue: "",
+			},
+			"ssoClientId": {
+				Key:      "sso.client_id",
+				DefValue: "",
+			},
+			"ssoClientSecret": {
+				Key:      "sso.client_secret",
+				DefValue: "",
+			},
+
+			// Exports
+			"exportDir": {
+				Key:      "exports.dir",
+				DefValue: "",
+			},
 		},
 		EnvPre: "HUB",
 		Global: true,
@@ -207,6 +320,10 @@ func init() {
 		"addrApiProxy",
 		config.Flags["addrApiProxy"].DefValue.(string),
 		"Hub API proxy listen address")
+	rootCmd.PersistentFlags().String(
+		"addrTrustedProxies",
+		config.Flags["addrTrustedProxies"].DefValue.(string),
+		"Comma-separated IPs or CIDRs of proxies whose X-Forwarded-For headers are trusted")
 	rootCmd.PersistentFlags().String(
 		"addrMongoUri",
 		config.Flags["addrMongoUri"].DefValue.(string),
@@ -235,6 +352,10 @@ func init() {
 		"addrGatewayUrl",
 		config.Flags["addrGatewayUrl"].DefValue.(string),
 		"Public gateway address")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayTls",
+		config.Flags["addrGatewayTls"].DefValue.(string),
+		"Local gateway TLS host address for custom domains")
 	rootCmd.PersistentFlags().String(
 		"addrIpfsApi",
 		config.Flags["addrIpfsApi"].DefValue.(string),
@@ -286,12 +407,38 @@ func init() {
 		"maxRepublishingConcurrency",
 		config.Flags["maxRepublishingConcurrency"].DefValue.(int),
 		"IPNS keys republishing batch size")
+	rootCmd.PersistentFlags().String(
+		"ipnsKeySecret",
+		config.Flags["ipnsKeySecret"].DefValue.(string),
+		"Secret for encrypting stored IPNS keys (keys are not exportable if empty)")
 
 	// Gateway
 	rootCmd.PersistentFlags().Bool(
 		"gatewaySubdomains",
 		config.Flags["gatewaySubdomains"].DefValue.(bool),
 		"Enable gateway namespace redirects to subdomains")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayDomains",
+		config.Flags["gatewayDomains"].DefValue.(bool),
+		"Enable serving bucket websites at verified custom domains")
+	rootCmd.PersistentFlags().Int(
+		"gatewayCacheSizeMb",
+		config.Flags["gatewayCacheSizeMb"].DefValue.(int),
+		"Max size in megabytes of gateway content cache (0 disables caching)")
+
+	// ACME
+	rootCmd.PersistentFlags().String(
+		"acmeDirectoryUrl",
+		config.Flags["acmeDirectoryUrl"].DefValue.(string),
+		"ACME directory URL used to issue certificates for custom domains")
+	rootCmd.PersistentFlags().String(
+		"acmeEmail",
+		config.Flags["acmeEmail"].DefValue.(string),
+		"ACME account contact email")
+	rootCmd.PersistentFlags().String(
+		"acmeCaCert",
+		config.Flags["acmeCaCert"].DefValue.(string),
+		"PEM file used to trust the ACME server, e.g., a local Pebble server")
 
 	// Cloudflare
 	// @todo: Change these to cloudflareDnsDomain, etc.
@@ -307,6 +454,62 @@ func init() {
 		"dnsToken",
 		config.Flags["dnsToken"].DefValue.(string),
 		"Cloudflare API Token for dnsDomain")
+	rootCmd.PersistentFlags().String(
+		"dnsProvider",
+		config.Flags["dnsProvider"].DefValue.(string),
+		"DNS provider for dnsDomain records. Options: [cloudflare,rfc2136,memory]")
+
+	// RFC2136
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136Server",
+		config.Flags["dnsRfc2136Server"].DefValue.(string),
+		"RFC2136 name server address (host:port) for dnsDomain")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136Zone",
+		config.Flags["dnsRfc2136Zone"].DefValue.(string),
+		"RFC2136 zone to update (defaults to dnsDomain)")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigKey",
+		config.Flags["dnsRfc2136TsigKey"].DefValue.(string),
+		"RFC2136 TSIG key name")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigSecret",
+		config.Flags["dnsRfc2136TsigSecret"].DefValue.(string),
+		"RFC2136 base64 encoded TSIG secret")
+	rootCmd.PersistentFlags().String(
+		"dnsRfc2136TsigAlgorithm",
+		config.Flags["dnsRfc2136TsigAlgorithm"].DefValue.(string),
+		"RFC2136 TSIG algorithm. Options: [hmac-sha1,hmac-sha256,hmac-sha512]")
+
+	// Email
+	rootCmd.PersistentFlags().String(
+		"emailSender",
+		config.Flags["emailSender"].DefValue.(string),
+		"Email sender. Options: [customerio,smtp,file,none]")
+	rootCmd.PersistentFlags().String(
+		"emailFrom",
+		config.Flags["emailFrom"].DefValue.(string),
+		"Email from address")
+	rootCmd.PersistentFlags().String(
+		"emailTemplatesDir",
+		config.Flags["emailTemplatesDir"].DefValue.(string),
+		"Directory of email templates that override the built-in templates")
+	rootCmd.PersistentFlags().String(
+		"emailFileDir",
+		config.Flags["emailFileDir"].DefValue.(string),
+		"Directory to write emails to with the file sender (emails are only logged if empty)")
+	rootCmd.PersistentFlags().String(
+		"emailSmtpAddr",
+		config.Flags["emailSmtpAddr"].DefValue.(string),
+		"SMTP server address (host:port)")
+	rootCmd.PersistentFlags().String(
+		"emailSmtpUsername",
+		config.Flags["emailSmtpUsername"].DefValue.(string),
+		"SMTP username")
+	rootCmd.PersistentFlags().String(
+		"emailSmtpPassword",
+		config.Flags["emailSmtpPassword"].DefValue.(string),
+		"SMTP password")
 
 	// Customer.io
 	rootCmd.PersistentFlags().String(
@@ -327,6 +530,26 @@ func init() {
 		config.Flags["emailSessionSecret"].DefValue.(string),
 		"Session secret to use when testing email APIs")
 
+	// Single sign-on
+	rootCmd.PersistentFlags().String(
+		"ssoIssuer",
+		config.Flags["ssoIssuer"].DefValue.(string),
+		"OpenID Connect issuer URL of the default identity provider")
+	rootCmd.PersistentFlags().String(
+		"ssoClientId",
+		config.Flags["ssoClientId"].DefValue.(string),
+		"OpenID Connect client ID registered with the default identity provider")
+	rootCmd.PersistentFlags().String(
+		"ssoClientSecret",
+		config.Flags["ssoClientSecret"].DefValue.(string),
+		"OpenID Connect client secret registered with the default identity provider")
+
+	// Exports
+	rootCmd.PersistentFlags().String(
+		"exportDir",
+		config.Flags["exportDir"].DefValue.(string),
+		"Directory of account export archives, which must be shared by all hub replicas (defaults to exports in the repo)")
+
 	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
 	cmd.ErrCheck(err)
 }
@@ -365,6 +588,12 @@ var rootCmd = &cobra.Command{
 		// Addresses
 		addrApi := cmd.AddrFromStr(config.Viper.GetString("addr.api"))
 		addrApiProxy := cmd.AddrFromStr(config.Viper.GetString("addr.api_proxy"))
+		var addrTrustedProxies []string
+		for _, p := range strings.Split(config.Viper.GetString("addr.trusted_proxies"), ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				addrTrustedProxies = append(addrTrustedProxies, p)
+			}
+		}
 		addrMongoUri := config.Viper.GetString("addr.mongo_uri")
 		addrMongoName := config.Viper.GetString("addr.mongo_name")
 		addrThreadsHost := cmd.AddrFromStr(config.Viper.GetString("addr.threads.host"))
@@ -372,6 +601,10 @@ var rootCmd = &cobra.Command{
 		addrThreadsMongoName := config.Viper.GetString("addr.threads.mongo_name")
 		addrGatewayHost := cmd.AddrFromStr(config.Viper.GetString("addr.gateway.host"))
 		addrGatewayUrl := config.Viper.GetString("addr.gateway.url")
+		var addrGatewayTls ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.tls"); str != "" {
+			addrGatewayTls = cmd.AddrFromStr(str)
+		}
 		addrIpfsApi := cmd.AddrFromStr(config.Viper.GetString("addr.ipfs.api"))
 		addrBillingApi := config.Viper.GetString("addr.billing.api")
 		addrPowergateApi := config.Viper.GetString("addr.powergate.api")
@@ -392,14 +625,44 @@ var rootCmd = &cobra.Command{
 		// IPNS
 		ipnsRepublishSchedule := config.Viper.GetString("ipns.republish_schedule")
 		maxRepublishingConcurrency := config.Viper.GetInt("ipns.republish_concurrency")
+		ipnsKeySecret := config.Viper.GetString("ipns.key_secret")
 
 		// Gateway
 		gatewaySubdomains := config.Viper.GetBool("gateway.subdomains")
+		gatewayDomains := config.Viper.GetBool("gateway.domains")
+		gatewayCacheSize := int64(config.Viper.GetInt("gateway.cache_size_mb")) * mib
+
+		// ACME
+		acmeDirectoryUrl := config.Viper.GetString("acme.directory_url")
+		acmeEmail := config.Viper.GetString("acme.email")
+		acmeCaCert := config.Viper.GetString("acme.ca_cert")
+		acmeCacheDir := filepath.Join(config.Viper.GetString("repo"), "acme")
+
+		// Exports
+		exportDir := config.Viper.GetString("exports.dir")
+		if exportDir == "" {
+			exportDir = filepath.Join(config.Viper.GetString("repo"), "exports")
+		}
 
 		// Cloudflare
 		dnsDomain := config.Viper.GetString("dns.domain")
 		dnsZoneID := config.Viper.GetString("dns.zone_id")
 		dnsToken := config.Viper.GetString("dns.token")
+		dnsProvider := config.Viper.GetString("dns.provider")
+		dnsRfc2136Server := config.Viper.GetString("dns.rfc2136.server")
+		dnsRfc2136Zone := config.Viper.GetString("dns.rfc2136.zone")
+		dnsRfc2136TsigKey := config.Viper.GetString("dns.rfc2136.tsig_key")
+		dnsRfc2136TsigSecret := config.Viper.GetString("dns.rfc2136.tsig_secret")
+		dnsRfc2136TsigAlgorithm := config.Viper.GetString("dns.rfc2136.tsig_algorithm")
+
+		// Email
+		emailSender := config.Viper.GetString("email.sender")
+		emailFrom := config.Viper.GetString("email.from")
+		emailTemplatesDir := config.Viper.GetString("email.templates_dir")
+		emailFileDir := config.Viper.GetString("email.file_dir")
+		emailSmtpAddr := config.Viper.GetString("email.smtp.addr")
+		emailSmtpUsername := config.Viper.GetString("email.smtp.username")
+		emailSmtpPassword := config.Viper.GetString("email.smtp.password")
 
 		// Customer.io
 		customerioApiKey := config.Viper.GetString("customerio.api_key")
@@ -407,6 +670,11 @@ var rootCmd = &cobra.Command{
 		customerioInviteTmpl := config.Viper.GetString("customerio.invite_template")
 		emailSessionSecret := config.Viper.GetString("email.session_secret")
 
+		// Single sign-on
+		ssoIssuer := config.Viper.GetString("sso.issuer")
+		ssoClientId := config.Viper.GetString("sso.client_id")
+		ssoClientSecret := config.Viper.GetString("sso.client_secret")
+
 		var opts []core.Option
 		if addrThreadsMongoUri != "" {
 			if addrThreadsMongoName == "" {
@@ -425,11 +693,13 @@ var rootCmd = &cobra.Command{
 			// Addresses
 			AddrAPI:          addrApi,
 			AddrAPIProxy:     addrApiProxy,
+			TrustedProxies:   addrTrustedProxies,
 			AddrMongoURI:     addrMongoUri,
 			AddrMongoName:    addrMongoName,
 			AddrThreadsHost:  addrThreadsHost,
 			AddrGatewayHost:  addrGatewayHost,
 			AddrGatewayURL:   addrGatewayUrl,
+			AddrGatewayTLS:   addrGatewayTls,
 			AddrIPFSAPI:      addrIpfsApi,
 			AddrBillingAPI:   addrBillingApi,
 			AddrPowergateAPI: addrPowergateApi,
@@ -445,17 +715,46 @@ var rootCmd = &cobra.Command{
 			// IPNS
 			IPNSRepublishSchedule:    ipnsRepublishSchedule,
 			IPNSRepublishConcurrency: maxRepublishingConcurrency,
+			IPNSKeySecret:            ipnsKeySecret,
 			// Gateway
-			UseSubdomains: gatewaySubdomains,
+			UseSubdomains:    gatewaySubdomains,
+			UseDomains:       gatewayDomains,
+			GatewayCacheSize: gatewayCacheSize,
+			// ACME
+			ACMEDirectoryURL: acmeDirectoryUrl,
+			ACMEEmail:        acmeEmail,
+			ACMECACert:       acmeCaCert,
+			ACMECacheDir:     acmeCacheDir,
 			// Cloudflare
-			DNSDomain: dnsDomain,
-			DNSZoneID: dnsZoneID,
-			DNSToken:  dnsToken,
+			DNSProvider: dnsProvider,
+			DNSDomain:   dnsDomain,
+			DNSZoneID:   dnsZoneID,
+			DNSToken:    dnsToken,
+			// RFC2136
+			DNSRFC2136Server:        dnsRfc2136Server,
+			DNSRFC2136Zone:          dnsRfc2136Zone,
+			DNSRFC2136TSIGKey:       dnsRfc2136TsigKey,
+			DNSRFC2136TSIGSecret:    dnsRfc2136TsigSecret,
+			DNSRFC2136TSIGAlgorithm: dnsRfc2136TsigAlgorithm,
+			// Email
+			EmailSender:       emailSender,
+			EmailFrom:         emailFrom,
+			EmailTemplatesDir: emailTemplatesDir,
+			EmailFileDir:      emailFileDir,
+			EmailSMTPAddr:     emailSmtpAddr,
+			EmailSMTPUsername: emailSmtpUsername,
+			EmailSMTPPassword: emailSmtpPassword,
 			// Customer.io
 			CustomerioConfirmTmpl: customerioConfirmTmpl,
 			CustomerioInviteTmpl:  customerioInviteTmpl,
 			CustomerioAPIKey:      customerioApiKey,
 			EmailSessionSecret:    emailSessionSecret,
+			// Single sign-on
+			SSOIssuer:       ssoIssuer,
+			SSOClientID:     ssoClientId,
+			SSOClientSecret: ssoClientSecret,
+			// Exports
+			ExportDir: exportDir,
 		}, opts...)
 		cmd.ErrCheck(err)
 		textile.Bootstrap()
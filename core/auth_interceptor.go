@@ -15,6 +15,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// apiKeyTouchInterval limits how often an API key's last used time is updated.
+var apiKeyTouchInterval = time.Minute
+
 // authFunc is used by hubd's authentication interceptor.
 func (t *Textile) authFunc(ctx context.Context) (context.Context, error) {
 	method, _ := grpc.Method(ctx)
@@ -100,6 +103,24 @@ func (t *Textile) newAuthCtx(ctx context.Context, method string, touchSession bo
 				if err != nil {
 					return ctx, status.Error(codes.NotFound, "Org not found")
 				}
+				// Owners are exempt so they can't be locked out by a misconfigured provider.
+				// The client ID is matched too, since the hub or another org may use the same issuer.
+				if org.SSO != nil && org.SSO.Required &&
+					(session.SSOIssuer != org.SSO.Issuer || session.SSOClientID != org.SSO.ClientID) {
+					isOwner, err := t.collections.Accounts.IsOwner(ctx, orgSlug, dev.Key)
+					if err != nil {
+						return ctx, err
+					}
+					if !isOwner {
+						return ctx, status.Error(codes.PermissionDenied, "Org requires single sign-on")
+					}
+				}
+				if org.RequireTwoFactor && !dev.TwoFactorEnabled() {
+					return ctx, status.Error(codes.PermissionDenied, "Org requires two-factor authentication")
+				}
+				if err := checkOrgRole(org, dev.Key, method); err != nil {
+					return ctx, err
+				}
 				ctx = common.NewOrgSlugContext(ctx, orgSlug)
 				ctx = thread.NewTokenContext(ctx, org.Token)
 			}
@@ -112,6 +133,14 @@ func (t *Textile) newAuthCtx(ctx context.Context, method string, touchSession bo
 		if err != nil || !key.Valid {
 			return ctx, status.Error(codes.NotFound, "API key not found or is invalid")
 		}
+		if err := checkAPIKey(ctx, key, method); err != nil {
+			return ctx, err
+		}
+		if touchSession && time.Since(key.LastUsedAt) > apiKeyTouchInterval {
+			if err := t.collections.APIKeys.Touch(ctx, key.Key); err != nil {
+				return ctx, err
+			}
+		}
 		ctx = common.NewAPIKeyContext(ctx, k)
 		if key.Secure {
 			msg, sig, ok := common.APISigFromMD(ctx)
@@ -120,7 +149,15 @@ func (t *Textile) newAuthCtx(ctx context.Context, method string, touchSession bo
 			} else {
 				ctx = common.NewAPISigContext(ctx, msg, sig)
 				if !common.ValidateAPISigContext(ctx, key.Secret) {
-					return ctx, status.Error(codes.Unauthenticated, "Bad API key signature")
+					// Clients may still be using the secret from before the last rotation
+					if !key.PreviousSecretValid() || !common.ValidateAPISigContext(ctx, key.PreviousSecret) {
+						return ctx, status.Error(codes.Unauthenticated, "Bad API key signature")
+					}
+					if touchSession && time.Since(key.PreviousSecretLastUsedAt) > apiKeyTouchInterval {
+						if err := t.collections.APIKeys.TouchPreviousSecret(ctx, key.Key); err != nil {
+							return ctx, err
+						}
+					}
 				}
 			}
 		}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	grpcm "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -46,10 +47,12 @@ import (
 	"github.com/textileio/textile/v2/buckets/archive/retrieval"
 	"github.com/textileio/textile/v2/buckets/archive/tracker"
 	"github.com/textileio/textile/v2/dns"
+	"github.com/textileio/textile/v2/domains"
 	"github.com/textileio/textile/v2/email"
 	"github.com/textileio/textile/v2/gateway"
 	"github.com/textileio/textile/v2/ipns"
 	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/oidc"
 	tdb "github.com/textileio/textile/v2/threaddb"
 	"github.com/textileio/textile/v2/util"
 	"google.golang.org/grpc"
@@ -66,12 +69,26 @@ var (
 	authIgnoredMethods = []string{
 		"/api.hubd.pb.APIService/Signup",
 		"/api.hubd.pb.APIService/Signin",
+		"/api.hubd.pb.APIService/StartSignup",
+		"/api.hubd.pb.APIService/StartSignin",
+		"/api.hubd.pb.APIService/PollLogin",
+		"/api.hubd.pb.APIService/GetSSOURL",
+		"/api.hubd.pb.APIService/SSOSignin",
 		"/api.hubd.pb.APIService/IsUsernameAvailable",
 	}
 
 	// usageIgnoredMethods are not intercepted by the usage interceptor.
 	usageIgnoredMethods = []string{
 		"/api.hubd.pb.APIService/Signout",
+		"/api.hubd.pb.APIService/ListSessions",
+		"/api.hubd.pb.APIService/RevokeSession",
+		"/api.hubd.pb.APIService/RevokeAllSessions",
+		"/api.hubd.pb.APIService/EnableTwoFactor",
+		"/api.hubd.pb.APIService/ConfirmTwoFactor",
+		"/api.hubd.pb.APIService/DisableTwoFactor",
+		"/api.hubd.pb.APIService/ExportAccount",
+		"/api.hubd.pb.APIService/GetExport",
+		"/api.hubd.pb.APIService/DownloadExport",
 		"/api.hubd.pb.APIService/DestroyAccount",
 		"/api.hubd.pb.APIService/SetupBilling",
 		"/api.hubd.pb.APIService/GetBillingSession",
@@ -133,6 +150,7 @@ type Textile struct {
 	thn *netclient.Client
 	bc  *billing.Client
 	pc  *pow.Client
+	ec  *email.Client
 
 	bucks *tdb.Buckets
 	mail  *tdb.Mail
@@ -143,6 +161,7 @@ type Textile struct {
 
 	ipnsm *ipns.Manager
 	dnsm  *dns.Manager
+	domm  *domains.Manager
 
 	server *grpc.Server
 	proxy  *http.Server
@@ -166,9 +185,12 @@ type Config struct {
 	AddrThreadsHost  ma.Multiaddr
 	AddrGatewayHost  ma.Multiaddr
 	AddrGatewayURL   string
+	AddrGatewayTLS   ma.Multiaddr
 	AddrIPFSAPI      ma.Multiaddr
 	AddrBillingAPI   string
 	AddrPowergateAPI string
+	// TrustedProxies are IPs or CIDRs of proxies whose X-Forwarded-For headers are trusted.
+	TrustedProxies []string
 
 	// Buckets
 	MaxBucketArchiveRepFactor int
@@ -180,6 +202,7 @@ type Config struct {
 	// IPNS
 	IPNSRepublishSchedule    string
 	IPNSRepublishConcurrency int
+	IPNSKeySecret            string
 
 	// Powergate
 	PowergateAdminToken string
@@ -189,18 +212,56 @@ type Config struct {
 	ArchiveJobPollIntervalFast time.Duration
 
 	// Gateway
-	UseSubdomains bool
+	UseSubdomains    bool
+	UseDomains       bool
+	GatewayCacheSize int64
+
+	// ACME
+	ACMEDirectoryURL string
+	ACMEEmail        string
+	ACMECACert       string
+	ACMECacheDir     string
+
+	// DNS
+	DNSProvider string
+	DNSDomain   string
 
 	// Cloudflare
-	DNSDomain string
 	DNSZoneID string
 	DNSToken  string
 
+	// RFC2136
+	DNSRFC2136Server        string
+	DNSRFC2136Zone          string
+	DNSRFC2136TSIGKey       string
+	DNSRFC2136TSIGSecret    string
+	DNSRFC2136TSIGAlgorithm string
+
+	// Email
+	EmailSender       string
+	EmailFrom         string
+	EmailTemplatesDir string
+	EmailFileDir      string
+
+	// SMTP
+	EmailSMTPAddr     string
+	EmailSMTPUsername string
+	EmailSMTPPassword string
+
 	// Customer.io
 	CustomerioAPIKey      string
 	CustomerioConfirmTmpl string
 	CustomerioInviteTmpl  string
 	EmailSessionSecret    string
+
+	// Single sign-on
+	SSOIssuer       string
+	SSOClientID     string
+	SSOClientSecret string
+
+	// Exports
+	// ExportDir must be shared by all hub replicas so any of them can serve an export's archive.
+	ExportDir string
 }
 
 func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, error) {
@@ -236,8 +297,12 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			return nil, err
 		}
 	}
-	if conf.DNSToken != "" {
-		t.dnsm, err = dns.NewManager(conf.DNSDomain, conf.DNSZoneID, conf.DNSToken, conf.Debug)
+	dnsp, err := newDNSProvider(conf)
+	if err != nil {
+		return nil, err
+	}
+	if dnsp != nil {
+		t.dnsm, err = dns.NewManager(conf.DNSDomain, dnsp, conf.Debug)
 		if err != nil {
 			return nil, err
 		}
@@ -246,10 +311,24 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	if err != nil {
 		return nil, err
 	}
-	t.ipnsm, err = ipns.NewManager(t.collections.IPNSKeys, ic.Key(), ic.Name(), conf.IPNSRepublishConcurrency, conf.Debug)
+	t.ipnsm, err = ipns.NewManager(
+		t.collections.IPNSKeys,
+		ic.Key(),
+		ic.Name(),
+		ipns.NewHTTPKeystore(ic),
+		conf.IPNSKeySecret,
+		conf.IPNSRepublishConcurrency,
+		conf.Debug,
+	)
 	if err != nil {
 		return nil, err
 	}
+	if conf.UseDomains {
+		t.domm, err = domains.NewManager(t.collections.Domains, nil, conf.Debug)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Configure threads
 	netOptions := []tc.NetOption{
@@ -356,17 +435,45 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	var hs *hubd.Service
 	var us *usersd.Service
 	if conf.Hub {
-		cio, err := email.NewClient(
+		sender, err := newEmailSender(conf)
+		if err != nil {
+			return nil, err
+		}
+		ec, err := email.NewClient(
 			email.Config{
-				ConfirmTmpl: conf.CustomerioConfirmTmpl,
-				InviteTmpl:  conf.CustomerioInviteTmpl,
-				APIKey:      conf.CustomerioAPIKey,
-				Debug:       conf.Debug,
+				Sender:       sender,
+				TemplatesDir: conf.EmailTemplatesDir,
+				Debug:        conf.Debug,
 			},
 		)
 		if err != nil {
 			return nil, err
 		}
+		t.ec = ec
+
+		var sso *oidc.Provider
+		if conf.SSOIssuer != "" {
+			sso, err = oidc.NewProvider(oidc.Config{
+				Issuer:       conf.SSOIssuer,
+				ClientID:     conf.SSOClientID,
+				ClientSecret: conf.SSOClientSecret,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Exports run in the background, so any this replica left unfinished in its last run won't complete.
+		// Other replicas' exports are failed once they go stale.
+		replica := t.tn.Host().ID().String()
+		if err := t.collections.Exports.FailUnfinished(ctx, replica, "Export was interrupted"); err != nil {
+			return nil, err
+		}
+
+		trustedProxies, err := parseTrustedProxies(conf.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
 
 		t.emailSessionBus = broadcast.NewBroadcaster(0)
 		hs = &hubd.Service{
@@ -374,7 +481,7 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			Threads:             t.th,
 			ThreadsNet:          t.thn,
 			GatewayURL:          conf.AddrGatewayURL,
-			EmailClient:         cio,
+			EmailClient:         ec,
 			EmailSessionBus:     t.emailSessionBus,
 			EmailSessionSecret:  conf.EmailSessionSecret,
 			IPFSClient:          ic,
@@ -382,6 +489,12 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			BillingClient:       t.bc,
 			PowergateClient:     t.pc,
 			PowergateAdminToken: conf.PowergateAdminToken,
+			SSOProvider:         sso,
+			Mail:                t.mail,
+			InternalSession:     t.internalHubSession,
+			ExportDir:           conf.ExportDir,
+			Replica:             replica,
+			TrustedProxies:      trustedProxies,
 		}
 		us = &usersd.Service{
 			Collections:     t.collections,
@@ -400,6 +513,7 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 		GatewayBucketsHost:        conf.DNSDomain,
 		IPFSClient:                ic,
 		IPNSManager:               t.ipnsm,
+		Domains:                   t.domm,
 		PowergateClient:           t.pc,
 		PowergateAdminToken:       conf.PowergateAdminToken,
 		ArchiveTracker:            t.archiveTracker,
@@ -436,6 +550,7 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 		grpcopts = []grpc.ServerOption{
 			grpcm.WithUnaryServerChain(
 				auth.UnaryServerInterceptor(t.authFunc),
+				scopeInterceptor(),
 				unaryServerInterceptor(t.preUsageFunc, t.postUsageFunc),
 				t.threadInterceptor(),
 				powInterceptor(
@@ -450,6 +565,7 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 			),
 			grpcm.WithStreamServerChain(
 				auth.StreamServerInterceptor(t.authFunc),
+				scopeStreamInterceptor(),
 				streamServerInterceptor(t.preUsageFunc, t.postUsageFunc),
 			),
 			grpc.StatsHandler(&StatsHandler{t: t}),
@@ -521,6 +637,15 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 		EmailSessionBus: t.emailSessionBus,
 		Hub:             conf.Hub,
 		Debug:           conf.Debug,
+		CacheSize:       conf.GatewayCacheSize,
+		Domains:         t.domm,
+		TLSAddr:         conf.AddrGatewayTLS,
+		ACME: domains.CertConfig{
+			DirectoryURL: conf.ACMEDirectoryURL,
+			Email:        conf.ACMEEmail,
+			CacheDir:     conf.ACMECacheDir,
+			CACert:       conf.ACMECACert,
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -537,6 +662,60 @@ func NewTextile(ctx context.Context, conf Config, opts ...Option) (*Textile, err
 	return t, nil
 }
 
+// newDNSProvider returns the configured DNS provider, or nil if DNS management is disabled.
+func newDNSProvider(conf Config) (dns.Provider, error) {
+	switch conf.DNSProvider {
+	case "", "cloudflare":
+		if conf.DNSToken == "" {
+			return nil, nil
+		}
+		return dns.NewCloudflare(conf.DNSZoneID, conf.DNSToken)
+	case "rfc2136":
+		zone := conf.DNSRFC2136Zone
+		if zone == "" {
+			zone = conf.DNSDomain
+		}
+		return dns.NewRFC2136(dns.RFC2136Config{
+			Server:        conf.DNSRFC2136Server,
+			Zone:          zone,
+			TSIGKey:       conf.DNSRFC2136TSIGKey,
+			TSIGSecret:    conf.DNSRFC2136TSIGSecret,
+			TSIGAlgorithm: conf.DNSRFC2136TSIGAlgorithm,
+		})
+	case "memory":
+		return dns.NewMemory(), nil
+	default:
+		return nil, fmt.Errorf("unknown dns provider %s (options: cloudflare, rfc2136, memory)", conf.DNSProvider)
+	}
+}
+
+// newEmailSender returns the configured email sender, or nil if email is disabled.
+func newEmailSender(conf Config) (email.Sender, error) {
+	switch conf.EmailSender {
+	case "", "customerio":
+		if conf.CustomerioAPIKey == "" {
+			return nil, nil
+		}
+		return email.NewCustomerio(conf.CustomerioAPIKey, conf.EmailFrom, map[email.Kind]string{
+			email.KindConfirm: conf.CustomerioConfirmTmpl,
+			email.KindInvite:  conf.CustomerioInviteTmpl,
+		}), nil
+	case "smtp":
+		return email.NewSMTP(email.SMTPConfig{
+			Addr:     conf.EmailSMTPAddr,
+			Username: conf.EmailSMTPUsername,
+			Password: conf.EmailSMTPPassword,
+			From:     conf.EmailFrom,
+		})
+	case "file":
+		return email.NewFile(conf.EmailFileDir, conf.EmailFrom)
+	case "none":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown email sender %s (options: customerio, smtp, file, none)", conf.EmailSender)
+	}
+}
+
 func (t *Textile) Bootstrap() {
 	t.tn.Bootstrap(tutil.DefaultBoostrapPeers())
 }
@@ -639,3 +818,28 @@ func createServiceDesciptor(file string, serviceName string) (*desc.ServiceDescr
 	}
 	return serviceDesc, nil
 }
+
+// parseTrustedProxies parses IPs or CIDRs into networks.
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", p)
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			bits := 8 * len(ip)
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %s", p)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
@@ -0,0 +1,311 @@
+package core
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/textileio/go-threads/core/thread"
+	bpb "github.com/textileio/textile/v2/api/bucketsd/pb"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const bucketsServicePrefix = "/api.bucketsd.pb.APIService/"
+
+// orgDataServicePrefixes are the services whose methods act on org data and are checked against org roles.
+// Other services, e.g., hubd, check org permissions in their handlers.
+var orgDataServicePrefixes = []string{
+	bucketsServicePrefix,
+	"/threads.pb.API/",
+	"/threads.net.pb.API/",
+	"/api.usersd.pb.APIService/",
+}
+
+var (
+	// scopeFreeMethods can be called with any API key scope.
+	scopeFreeMethods = []string{
+		"/threads.pb.API/GetToken",
+		"/threads.net.pb.API/GetToken",
+		"/threads.net.pb.API/GetHostID",
+	}
+
+	// methodScopes are the API key scopes required by methods, as "resource:action".
+	// Methods that aren't listed can only be called with unscoped keys.
+	methodScopes = map[string]string{
+		bucketsServicePrefix + "List":                    "buckets:read",
+		bucketsServicePrefix + "Create":                  "buckets:write",
+		bucketsServicePrefix + "Root":                    "buckets:read",
+		bucketsServicePrefix + "Links":                   "buckets:read",
+		bucketsServicePrefix + "ListPath":                "buckets:read",
+		bucketsServicePrefix + "ListIpfsPath":            "buckets:read",
+		bucketsServicePrefix + "PushPath":                "buckets:write",
+		bucketsServicePrefix + "PushPaths":               "buckets:write",
+		bucketsServicePrefix + "PullPath":                "buckets:read",
+		bucketsServicePrefix + "PullIpfsPath":            "buckets:read",
+		bucketsServicePrefix + "SetPath":                 "buckets:write",
+		bucketsServicePrefix + "Remove":                  "buckets:write",
+		bucketsServicePrefix + "RemovePath":              "buckets:write",
+		bucketsServicePrefix + "PushPathAccessRoles":     "buckets:write",
+		bucketsServicePrefix + "PullPathAccessRoles":     "buckets:read",
+		bucketsServicePrefix + "AcceptPathAccessRoles":   "buckets:write",
+		bucketsServicePrefix + "DefaultArchiveConfig":    "buckets:read",
+		bucketsServicePrefix + "SetDefaultArchiveConfig": "buckets:write",
+		bucketsServicePrefix + "Archive":                 "buckets:write",
+		bucketsServicePrefix + "Archives":                "buckets:read",
+		bucketsServicePrefix + "ArchiveWatch":            "buckets:read",
+		bucketsServicePrefix + "AddDomain":               "buckets:write",
+		bucketsServicePrefix + "VerifyDomain":            "buckets:write",
+		bucketsServicePrefix + "ListDomains":             "buckets:read",
+		bucketsServicePrefix + "RemoveDomain":            "buckets:write",
+		bucketsServicePrefix + "GetIPNSSettings":         "buckets:read",
+		bucketsServicePrefix + "SetIPNSSettings":         "buckets:write",
+		bucketsServicePrefix + "ExportIPNSKey":           "buckets:write",
+
+		"/threads.pb.API/NewDB":                "threads:write",
+		"/threads.pb.API/NewDBFromAddr":        "threads:write",
+		"/threads.pb.API/GetDBInfo":            "threads:read",
+		"/threads.pb.API/DeleteDB":             "threads:write",
+		"/threads.pb.API/NewCollection":        "threads:write",
+		"/threads.pb.API/UpdateCollection":     "threads:write",
+		"/threads.pb.API/DeleteCollection":     "threads:write",
+		"/threads.pb.API/GetCollectionInfo":    "threads:read",
+		"/threads.pb.API/GetCollectionIndexes": "threads:read",
+		"/threads.pb.API/ListCollections":      "threads:read",
+		"/threads.pb.API/Create":               "threads:write",
+		"/threads.pb.API/Verify":               "threads:read",
+		"/threads.pb.API/Save":                 "threads:write",
+		"/threads.pb.API/Delete":               "threads:write",
+		"/threads.pb.API/Has":                  "threads:read",
+		"/threads.pb.API/Find":                 "threads:read",
+		"/threads.pb.API/FindByID":             "threads:read",
+		"/threads.pb.API/ReadTransaction":      "threads:read",
+		"/threads.pb.API/WriteTransaction":     "threads:write",
+		"/threads.pb.API/Listen":               "threads:read",
+		"/threads.net.pb.API/CreateThread":     "threads:write",
+		"/threads.net.pb.API/AddThread":        "threads:write",
+		"/threads.net.pb.API/GetThread":        "threads:read",
+		"/threads.net.pb.API/PullThread":       "threads:write",
+		"/threads.net.pb.API/DeleteThread":     "threads:write",
+		"/threads.net.pb.API/AddReplicator":    "threads:write",
+		"/threads.net.pb.API/CreateRecord":     "threads:write",
+		"/threads.net.pb.API/AddRecord":        "threads:write",
+		"/threads.net.pb.API/GetRecord":        "threads:read",
+		"/threads.net.pb.API/Subscribe":        "threads:read",
+
+		"/api.usersd.pb.APIService/GetThread":            "threads:read",
+		"/api.usersd.pb.APIService/ListThreads":          "threads:read",
+		"/api.usersd.pb.APIService/SetupMailbox":         "mail:send",
+		"/api.usersd.pb.APIService/SendMessage":          "mail:send",
+		"/api.usersd.pb.APIService/ListInboxMessages":    "mail:read",
+		"/api.usersd.pb.APIService/ListSentboxMessages":  "mail:read",
+		"/api.usersd.pb.APIService/ReadInboxMessage":     "mail:write",
+		"/api.usersd.pb.APIService/DeleteInboxMessage":   "mail:write",
+		"/api.usersd.pb.APIService/DeleteSentboxMessage": "mail:write",
+		"/api.usersd.pb.APIService/GetUsage":             "usage:read",
+		"/api.usersd.pb.APIService/ArchivesLs":           "archives:read",
+		"/api.usersd.pb.APIService/ArchivesImport":       "archives:write",
+		"/api.usersd.pb.APIService/ArchiveRetrievalLs":   "archives:read",
+		"/api.usersd.pb.APIService/ArchiveRetrievalLogs": "archives:read",
+	}
+)
+
+// checkAPIKey returns an error if the key can't be used for method from the caller in ctx.
+func checkAPIKey(ctx context.Context, key *mdb.APIKey, method string) error {
+	if key.Expired() {
+		return status.Error(codes.Unauthenticated, "API key has expired")
+	}
+	if len(key.AllowedOrigins) != 0 {
+		origin := metautils.ExtractIncoming(ctx).Get("origin")
+		if !containsString(key.AllowedOrigins, strings.TrimSuffix(origin, "/")) {
+			return status.Error(codes.PermissionDenied, "API key is not allowed from this origin")
+		}
+	}
+	if len(key.AllowedIPs) != 0 {
+		if !ipAllowed(key.AllowedIPs, peerIP(ctx)) {
+			return status.Error(codes.PermissionDenied, "API key is not allowed from this address")
+		}
+	}
+	if len(key.Scopes) == 0 || containsString(scopeFreeMethods, method) {
+		return nil
+	}
+	scope, ok := methodScopes[method]
+	if !ok {
+		return status.Error(codes.PermissionDenied, "API key scopes do not allow this method")
+	}
+	resource, action := splitMethodScope(scope)
+	if !key.AllowsAction(resource, action) {
+		return status.Errorf(codes.PermissionDenied, "API key requires the %s scope", scope)
+	}
+	return nil
+}
+
+// checkOrgRole returns an error if member's org role doesn't allow method on org data.
+// Methods are classified as read or write by their API key scope.
+// Methods of org data services without a scope are denied.
+func checkOrgRole(org *mdb.Account, member thread.PubKey, method string) error {
+	if !isOrgDataMethod(method) {
+		return nil
+	}
+	role, ok := org.MemberRole(member)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "User is not an org member")
+	}
+	if containsString(scopeFreeMethods, method) {
+		return nil
+	}
+	scope, ok := methodScopes[method]
+	if !ok {
+		return status.Error(codes.PermissionDenied, "Org roles do not allow this method")
+	}
+	resource, action := splitMethodScope(scope)
+	var perm mdb.Permission
+	switch {
+	case resource == "buckets" && action == "read":
+		perm = mdb.PermReadBuckets
+	case resource == "buckets":
+		perm = mdb.PermWriteBuckets
+	case action == "read":
+		perm = mdb.PermReadThreads
+	default:
+		perm = mdb.PermWriteThreads
+	}
+	if !role.Allows(perm) {
+		return status.Errorf(codes.PermissionDenied, "Org role %s does not allow this %s action", role, resource)
+	}
+	return nil
+}
+
+// isOrgDataMethod returns whether method belongs to a service that acts on org data.
+func isOrgDataMethod(method string) bool {
+	for _, p := range orgDataServicePrefixes {
+		if strings.HasPrefix(method, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeInterceptor checks bucket requests against API key scopes that are limited to bucket keys.
+func scopeInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkBucketScope(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// scopeStreamInterceptor is the streaming version of scopeInterceptor.
+// Each received message is checked.
+func scopeStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !strings.HasPrefix(info.FullMethod, bucketsServicePrefix) {
+			return handler(srv, stream)
+		}
+		return handler(srv, &scopedStream{ServerStream: stream, method: info.FullMethod})
+	}
+}
+
+type scopedStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *scopedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkBucketScope(s.Context(), s.method, m)
+}
+
+func checkBucketScope(ctx context.Context, method string, req interface{}) error {
+	if !strings.HasPrefix(method, bucketsServicePrefix) {
+		return nil
+	}
+	key, ok := mdb.APIKeyFromContext(ctx)
+	if !ok || len(key.Scopes) == 0 {
+		return nil
+	}
+	var bucket string
+	switch r := req.(type) {
+	case *bpb.PushPathRequest:
+		if r.GetHeader() == nil {
+			return nil // Only headers contain the bucket key
+		}
+		bucket = r.GetHeader().GetKey()
+	case *bpb.PushPathsRequest:
+		if r.GetHeader() == nil {
+			return nil
+		}
+		bucket = r.GetHeader().GetKey()
+	case interface{ GetKey() string }:
+		bucket = r.GetKey()
+	}
+	resource, action := splitMethodScope(methodScopes[method])
+	if !key.AllowsTarget(resource, action, bucket) {
+		return status.Error(codes.PermissionDenied, "API key scopes do not allow this bucket")
+	}
+	return nil
+}
+
+func splitMethodScope(scope string) (resource, action string) {
+	parts := strings.SplitN(scope, ":", 2)
+	if len(parts) != 2 {
+		return scope, ""
+	}
+	return parts[0], parts[1]
+}
+
+// peerIP returns the IP address of the caller.
+func peerIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return net.ParseIP(host)
+}
+
+// ipAllowed returns whether ip matches one of the allowed IP addresses or CIDR ranges.
+func ipAllowed(allowed []string, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, a := range allowed {
+		if _, n, err := net.ParseCIDR(a); err == nil {
+			if n.Contains(ip) {
+				return true
+			}
+		} else if aip := net.ParseIP(a); aip != nil && aip.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, i := range list {
+		if i == s {
+			return true
+		}
+	}
+	return false
+}
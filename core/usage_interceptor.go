@@ -22,6 +22,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// quotaWarningPercent is the percentage of the storage quota at which owners are warned.
+const quotaWarningPercent = 80
+
 type preFunc func(ctx context.Context, method string) (context.Context, error)
 type postFunc func(ctx context.Context, method string) error
 
@@ -237,15 +240,19 @@ func (t *Textile) postUsageFunc(ctx context.Context, method string) error {
 		"/api.bucketsd.pb.APIService/Remove",
 		"/api.bucketsd.pb.APIService/RemovePath",
 		"/api.bucketsd.pb.APIService/PushPathAccessRoles":
-		if _, err := t.bc.IncCustomerUsage(
+		res, err := t.bc.IncCustomerUsage(
 			ctx,
 			account.Owner().Key,
 			map[string]int64{
 				"stored_data": owner.StorageDelta,
 			},
-		); err != nil {
+		)
+		if err != nil {
 			return err
 		}
+		if owner.StorageDelta > 0 {
+			t.warnQuota(ctx, account, owner, res.DailyUsage["stored_data"])
+		}
 	}
 
 	if t.bc != nil {
@@ -270,6 +277,46 @@ func (t *Textile) postUsageFunc(ctx context.Context, method string) error {
 	return nil
 }
 
+// warnQuota emails the account owner once per usage period when stored data
+// crosses quotaWarningPercent of the quota.
+func (t *Textile) warnQuota(ctx context.Context, account *mdb.AccountCtx, owner *buckets.BucketOwner, usage *pb.Usage) {
+	if t.ec == nil || usage == nil || owner.StorageAvailable == int64(math.MaxInt64) {
+		return
+	}
+	quota := owner.StorageUsed + owner.StorageAvailable
+	if quota <= 0 || usage.Total*100 < quota*quotaWarningPercent {
+		return
+	}
+	var period int64
+	if usage.Period != nil {
+		period = usage.Period.UnixStart
+	}
+	ok, err := t.collections.Accounts.MarkQuotaWarned(ctx, account.Owner().Key, period)
+	if err != nil {
+		log.Errorf("marking quota warning: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	email, err := t.getAccountCtxEmail(ctx, account)
+	if err != nil {
+		log.Errorf("getting quota warning email: %v", err)
+		return
+	}
+	if err := t.ec.QuotaWarning(
+		ctx,
+		email,
+		account.Owner().Username,
+		email,
+		t.conf.AddrGatewayURL,
+		usage.Total,
+		quota,
+	); err != nil {
+		log.Errorf("sending quota warning: %v", err)
+	}
+}
+
 func (t *Textile) getAccountCtxEmail(ctx context.Context, account *mdb.AccountCtx) (string, error) {
 	if account.User != nil {
 		return account.User.Email, nil
@@ -0,0 +1,55 @@
+package dns
+
+import (
+	cf "github.com/cloudflare/cloudflare-go"
+)
+
+// Cloudflare is a Provider backed by the Cloudflare API.
+type Cloudflare struct {
+	api    *cf.API
+	zoneID string
+}
+
+var _ Provider = (*Cloudflare)(nil)
+
+// NewCloudflare returns a Cloudflare provider for the zone.
+func NewCloudflare(zoneID string, token string) (*Cloudflare, error) {
+	api, err := cf.NewWithAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return &Cloudflare{
+		api:    api,
+		zoneID: zoneID,
+	}, nil
+}
+
+func (p *Cloudflare) CreateRecord(rec Record) (*Record, error) {
+	res, err := p.api.CreateDNSRecord(p.zoneID, cf.DNSRecord{
+		Type:    rec.Type,
+		Name:    rec.Name,
+		Content: rec.Content,
+		Proxied: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		ID:      res.Result.ID,
+		Type:    res.Result.Type,
+		Name:    res.Result.Name,
+		Content: res.Result.Content,
+	}, nil
+}
+
+func (p *Cloudflare) UpdateRecord(rec Record) error {
+	return p.api.UpdateDNSRecord(p.zoneID, rec.ID, cf.DNSRecord{
+		Type:    rec.Type,
+		Name:    rec.Name,
+		Content: rec.Content,
+	})
+}
+
+func (p *Cloudflare) DeleteRecord(id string) error {
+	return p.api.DeleteDNSRecord(p.zoneID, id)
+}
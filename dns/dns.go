@@ -3,7 +3,6 @@ package dns
 import (
 	"fmt"
 
-	cf "github.com/cloudflare/cloudflare-go"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/go-threads/util"
 )
@@ -14,16 +13,34 @@ var (
 
 const IPFSGateway = "cloudflare-ipfs.com"
 
-// Manager wraps a CloudflareClient client.
+// Record is a DNS record.
+type Record struct {
+	// ID identifies the record with the provider that created it.
+	ID      string
+	Type    string
+	Name    string
+	Content string
+}
+
+// Provider creates, updates, and deletes DNS records in a zone.
+type Provider interface {
+	// CreateRecord creates a new record and returns it with a provider-specific ID.
+	CreateRecord(rec Record) (*Record, error)
+	// UpdateRecord replaces the record with ID.
+	UpdateRecord(rec Record) error
+	// DeleteRecord removes the record with ID.
+	DeleteRecord(id string) error
+}
+
+// Manager manages records in a DNS zone through a Provider.
 type Manager struct {
 	Domain string
 
-	api    *cf.API
-	zoneID string
+	provider Provider
 }
 
-// NewManager return a cloudflare-backed dns updating client.
-func NewManager(domain string, zoneID string, token string, debug bool) (*Manager, error) {
+// NewManager returns a dns updating client backed by provider.
+func NewManager(domain string, provider Provider, debug bool) (*Manager, error) {
 	if debug {
 		if err := util.SetLogLevels(map[string]logging.LogLevel{
 			"dns": logging.LevelDebug,
@@ -31,36 +48,29 @@ func NewManager(domain string, zoneID string, token string, debug bool) (*Manage
 			return nil, err
 		}
 	}
-
-	api, err := cf.NewWithAPIToken(token)
-	if err != nil {
-		return nil, err
-	}
 	return &Manager{
-		Domain: domain,
-		api:    api,
-		zoneID: zoneID,
+		Domain:   domain,
+		provider: provider,
 	}, nil
 }
 
 // NewCNAME enters a new dns record for a CNAME.
-func (m *Manager) NewCNAME(name string, target string) (*cf.DNSRecord, error) {
-	res, err := m.api.CreateDNSRecord(m.zoneID, cf.DNSRecord{
+func (m *Manager) NewCNAME(name string, target string) (*Record, error) {
+	rec, err := m.provider.CreateRecord(Record{
 		Type:    "CNAME",
 		Name:    name,
 		Content: target,
-		Proxied: false,
 	})
 	if err != nil {
 		return nil, err
 	}
 	log.Debugf("created CNAME record %s -> %s", name, target)
-	return &res.Result, nil
+	return rec, nil
 }
 
 // NewTXT enters a new dns record for a TXT.
-func (m *Manager) NewTXT(name string, content string) (*cf.DNSRecord, error) {
-	res, err := m.api.CreateDNSRecord(m.zoneID, cf.DNSRecord{
+func (m *Manager) NewTXT(name string, content string) (*Record, error) {
+	rec, err := m.provider.CreateRecord(Record{
 		Type:    "TXT",
 		Name:    name,
 		Content: content,
@@ -69,11 +79,11 @@ func (m *Manager) NewTXT(name string, content string) (*cf.DNSRecord, error) {
 		return nil, err
 	}
 	log.Debugf("created TXT record %s -> %s", name, content)
-	return &res.Result, nil
+	return rec, nil
 }
 
 // NewDNSLink enters a two dns records to enable DNS link.
-func (m *Manager) NewDNSLink(subdomain string, hash string) ([]*cf.DNSRecord, error) {
+func (m *Manager) NewDNSLink(subdomain string, hash string) ([]*Record, error) {
 	cname, err := m.NewCNAME(subdomain, IPFSGateway)
 	if err != nil {
 		return nil, err
@@ -89,12 +99,13 @@ func (m *Manager) NewDNSLink(subdomain string, hash string) ([]*cf.DNSRecord, er
 	}
 
 	log.Debugf("created DNSLink record %s -> %s", subdomain, hash)
-	return []*cf.DNSRecord{cname, txt}, nil
+	return []*Record{cname, txt}, nil
 }
 
 // UpdateRecord updates an existing record.
 func (m *Manager) UpdateRecord(id, rtype, name, content string) error {
-	if err := m.api.UpdateDNSRecord(m.zoneID, id, cf.DNSRecord{
+	if err := m.provider.UpdateRecord(Record{
+		ID:      id,
 		Type:    rtype,
 		Name:    name,
 		Content: content,
@@ -107,7 +118,7 @@ func (m *Manager) UpdateRecord(id, rtype, name, content string) error {
 
 // Delete removes a record by ID from dns.
 func (m *Manager) DeleteRecord(id string) error {
-	if err := m.api.DeleteDNSRecord(m.zoneID, id); err != nil {
+	if err := m.provider.DeleteRecord(id); err != nil {
 		return err
 	}
 	log.Debugf("deleted record %s", id)
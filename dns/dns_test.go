@@ -0,0 +1,156 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_NewDNSLink(t *testing.T) {
+	p := NewMemory()
+	m, err := NewManager("example.com", p, false)
+	require.NoError(t, err)
+
+	recs, err := m.NewDNSLink("foo", "bafyhash")
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+	assert.Equal(t, []Record{
+		{ID: recs[0].ID, Type: "CNAME", Name: "foo", Content: IPFSGateway},
+		{ID: recs[1].ID, Type: "TXT", Name: "_dnslink.foo", Content: "dnslink=/ipfs/bafyhash"},
+	}, p.Records())
+
+	err = m.UpdateRecord(recs[1].ID, "TXT", "_dnslink.foo", CreateDNSLinkContent("bafynew"))
+	require.NoError(t, err)
+	assert.Equal(t, "dnslink=/ipfs/bafynew", p.Records()[1].Content)
+
+	err = m.DeleteRecord(recs[0].ID)
+	require.NoError(t, err)
+	assert.Len(t, p.Records(), 1)
+	assert.Error(t, m.DeleteRecord(recs[0].ID))
+}
+
+func TestRFC2136(t *testing.T) {
+	const key = "textile-key."
+	secret := base64.StdEncoding.EncodeToString([]byte("supersecret"))
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ln, err := net.Listen("tcp", conn.LocalAddr().String())
+	require.NoError(t, err)
+
+	var (
+		lk       sync.Mutex
+		msgs     []*dns.Msg
+		nets     []string
+		rcode    = dns.RcodeSuccess
+		unsigned bool
+		truncate bool
+	)
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		lk.Lock()
+		defer lk.Unlock()
+		res := new(dns.Msg)
+		res.SetRcode(r, rcode)
+		if w.TsigStatus() != nil {
+			res.SetRcode(r, dns.RcodeNotAuth)
+		}
+		network := w.LocalAddr().Network()
+		if truncate && network == "udp" {
+			res.Truncated = true
+		} else {
+			msgs = append(msgs, r)
+			nets = append(nets, network)
+		}
+		if t := r.IsTsig(); t != nil && !unsigned {
+			res.SetTsig(t.Hdr.Name, t.Algorithm, t.Fudge, time.Now().Unix())
+		}
+		_ = w.WriteMsg(res)
+	})
+	secrets := map[string]string{key: secret}
+	// The default accept func rejects updates
+	accept := func(dns.Header) dns.MsgAcceptAction {
+		return dns.MsgAccept
+	}
+	udp := &dns.Server{PacketConn: conn, Handler: handler, TsigSecret: secrets, MsgAcceptFunc: accept}
+	tcp := &dns.Server{Listener: ln, Handler: handler, TsigSecret: secrets, MsgAcceptFunc: accept}
+	go func() { _ = udp.ActivateAndServe() }()
+	go func() { _ = tcp.ActivateAndServe() }()
+	defer func() {
+		_ = udp.Shutdown()
+		_ = tcp.Shutdown()
+	}()
+	last := func() (*dns.Msg, string) {
+		lk.Lock()
+		defer lk.Unlock()
+		require.NotEmpty(t, msgs)
+		return msgs[len(msgs)-1], nets[len(nets)-1]
+	}
+
+	p, err := NewRFC2136(RFC2136Config{
+		Server:     conn.LocalAddr().String(),
+		Zone:       "example.com.",
+		TSIGKey:    "textile-key",
+		TSIGSecret: secret,
+	})
+	require.NoError(t, err)
+
+	rec, err := p.CreateRecord(Record{Type: "TXT", Name: "_dnslink.foo", Content: "dnslink=/ipfs/bafyhash"})
+	require.NoError(t, err)
+	assert.Equal(t, "TXT _dnslink.foo.example.com", rec.ID)
+	msg, network := last()
+	assert.Equal(t, "udp", network)
+	assert.Equal(t, dns.OpcodeUpdate, msg.Opcode)
+	require.Len(t, msg.Question, 1)
+	assert.Equal(t, "example.com.", msg.Question[0].Name)
+	require.Len(t, msg.Ns, 1)
+	assert.Equal(t, []string{"dnslink=/ipfs/bafyhash"}, msg.Ns[0].(*dns.TXT).Txt)
+	assert.NotNil(t, msg.IsTsig())
+
+	err = p.UpdateRecord(Record{ID: rec.ID, Type: "TXT", Name: "_dnslink.foo", Content: "dnslink=/ipfs/bafynew"})
+	require.NoError(t, err)
+	msg, _ = last()
+	require.Len(t, msg.Ns, 2)
+	assert.Equal(t, uint16(dns.ClassANY), msg.Ns[0].Header().Class, "rrset deletion")
+	assert.Equal(t, "_dnslink.foo.example.com.", msg.Ns[0].Header().Name)
+
+	// Truncated responses are retried over TCP
+	lk.Lock()
+	truncate = true
+	lk.Unlock()
+	require.NoError(t, p.DeleteRecord(rec.ID))
+	_, network = last()
+	assert.Equal(t, "tcp", network)
+	lk.Lock()
+	truncate = false
+	rcode = dns.RcodeRefused
+	lk.Unlock()
+
+	err = p.DeleteRecord(rec.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "REFUSED")
+
+	// Responses must be signed
+	lk.Lock()
+	rcode = dns.RcodeSuccess
+	unsigned = true
+	lk.Unlock()
+	err = p.DeleteRecord(rec.ID)
+	require.Error(t, err)
+
+	_, err = p.CreateRecord(Record{Type: "MX", Name: "foo", Content: "bar"})
+	assert.Error(t, err)
+}
+
+func TestSplitTXT(t *testing.T) {
+	long := string(bytes.Repeat([]byte("a"), 300))
+	txt := splitTXT(long)
+	require.Len(t, txt, 2)
+	assert.Len(t, txt[0], 255)
+	assert.Len(t, txt[1], 45)
+}
@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Memory is an in-memory Provider for tests and local development.
+type Memory struct {
+	lk      sync.Mutex
+	next    int
+	records map[string]Record
+}
+
+var _ Provider = (*Memory)(nil)
+
+// NewMemory returns an empty in-memory provider.
+func NewMemory() *Memory {
+	return &Memory{records: make(map[string]Record)}
+}
+
+func (p *Memory) CreateRecord(rec Record) (*Record, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	for _, r := range p.records {
+		if r.Type == rec.Type && r.Name == rec.Name && r.Content == rec.Content {
+			return nil, fmt.Errorf("record already exists: %s %s", rec.Type, rec.Name)
+		}
+	}
+	p.next++
+	rec.ID = strconv.Itoa(p.next)
+	p.records[rec.ID] = rec
+	return &rec, nil
+}
+
+func (p *Memory) UpdateRecord(rec Record) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if _, ok := p.records[rec.ID]; !ok {
+		return fmt.Errorf("record not found: %s", rec.ID)
+	}
+	p.records[rec.ID] = rec
+	return nil
+}
+
+func (p *Memory) DeleteRecord(id string) error {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if _, ok := p.records[id]; !ok {
+		return fmt.Errorf("record not found: %s", id)
+	}
+	delete(p.records, id)
+	return nil
+}
+
+// Records returns all records ordered by ID.
+func (p *Memory) Records() []Record {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	list := make([]Record, 0, len(p.records))
+	for _, r := range p.records {
+		list = append(list, r)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, _ := strconv.Atoi(list[i].ID)
+		b, _ := strconv.Atoi(list[j].ID)
+		return a < b
+	})
+	return list
+}
@@ -0,0 +1,234 @@
+package dns
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultTSIGAlgorithm is the TSIG algorithm used when none is configured.
+	DefaultTSIGAlgorithm = "hmac-sha256"
+
+	rfc2136Timeout = time.Second * 10
+	rfc2136TTL     = 300
+	tsigFudge      = 300
+)
+
+var tsigAlgorithms = map[string]string{
+	"hmac-sha1":   dns.HmacSHA1,
+	"hmac-sha256": dns.HmacSHA256,
+	"hmac-sha512": dns.HmacSHA512,
+}
+
+// RFC2136Config configures an RFC2136 provider.
+type RFC2136Config struct {
+	// Server is the host:port of the authoritative name server.
+	Server string
+	// Zone is the zone being updated.
+	Zone string
+	// TSIGKey is the optional name of the TSIG key used to sign updates.
+	TSIGKey string
+	// TSIGSecret is the base64 encoded TSIG secret.
+	TSIGSecret string
+	// TSIGAlgorithm is one of hmac-sha1, hmac-sha256, or hmac-sha512.
+	TSIGAlgorithm string
+}
+
+// RFC2136 is a Provider that sends dynamic updates to a name server, e.g., BIND, Knot, or PowerDNS.
+// Records are identified by type and name, i.e., an update or delete applies to the whole RRset.
+// If a TSIG key is configured, updates are signed and responses must be signed with the same key.
+type RFC2136 struct {
+	server  string
+	zone    string
+	keyName string
+	keyAlg  string
+	secret  string
+	timeout time.Duration
+}
+
+var _ Provider = (*RFC2136)(nil)
+
+// NewRFC2136 returns an RFC2136 dynamic update provider.
+func NewRFC2136(conf RFC2136Config) (*RFC2136, error) {
+	if conf.Server == "" {
+		return nil, errors.New("rfc2136 server is required")
+	}
+	if conf.Zone == "" {
+		return nil, errors.New("rfc2136 zone is required")
+	}
+	server := conf.Server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+	p := &RFC2136{
+		server:  server,
+		zone:    strings.TrimSuffix(strings.ToLower(conf.Zone), "."),
+		timeout: rfc2136Timeout,
+	}
+	if conf.TSIGKey != "" {
+		alg := conf.TSIGAlgorithm
+		if alg == "" {
+			alg = DefaultTSIGAlgorithm
+		}
+		keyAlg, ok := tsigAlgorithms[strings.TrimSuffix(strings.ToLower(alg), ".")]
+		if !ok {
+			return nil, fmt.Errorf("unsupported tsig algorithm %s", conf.TSIGAlgorithm)
+		}
+		if _, err := base64.StdEncoding.DecodeString(conf.TSIGSecret); err != nil {
+			return nil, fmt.Errorf("decoding tsig secret: %v", err)
+		}
+		p.keyName = dns.Fqdn(strings.ToLower(conf.TSIGKey))
+		p.keyAlg = keyAlg
+		p.secret = conf.TSIGSecret
+	}
+	return p, nil
+}
+
+func (p *RFC2136) CreateRecord(rec Record) (*Record, error) {
+	rr, err := p.resource(rec)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.update(nil, rr); err != nil {
+		return nil, err
+	}
+	rec.ID = rrsetID(rec.Type, p.fqdn(rec.Name))
+	return &rec, nil
+}
+
+func (p *RFC2136) UpdateRecord(rec Record) error {
+	rr, err := p.resource(rec)
+	if err != nil {
+		return err
+	}
+	del, err := p.rrset(rec.ID)
+	if err != nil {
+		return err
+	}
+	return p.update(del, rr)
+}
+
+func (p *RFC2136) DeleteRecord(id string) error {
+	del, err := p.rrset(id)
+	if err != nil {
+		return err
+	}
+	return p.update(del, nil)
+}
+
+// rrsetID returns the record ID for an RRset.
+func rrsetID(rtype, name string) string {
+	return strings.ToUpper(rtype) + " " + name
+}
+
+// fqdn returns name as a fully qualified name in the zone, without the trailing dot.
+func (p *RFC2136) fqdn(name string) string {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, ".")
+	}
+	if name == p.zone || strings.HasSuffix(name, "."+p.zone) {
+		return name
+	}
+	return name + "." + p.zone
+}
+
+// resource returns the RR that adds rec.
+func (p *RFC2136) resource(rec Record) (dns.RR, error) {
+	hdr := dns.RR_Header{
+		Name:  dns.Fqdn(p.fqdn(rec.Name)),
+		Class: dns.ClassINET,
+		Ttl:   rfc2136TTL,
+	}
+	switch strings.ToUpper(rec.Type) {
+	case "CNAME":
+		hdr.Rrtype = dns.TypeCNAME
+		return &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(rec.Content)}, nil
+	case "TXT":
+		hdr.Rrtype = dns.TypeTXT
+		return &dns.TXT{Hdr: hdr, Txt: splitTXT(rec.Content)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", rec.Type)
+	}
+}
+
+// rrset returns an RR identifying the RRset with id.
+func (p *RFC2136) rrset(id string) (dns.RR, error) {
+	parts := strings.SplitN(id, " ", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid record id %s", id)
+	}
+	hdr := dns.RR_Header{Name: dns.Fqdn(parts[1])}
+	switch parts[0] {
+	case "CNAME":
+		hdr.Rrtype = dns.TypeCNAME
+	case "TXT":
+		hdr.Rrtype = dns.TypeTXT
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", parts[0])
+	}
+	return &dns.ANY{Hdr: hdr}, nil
+}
+
+// update sends an update that removes the RRset of del and inserts add, either of which may be nil.
+// The update is retried over TCP if the UDP response is truncated.
+func (p *RFC2136) update(del, add dns.RR) error {
+	m := new(dns.Msg)
+	m.SetUpdate(dns.Fqdn(p.zone))
+	if del != nil {
+		m.RemoveRRset([]dns.RR{del})
+	}
+	if add != nil {
+		m.Insert([]dns.RR{add})
+	}
+	res, err := p.exchange(m, "udp")
+	if err == nil && res.Truncated {
+		res, err = p.exchange(m, "tcp")
+	}
+	if err != nil {
+		return err
+	}
+	if res.Rcode != dns.RcodeSuccess {
+		name, ok := dns.RcodeToString[res.Rcode]
+		if !ok {
+			name = fmt.Sprintf("RCODE%d", res.Rcode)
+		}
+		return fmt.Errorf("dns update failed: %s", name)
+	}
+	return nil
+}
+
+// exchange sends m over network, signing it if a TSIG key is configured.
+// The client verifies signed responses, but unsigned responses must be rejected here.
+func (p *RFC2136) exchange(m *dns.Msg, network string) (*dns.Msg, error) {
+	c := &dns.Client{Net: network, Timeout: p.timeout}
+	if p.keyName != "" {
+		c.TsigSecret = map[string]string{p.keyName: p.secret}
+		// The signature is removed from m when it's sent, so it's added for each exchange
+		m.SetTsig(p.keyName, p.keyAlg, tsigFudge, time.Now().Unix())
+	}
+	res, _, err := c.Exchange(m, p.server)
+	if err != nil {
+		return nil, err
+	}
+	if p.keyName != "" && res.IsTsig() == nil {
+		return nil, errors.New("dns update response is not signed")
+	}
+	return res, nil
+}
+
+// splitTXT splits content into TXT strings of at most 255 bytes.
+func splitTXT(content string) []string {
+	var txt []string
+	for len(content) > 255 {
+		txt = append(txt, content[:255])
+		content = content[255:]
+	}
+	return append(txt, content)
+}
@@ -0,0 +1,60 @@
+package domains
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// CertConfig configures ACME certificate issuance for verified domains.
+type CertConfig struct {
+	// DirectoryURL is the ACME directory endpoint.
+	// Defaults to Let's Encrypt production.
+	DirectoryURL string
+	// Email is an optional contact address for the ACME account.
+	Email string
+	// CacheDir is where account keys and certificates are stored.
+	CacheDir string
+	// CACert is an optional PEM file used to trust the ACME server,
+	// e.g., when testing against a local Pebble server.
+	CACert string
+}
+
+// CertManager returns an autocert manager that issues certificates only for verified domains.
+func (m *Manager) CertManager(conf CertConfig) (*autocert.Manager, error) {
+	if conf.CacheDir == "" {
+		return nil, errors.New("cert cache dir is required")
+	}
+	client := &acme.Client{DirectoryURL: conf.DirectoryURL}
+	if conf.CACert != "" {
+		pem, err := ioutil.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in ca cert")
+		}
+		client.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+		}
+	}
+	return &autocert.Manager{
+		Prompt: autocert.AcceptTOS,
+		Cache:  autocert.DirCache(conf.CacheDir),
+		HostPolicy: func(ctx context.Context, host string) error {
+			return m.IsVerified(ctx, host)
+		},
+		Client: client,
+		Email:  conf.Email,
+	}, nil
+}
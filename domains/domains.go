@@ -0,0 +1,221 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	logging "github.com/ipfs/go-log/v2"
+	isd "github.com/jbenet/go-is-domain"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var log = logging.Logger("domains")
+
+const (
+	// ChallengePrefix is prepended to a domain to form the name of its TXT challenge record.
+	ChallengePrefix = "_textile-challenge."
+
+	// challengeLen is the length of the random TXT challenge value.
+	challengeLen = 32
+	// lookupCacheSize is the number of host lookups to cache.
+	lookupCacheSize = 1024
+	// lookupCacheTTL is how long a host lookup is cached.
+	lookupCacheTTL = time.Minute
+)
+
+var (
+	// ErrInvalidDomain indicates the domain name is not valid.
+	ErrInvalidDomain = errors.New("invalid domain name")
+	// ErrDomainTaken indicates the domain is already verified for another bucket.
+	ErrDomainTaken = errors.New("domain is already attached to another bucket")
+	// ErrNotFound indicates the domain is not attached to the bucket.
+	ErrNotFound = errors.New("domain not found")
+	// ErrChallengeFailed indicates the TXT challenge record was not found.
+	ErrChallengeFailed = errors.New("domain challenge record not found")
+)
+
+// Resolver looks up DNS TXT records.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Manager handles custom domains for buckets.
+type Manager struct {
+	col      *mdb.Domains
+	resolver Resolver
+	cache    *lru.Cache
+}
+
+// NewManager returns a new domain manager.
+// If resolver is nil, the system resolver is used.
+func NewManager(col *mdb.Domains, resolver Resolver, debug bool) (*Manager, error) {
+	if debug {
+		logging.SetLogLevel("domains", "debug")
+	}
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	cache, err := lru.New(lookupCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{col: col, resolver: resolver, cache: cache}, nil
+}
+
+// ChallengeName returns the name of the TXT record used to verify domain ownership.
+func ChallengeName(domain string) string {
+	return ChallengePrefix + domain
+}
+
+// Normalize lowercases domain and removes a trailing dot.
+func Normalize(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// Add attaches domain to the bucket and returns it with a new ownership challenge.
+// The domain is not served until it has been verified.
+func (m *Manager) Add(ctx context.Context, domain, key string, threadID thread.ID) (*mdb.Domain, error) {
+	domain = Normalize(domain)
+	if !isd.IsDomain(domain) {
+		return nil, ErrInvalidDomain
+	}
+	existing, err := m.col.Get(ctx, domain)
+	if err == nil {
+		if existing.Verified {
+			if existing.Key == key {
+				return existing, nil
+			}
+			return nil, ErrDomainTaken
+		}
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	return m.col.Create(ctx, domain, key, threadID, util.MakeToken(challengeLen))
+}
+
+// Verify checks the domain's TXT challenge record and marks it as verified.
+func (m *Manager) Verify(ctx context.Context, domain, key string) (*mdb.Domain, error) {
+	doc, err := m.get(ctx, domain, key)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Verified {
+		return doc, nil
+	}
+	records, err := m.resolver.LookupTXT(ctx, ChallengeName(doc.Name))
+	if err != nil {
+		log.Debugf("looking up challenge for %s: %v", doc.Name, err)
+		return nil, ErrChallengeFailed
+	}
+	var found bool
+	for _, r := range records {
+		if strings.TrimSpace(r) == doc.Challenge {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrChallengeFailed
+	}
+	if err := m.col.SetVerified(ctx, doc.Name); err != nil {
+		return nil, err
+	}
+	m.cache.Remove(doc.Name)
+	return m.col.Get(ctx, doc.Name)
+}
+
+// List returns the domains attached to the bucket.
+func (m *Manager) List(ctx context.Context, key string) ([]mdb.Domain, error) {
+	return m.col.ListByKey(ctx, key)
+}
+
+// Remove detaches domain from the bucket.
+func (m *Manager) Remove(ctx context.Context, domain, key string) error {
+	doc, err := m.get(ctx, domain, key)
+	if err != nil {
+		return err
+	}
+	if err := m.col.Delete(ctx, doc.Name); err != nil {
+		return err
+	}
+	m.cache.Remove(doc.Name)
+	return nil
+}
+
+// RemoveAll detaches all domains from the bucket.
+func (m *Manager) RemoveAll(ctx context.Context, key string) error {
+	docs, err := m.col.ListByKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := m.col.DeleteByKey(ctx, key); err != nil {
+		return err
+	}
+	for _, d := range docs {
+		m.cache.Remove(d.Name)
+	}
+	return nil
+}
+
+type lookupResult struct {
+	key     string
+	ok      bool
+	expires time.Time
+}
+
+// Lookup returns the bucket key served at host if host is a verified domain.
+func (m *Manager) Lookup(ctx context.Context, host string) (string, bool) {
+	host = Normalize(stripPort(host))
+	if v, ok := m.cache.Get(host); ok {
+		if res := v.(lookupResult); time.Now().Before(res.expires) {
+			return res.key, res.ok
+		}
+	}
+	res := lookupResult{expires: time.Now().Add(lookupCacheTTL)}
+	doc, err := m.col.Get(ctx, host)
+	if err == nil && doc.Verified {
+		res.key = doc.Key
+		res.ok = true
+	} else if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Errorf("looking up domain %s: %v", host, err)
+		return "", false
+	}
+	m.cache.Add(host, res)
+	return res.key, res.ok
+}
+
+// IsVerified returns an error if domain is not verified.
+func (m *Manager) IsVerified(ctx context.Context, domain string) error {
+	if _, ok := m.Lookup(ctx, domain); !ok {
+		return fmt.Errorf("%s: %w", domain, ErrNotFound)
+	}
+	return nil
+}
+
+func (m *Manager) get(ctx context.Context, domain, key string) (*mdb.Domain, error) {
+	doc, err := m.col.Get(ctx, Normalize(domain))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if doc.Key != key {
+		return nil, ErrNotFound
+	}
+	return doc, nil
+}
+
+func stripPort(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
@@ -0,0 +1,104 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-ds-mongo/test"
+	"github.com/textileio/go-threads/core/thread"
+	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestMain(m *testing.M) {
+	cleanup := func() {}
+	if os.Getenv("SKIP_SERVICES") != "true" {
+		cleanup = test.StartMongoDB()
+	}
+	exitVal := m.Run()
+	cleanup()
+	os.Exit(exitVal)
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "example.com", Normalize(" Example.COM. "))
+	assert.Equal(t, "_textile-challenge.example.com", ChallengeName("example.com"))
+	assert.Equal(t, "example.com", stripPort("example.com:8006"))
+	assert.Equal(t, "example.com", stripPort("example.com"))
+}
+
+func TestManager_Verify(t *testing.T) {
+	res := &fakeResolver{records: make(map[string][]string)}
+	m := newManager(t, res)
+	ctx := context.Background()
+
+	_, err := m.Add(ctx, "not a domain", "key", thread.NewIDV1(thread.Raw, 32))
+	require.True(t, errors.Is(err, ErrInvalidDomain))
+
+	d, err := m.Add(ctx, "Example.com", "key", thread.NewIDV1(thread.Raw, 32))
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", d.Name)
+	assert.NotEmpty(t, d.Challenge)
+
+	_, ok := m.Lookup(ctx, "example.com")
+	assert.False(t, ok, "unverified domains should not resolve")
+
+	_, err = m.Verify(ctx, "example.com", "key")
+	require.True(t, errors.Is(err, ErrChallengeFailed))
+
+	res.records[ChallengeName("example.com")] = []string{"other", d.Challenge}
+	_, err = m.Verify(ctx, "example.com", "other")
+	require.True(t, errors.Is(err, ErrNotFound))
+	d, err = m.Verify(ctx, "example.com", "key")
+	require.NoError(t, err)
+	assert.True(t, d.Verified)
+
+	key, ok := m.Lookup(ctx, "EXAMPLE.com:8006")
+	require.True(t, ok)
+	assert.Equal(t, "key", key)
+
+	_, err = m.Add(ctx, "example.com", "other", thread.NewIDV1(thread.Raw, 32))
+	require.True(t, errors.Is(err, ErrDomainTaken))
+
+	err = m.RemoveAll(ctx, "key")
+	require.NoError(t, err)
+	_, ok = m.Lookup(ctx, "example.com")
+	assert.False(t, ok)
+}
+
+func newManager(t *testing.T, res Resolver) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(test.GetMongoUri()))
+	require.NoError(t, err)
+	db := client.Database(util.MakeToken(12))
+	t.Cleanup(func() {
+		err := db.Drop(ctx)
+		require.NoError(t, err)
+		err = client.Disconnect(ctx)
+		require.NoError(t, err)
+		cancel()
+	})
+	col, err := mdb.NewDomains(ctx, db)
+	require.NoError(t, err)
+	m, err := NewManager(col, res, false)
+	require.NoError(t, err)
+	return m
+}
+
+type fakeResolver struct {
+	records map[string][]string
+}
+
+func (r *fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	recs, ok := r.records[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return recs, nil
+}
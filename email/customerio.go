@@ -0,0 +1,45 @@
+package email
+
+import (
+	"context"
+
+	cio "github.com/customerio/go-customerio"
+)
+
+// Customerio sends messages with the Customer.io transactional API.
+type Customerio struct {
+	client    *cio.APIClient
+	from      string
+	templates map[Kind]string
+}
+
+var _ Sender = (*Customerio)(nil)
+
+// NewCustomerio returns a Customer.io sender.
+// Messages with a transactional template ID in templates are rendered by Customer.io from the message data.
+// All other messages are sent with their locally rendered subject and bodies from address from.
+func NewCustomerio(apiKey, from string, templates map[Kind]string) *Customerio {
+	return &Customerio{
+		client:    cio.NewAPIClient(apiKey),
+		from:      from,
+		templates: templates,
+	}
+}
+
+func (s *Customerio) Send(ctx context.Context, msg *Message) error {
+	request := cio.SendEmailRequest{
+		To:          msg.To,
+		Identifiers: msg.Identifiers,
+	}
+	if tmpl := s.templates[msg.Kind]; tmpl != "" {
+		request.TransactionalMessageID = tmpl
+		request.MessageData = msg.Data
+	} else {
+		request.From = s.from
+		request.Subject = msg.Subject
+		request.Body = msg.HTML
+		request.PlaintextBody = msg.Text
+	}
+	_, err := s.client.SendEmail(ctx, &request)
+	return err
+}
@@ -4,24 +4,45 @@ import (
 	"context"
 	"fmt"
 
-	cio "github.com/customerio/go-customerio"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/go-threads/util"
+	tutil "github.com/textileio/textile/v2/util"
 )
 
 var log = logging.Logger("email")
 
+// Message is an email message.
+type Message struct {
+	// Kind is the type of message.
+	Kind Kind
+	// To is the recipient address.
+	To string
+	// Subject, Text, and HTML are rendered from the message kind's templates.
+	Subject string
+	Text    string
+	HTML    string
+	// Data is the template data.
+	Data map[string]interface{}
+	// Identifiers identify the recipient with senders that track people, e.g., Customer.io.
+	Identifiers map[string]string
+}
+
+// Sender delivers messages.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
 type Client struct {
-	inviteTmpl  string
-	confirmTmpl string
-	client      *cio.APIClient
+	sender    Sender
+	templates *Templates
 }
 
 type Config struct {
-	ConfirmTmpl string
-	InviteTmpl  string
-	APIKey      string
-	Debug       bool
+	// Sender delivers messages. If nil, messages are dropped.
+	Sender Sender
+	// TemplatesDir optionally overrides the built-in message templates.
+	TemplatesDir string
+	Debug        bool
 }
 
 func NewClient(conf Config) (*Client, error) {
@@ -33,64 +54,92 @@ func NewClient(conf Config) (*Client, error) {
 		}
 	}
 
-	var client *cio.APIClient
-	if conf.APIKey != "" {
-		client = cio.NewAPIClient(conf.APIKey)
+	templates, err := NewTemplates(conf.TemplatesDir)
+	if err != nil {
+		return nil, err
 	}
 	return &Client{
-		inviteTmpl:  conf.InviteTmpl,
-		confirmTmpl: conf.ConfirmTmpl,
-		client:      client,
+		sender:    conf.Sender,
+		templates: templates,
 	}, nil
 }
 
 // ConfirmAddress sends a confirmation link to a recipient.
 func (c *Client) ConfirmAddress(ctx context.Context, id, username, email, url, secret string) error {
-	if c.client == nil {
-		return nil
-	}
-	request := cio.SendEmailRequest{
-		To: email,
-		TransactionalMessageID: c.confirmTmpl,
+	if err := c.send(ctx, &Message{
+		Kind: KindConfirm,
+		To:   email,
 		Identifiers: map[string]string{
 			"id":           id,
 			"confirmation": "true",
 		},
-		MessageData: map[string]interface{}{
+		Data: map[string]interface{}{
 			"link":     fmt.Sprintf("%s/confirm/%s", url, secret),
 			"username": username,
 		},
-	}
-	if _, err := c.client.SendEmail(ctx, &request); err != nil {
+	}); err != nil {
 		return err
 	}
 
-	log.Debug("sent confirm address for %s to %s", username, email)
+	log.Debugf("sent confirm address for %s to %s", username, email)
 	return nil
 }
 
 // InviteAddress sends a confirmation link to a recipient.
 func (c *Client) InviteAddress(ctx context.Context, id, org, from, to, url, token string) error {
-	if c.client == nil {
-		return nil
-	}
-	request := cio.SendEmailRequest{
-		To: to,
-		TransactionalMessageID: c.inviteTmpl,
+	if err := c.send(ctx, &Message{
+		Kind: KindInvite,
+		To:   to,
 		Identifiers: map[string]string{
 			"id":    id,
 			"email": from,
 		},
-		MessageData: map[string]interface{}{
+		Data: map[string]interface{}{
 			"link": fmt.Sprintf("%s/consent/%s", url, token),
 			"org":  org,
 			"from": from,
 		},
+	}); err != nil {
+		return err
+	}
+
+	log.Debugf("sent invite to %s from %s to %s", org, from, to)
+	return nil
+}
+
+// QuotaWarning warns a recipient that their storage usage is approaching quota.
+func (c *Client) QuotaWarning(ctx context.Context, id, username, email, url string, used, quota int64) error {
+	var percent int64
+	if quota > 0 {
+		percent = used * 100 / quota
 	}
-	if _, err := c.client.SendEmail(ctx, &request); err != nil {
+	if err := c.send(ctx, &Message{
+		Kind: KindQuotaWarning,
+		To:   email,
+		Identifiers: map[string]string{
+			"id": id,
+		},
+		Data: map[string]interface{}{
+			"link":     fmt.Sprintf("%s/dashboard/%s", url, username),
+			"username": username,
+			"used":     tutil.ByteCountDecimal(used),
+			"quota":    tutil.ByteCountDecimal(quota),
+			"percent":  percent,
+		},
+	}); err != nil {
 		return err
 	}
 
-	log.Debug("sent invite to %s from %s to %s", org, from, to)
+	log.Debugf("sent quota warning for %s to %s", username, email)
 	return nil
 }
+
+func (c *Client) send(ctx context.Context, msg *Message) error {
+	if c.sender == nil {
+		return nil
+	}
+	if err := c.templates.Render(msg); err != nil {
+		return fmt.Errorf("rendering %s message: %v", msg.Kind, err)
+	}
+	return c.sender.Send(ctx, msg)
+}
@@ -0,0 +1,105 @@
+package email
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ConfirmAddress(t *testing.T) {
+	sender := &memSender{}
+	c, err := NewClient(Config{Sender: sender})
+	require.NoError(t, err)
+
+	err = c.ConfirmAddress(context.Background(), "id", "jane", "jane@example.com", "https://hub.example.com", "secret")
+	require.NoError(t, err)
+	require.Len(t, sender.msgs, 1)
+	msg := sender.msgs[0]
+	assert.Equal(t, KindConfirm, msg.Kind)
+	assert.Equal(t, "jane@example.com", msg.To)
+	assert.Equal(t, "Confirm your email address", msg.Subject)
+	assert.Contains(t, msg.Text, "https://hub.example.com/confirm/secret")
+	assert.Contains(t, msg.HTML, `href="https://hub.example.com/confirm/secret"`)
+}
+
+func TestClient_QuotaWarning(t *testing.T) {
+	sender := &memSender{}
+	c, err := NewClient(Config{Sender: sender})
+	require.NoError(t, err)
+
+	err = c.QuotaWarning(context.Background(), "id", "jane", "jane@example.com", "https://hub.example.com", 900, 1000)
+	require.NoError(t, err)
+	require.Len(t, sender.msgs, 1)
+	assert.Equal(t, "You've used 90% of your storage quota", sender.msgs[0].Subject)
+	assert.Contains(t, sender.msgs[0].Text, "900 B of your 1.0 kB")
+}
+
+func TestTemplates_Override(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "invite.subject"), []byte("Join {{.org}}!"), 0644)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "invite.html"), []byte("<b>{{.from}}</b>"), 0644)
+	require.NoError(t, err)
+
+	sender := &memSender{}
+	c, err := NewClient(Config{Sender: sender, TemplatesDir: dir})
+	require.NoError(t, err)
+	err = c.InviteAddress(context.Background(), "id", "acme", "<jane>", "joe@example.com", "https://hub.example.com", "token")
+	require.NoError(t, err)
+	msg := sender.msgs[0]
+	assert.Equal(t, "Join acme!", msg.Subject)
+	assert.Equal(t, "<b>&lt;jane&gt;</b>", msg.HTML)
+	assert.Contains(t, msg.Text, "https://hub.example.com/consent/token")
+
+	err = ioutil.WriteFile(filepath.Join(dir, "confirm.txt"), []byte("{{.link"), 0644)
+	require.NoError(t, err)
+	_, err = NewClient(Config{TemplatesDir: dir})
+	require.Error(t, err)
+}
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sender, err := NewFile(dir, "hub@example.com")
+	require.NoError(t, err)
+	c, err := NewClient(Config{Sender: sender})
+	require.NoError(t, err)
+	err = c.ConfirmAddress(context.Background(), "id", "jane", "jane@example.com", "https://hub.example.com", "secret")
+	require.NoError(t, err)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	body := string(data)
+	assert.True(t, strings.HasPrefix(body, "From: hub@example.com\r\nTo: jane@example.com\r\n"))
+	assert.Contains(t, body, "Content-Type: multipart/alternative")
+	assert.Contains(t, body, "Content-Type: text/html; charset=utf-8")
+}
+
+func TestNewClient_NoSender(t *testing.T) {
+	c, err := NewClient(Config{})
+	require.NoError(t, err)
+	err = c.ConfirmAddress(context.Background(), "id", "jane", "jane@example.com", "url", "secret")
+	require.NoError(t, err)
+}
+
+type memSender struct {
+	msgs []*Message
+}
+
+func (s *memSender) Send(_ context.Context, msg *Message) error {
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
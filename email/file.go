@@ -0,0 +1,50 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// File writes messages to a directory as .eml files for development and tests.
+// If the directory is empty, messages are only logged.
+type File struct {
+	dir  string
+	from string
+
+	lk  sync.Mutex
+	seq int
+}
+
+var _ Sender = (*File)(nil)
+
+// NewFile returns a sender that writes messages to dir.
+func NewFile(dir, from string) (*File, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+	return &File{dir: dir, from: from}, nil
+}
+
+func (s *File) Send(_ context.Context, msg *Message) error {
+	log.Infof("email %s to %s: %s\n%s", msg.Kind, msg.To, msg.Subject, msg.Text)
+	if s.dir == "" {
+		return nil
+	}
+	now := time.Now()
+	body, err := buildMIME(s.from, msg, now)
+	if err != nil {
+		return err
+	}
+	s.lk.Lock()
+	s.seq++
+	name := fmt.Sprintf("%d-%d-%s.eml", now.UnixNano(), s.seq, msg.Kind)
+	s.lk.Unlock()
+	return ioutil.WriteFile(filepath.Join(s.dir, name), body, 0644)
+}
@@ -0,0 +1,101 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// SMTPConfig configures an SMTP sender.
+type SMTPConfig struct {
+	// Addr is the host:port of the SMTP server.
+	Addr string
+	// Username and Password enable PLAIN authentication if set.
+	Username string
+	Password string
+	// From is the sender address.
+	From string
+}
+
+// SMTP sends messages through an SMTP server.
+// STARTTLS is used if the server supports it.
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+var _ Sender = (*SMTP)(nil)
+
+// NewSMTP returns an SMTP sender.
+func NewSMTP(conf SMTPConfig) (*SMTP, error) {
+	if conf.Addr == "" {
+		return nil, errors.New("smtp address is required")
+	}
+	if conf.From == "" {
+		return nil, errors.New("smtp from address is required")
+	}
+	host, _, err := net.SplitHostPort(conf.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing smtp address: %v", err)
+	}
+	s := &SMTP{addr: conf.Addr, from: conf.From}
+	if conf.Username != "" {
+		s.auth = smtp.PlainAuth("", conf.Username, conf.Password, host)
+	}
+	return s, nil
+}
+
+func (s *SMTP) Send(_ context.Context, msg *Message) error {
+	body, err := buildMIME(s.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(s.addr, s.auth, s.from, []string{msg.To}, body)
+}
+
+// buildMIME encodes msg as a multipart/alternative message with text and html parts.
+func buildMIME(from string, msg *Message, date time.Time) ([]byte, error) {
+	var bb [12]byte
+	if _, err := rand.Read(bb[:]); err != nil {
+		return nil, err
+	}
+	boundary := hex.EncodeToString(bb[:])
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", boundary)
+	for _, part := range []struct {
+		ctype string
+		body  string
+	}{
+		{"text/plain", msg.Text},
+		{"text/html", msg.HTML},
+	} {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n", part.ctype)
+		fmt.Fprintf(&buf, "Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		w := quotedprintable.NewWriter(&buf)
+		if _, err := w.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,148 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// Kind identifies a type of message.
+type Kind string
+
+const (
+	// KindConfirm asks a user to confirm their email address.
+	KindConfirm Kind = "confirm"
+	// KindInvite invites an email address to join an org.
+	KindInvite Kind = "invite"
+	// KindQuotaWarning warns a user that they are approaching their storage quota.
+	KindQuotaWarning Kind = "quota_warning"
+)
+
+// Kinds lists all message kinds.
+var Kinds = []Kind{KindConfirm, KindInvite, KindQuotaWarning}
+
+// defaultTemplates are the built-in subject, text, and html templates for each kind.
+var defaultTemplates = map[Kind][3]string{
+	KindConfirm: {
+		`Confirm your email address`,
+		`Hi {{.username}},
+
+Please confirm your email address to finish signing in:
+
+{{.link}}
+
+If you didn't request this, you can ignore this email.
+`,
+		`<p>Hi {{.username}},</p>
+<p>Please confirm your email address to finish signing in:</p>
+<p><a href="{{.link}}">Confirm email address</a></p>
+<p>If you didn't request this, you can ignore this email.</p>
+`,
+	},
+	KindInvite: {
+		`{{.from}} invited you to join {{.org}}`,
+		`Hi,
+
+{{.from}} invited you to join the {{.org}} organization.
+
+Accept the invitation:
+
+{{.link}}
+`,
+		`<p>Hi,</p>
+<p>{{.from}} invited you to join the <strong>{{.org}}</strong> organization.</p>
+<p><a href="{{.link}}">Accept the invitation</a></p>
+`,
+	},
+	KindQuotaWarning: {
+		`You've used {{.percent}}% of your storage quota`,
+		`Hi {{.username}},
+
+You've used {{.used}} of your {{.quota}} storage quota ({{.percent}}%).
+Requests that exceed the quota may be rejected.
+
+{{.link}}
+`,
+		`<p>Hi {{.username}},</p>
+<p>You've used <strong>{{.used}}</strong> of your {{.quota}} storage quota ({{.percent}}%).
+Requests that exceed the quota may be rejected.</p>
+<p><a href="{{.link}}">View usage</a></p>
+`,
+	},
+}
+
+// template file extensions for the subject, text, and html parts.
+var templateExts = [3]string{".subject", ".txt", ".html"}
+
+type templateSet struct {
+	subject *template.Template
+	text    *template.Template
+	html    *htmltemplate.Template
+}
+
+// Templates renders messages.
+type Templates struct {
+	sets map[Kind]templateSet
+}
+
+// NewTemplates returns the built-in templates.
+// If dir is not empty, files named <kind>.subject, <kind>.txt, and <kind>.html in dir override the built-in parts.
+func NewTemplates(dir string) (*Templates, error) {
+	t := &Templates{sets: make(map[Kind]templateSet)}
+	for _, kind := range Kinds {
+		parts := defaultTemplates[kind]
+		if dir != "" {
+			for i, ext := range templateExts {
+				data, err := ioutil.ReadFile(filepath.Join(dir, string(kind)+ext))
+				if os.IsNotExist(err) {
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				parts[i] = string(data)
+			}
+		}
+		var set templateSet
+		var err error
+		name := string(kind)
+		if set.subject, err = template.New(name).Parse(parts[0]); err != nil {
+			return nil, fmt.Errorf("parsing %s subject template: %v", kind, err)
+		}
+		if set.text, err = template.New(name).Parse(parts[1]); err != nil {
+			return nil, fmt.Errorf("parsing %s text template: %v", kind, err)
+		}
+		if set.html, err = htmltemplate.New(name).Parse(parts[2]); err != nil {
+			return nil, fmt.Errorf("parsing %s html template: %v", kind, err)
+		}
+		t.sets[kind] = set
+	}
+	return t, nil
+}
+
+// Render fills in the subject and bodies of msg from its kind and data.
+func (t *Templates) Render(msg *Message) error {
+	set, ok := t.sets[msg.Kind]
+	if !ok {
+		return fmt.Errorf("unknown message kind %s", msg.Kind)
+	}
+	var buf bytes.Buffer
+	if err := set.subject.Execute(&buf, msg.Data); err != nil {
+		return err
+	}
+	msg.Subject = buf.String()
+	buf.Reset()
+	if err := set.text.Execute(&buf, msg.Data); err != nil {
+		return err
+	}
+	msg.Text = buf.String()
+	buf.Reset()
+	if err := set.html.Execute(&buf, msg.Data); err != nil {
+		return err
+	}
+	msg.HTML = buf.String()
+	return nil
+}
@@ -1,27 +1,27 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"mime"
 	"net/http"
 	"path"
-	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	lru "github.com/hashicorp/golang-lru"
 	assets "github.com/textileio/go-assets"
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
 	"github.com/textileio/textile/v2/api/bucketsd/client"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 	"github.com/textileio/textile/v2/api/common"
 	"github.com/textileio/textile/v2/buckets"
+	"github.com/textileio/textile/v2/domains"
 	mdb "github.com/textileio/textile/v2/mongodb"
 	tdb "github.com/textileio/textile/v2/threaddb"
-	"github.com/textileio/textile/v2/util"
 )
 
 type fileSystem struct {
@@ -43,7 +43,7 @@ func (g *Gateway) renderBucket(c *gin.Context, ctx context.Context, threadID thr
 		renderError(c, http.StatusBadRequest, err)
 		return
 	}
-	links := make([]link, len(rep.Roots))
+	entries := make([]entry, len(rep.Roots))
 	for i, r := range rep.Roots {
 		var name string
 		if r.Name != "" {
@@ -55,20 +55,20 @@ func (g *Gateway) renderBucket(c *gin.Context, ctx context.Context, threadID thr
 		if token.Defined() {
 			p += "?token=" + string(token)
 		}
-		links[i] = link{
-			Name:  name,
-			Path:  p,
-			Size:  "",
-			Links: "",
+		entries[i] = entry{
+			Name: name,
+			Path: p,
+			Cid:  strings.TrimPrefix(r.Path, "/ipfs/"),
+			Type: entryDirectory,
 		}
 	}
-	c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
-		"Title":   "Index of " + path.Join("/thread", threadID.String(), buckets.CollectionName),
+	index := path.Join("/thread", threadID.String(), buckets.CollectionName)
+	renderListing(c, listing{Path: index}, entries, gin.H{
+		"Title":   "Index of " + index,
 		"Root":    "/",
 		"Path":    "",
 		"Updated": "",
 		"Back":    "",
-		"Links":   links,
 	})
 }
 
@@ -84,7 +84,18 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 		return
 	}
 	if !rep.Item.IsDir {
-		if err := g.buckets.PullPath(ctx, buck.Key, pth, c.Writer); err != nil {
+		setDownload(c, rep.Item.Name)
+		pull := func(w io.Writer) error {
+			return g.buckets.PullPath(ctx, buck.Key, pth, w)
+		}
+		// Decrypted and token-gated content is never cached
+		var err error
+		if token.Defined() || buck.IsPrivate() {
+			err = pull(c.Writer)
+		} else {
+			err = g.cache.writeBucketContent(buck.Key, rep.Root.Path, pth, rep.Item.Size, c.Writer, pull)
+		}
+		if err != nil {
 			render404(c)
 		}
 	} else {
@@ -94,18 +105,24 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 		} else {
 			base = path.Join("thread", threadID.String(), buckets.CollectionName)
 		}
-		var links []link
-		for _, item := range rep.Item.Items {
+		entries := make([]entry, len(rep.Item.Items))
+		for i, item := range rep.Item.Items {
 			pth := path.Join(base, strings.Replace(item.Path, rep.Root.Path, rep.Root.Key, 1))
 			if token.Defined() {
 				pth += "?token=" + string(token)
 			}
-			links = append(links, link{
+			typ := entryFile
+			if item.IsDir {
+				typ = entryDirectory
+			}
+			entries[i] = entry{
 				Name:  item.Name,
 				Path:  pth,
-				Size:  util.ByteCountDecimal(item.Size),
-				Links: strconv.Itoa(len(item.Items)),
-			})
+				Cid:   item.Cid,
+				Size:  item.Size,
+				Type:  typ,
+				Items: len(item.Items),
+			}
 		}
 		var name string
 		if rep.Root.Name != "" {
@@ -118,13 +135,12 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 		if token.Defined() {
 			back += "?token=" + string(token)
 		}
-		c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
+		renderListing(c, listing{Path: "/" + root, Cid: rep.Item.Cid}, entries, gin.H{
 			"Title":   "Index of /" + root,
 			"Root":    "/" + root,
 			"Path":    rep.Item.Path,
 			"Updated": time.Unix(0, rep.Root.UpdatedAt).String(),
 			"Back":    back,
-			"Links":   links,
 		})
 	}
 }
@@ -133,25 +149,49 @@ type serveBucketFS interface {
 	GetThread(ctx context.Context, key string) (thread.ID, error)
 	Exists(ctx context.Context, bucket, pth string) (bool, string)
 	Write(ctx context.Context, bucket, pth string, writer io.Writer) error
+	Website(ctx context.Context, bucket string) (*website, error)
 	ValidHost() string
+	CustomDomain(ctx context.Context, host string) (string, bool)
 }
 
 type bucketFS struct {
 	client  *client.Client
 	keys    *mdb.IPNSKeys
+	domains *domains.Manager
+	cache   *cache
 	session string
 	host    string
+	sites   *lru.Cache
+}
+
+// websiteCacheSize is the number of bucket website configs kept in memory.
+const websiteCacheSize = 1024
+
+func newBucketFS(client *client.Client, keys *mdb.IPNSKeys, dm *domains.Manager, c *cache, session, host string) *bucketFS {
+	sites, _ := lru.New(websiteCacheSize)
+	return &bucketFS{
+		client:  client,
+		keys:    keys,
+		domains: dm,
+		cache:   c,
+		session: session,
+		host:    host,
+		sites:   sites,
+	}
 }
 
 func serveBucket(fs serveBucketFS) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+		defer cancel()
 		key, err := bucketFromHost(c.Request.Host, fs.ValidHost())
 		if err != nil {
-			return
+			var ok bool
+			if key, ok = fs.CustomDomain(ctx, c.Request.Host); !ok {
+				return
+			}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
-		defer cancel()
 		threadID, err := fs.GetThread(ctx, key)
 		if err != nil {
 			return
@@ -162,33 +202,19 @@ func serveBucket(fs serveBucketFS) gin.HandlerFunc {
 			ctx = thread.NewTokenContext(ctx, token)
 		}
 
-		exists, target := fs.Exists(ctx, key, c.Request.URL.Path)
-		if exists {
-			c.Writer.WriteHeader(http.StatusOK)
-			ctype := mime.TypeByExtension(filepath.Ext(c.Request.URL.Path))
-			if ctype == "" {
-				ctype = "application/octet-stream"
-			}
-			c.Writer.Header().Set("Content-Type", ctype)
-			if err := fs.Write(ctx, key, c.Request.URL.Path, c.Writer); err != nil {
-				renderError(c, http.StatusInternalServerError, err)
-			} else {
-				c.Abort()
-			}
-		} else if target != "" {
-			content := path.Join(c.Request.URL.Path, target)
-			ctype := mime.TypeByExtension(filepath.Ext(content))
-			c.Writer.WriteHeader(http.StatusOK)
-			c.Writer.Header().Set("Content-Type", ctype)
-			if err := fs.Write(ctx, key, content, c.Writer); err != nil {
-				renderError(c, http.StatusInternalServerError, err)
-			} else {
-				c.Abort()
-			}
+		if serveWebsite(c, ctx, fs, key) {
+			c.Abort()
 		}
 	}
 }
 
+func (f *bucketFS) CustomDomain(ctx context.Context, host string) (string, bool) {
+	if f.domains == nil {
+		return "", false
+	}
+	return f.domains.Lookup(ctx, host)
+}
+
 func (f *bucketFS) GetThread(ctx context.Context, bkey string) (id thread.ID, err error) {
 	key, err := f.keys.GetByCid(ctx, bkey)
 	if err != nil {
@@ -198,17 +224,19 @@ func (f *bucketFS) GetThread(ctx context.Context, bkey string) (id thread.ID, er
 }
 
 func (f *bucketFS) Exists(ctx context.Context, key, pth string) (ok bool, name string) {
-	if key == "" || pth == "/" {
+	if key == "" {
 		return
 	}
-	ctx = common.NewSessionContext(ctx, f.session)
-	rep, err := f.client.ListPath(ctx, key, pth)
+	if pth == "/" {
+		pth = ""
+	}
+	rep, err := f.listPath(ctx, key, pth)
 	if err != nil {
 		return
 	}
 	if rep.Item.IsDir {
 		for _, item := range rep.Item.Items {
-			if item.Name == "index.html" {
+			if item.Name == indexFile {
 				return false, item.Name
 			}
 		}
@@ -219,7 +247,119 @@ func (f *bucketFS) Exists(ctx context.Context, key, pth string) (ok bool, name s
 
 func (f *bucketFS) Write(ctx context.Context, key, pth string, writer io.Writer) error {
 	ctx = common.NewSessionContext(ctx, f.session)
-	return f.client.PullPath(ctx, key, pth, writer)
+	pull := func(w io.Writer) error {
+		return f.client.PullPath(ctx, key, pth, w)
+	}
+	// Decrypted and token-gated content is never cached
+	if token, _ := thread.TokenFromContext(ctx); token.Defined() {
+		return pull(writer)
+	}
+	item, root, ok := f.cachedItem(key, pth)
+	if !ok || root.LinkKey != "" {
+		return pull(writer)
+	}
+	return f.cache.writeBucketContent(key, root.Path, pth, item.Size, writer, pull)
+}
+
+// cachedItem returns the item at a bucket path from cached listings of the path or its parent,
+// which are populated by Exists.
+func (f *bucketFS) cachedItem(key, pth string) (*pb.PathItem, *pb.Root, bool) {
+	if rep, ok := f.cache.getPath(key, pth); ok {
+		return rep.Item, rep.Root, rep.Root != nil
+	}
+	dir := path.Dir(pth)
+	if dir == "/" || dir == "." {
+		dir = ""
+	}
+	rep, ok := f.cache.getPath(key, dir)
+	if !ok || rep.Root == nil {
+		return nil, nil, false
+	}
+	for _, item := range rep.Item.Items {
+		if item.Name == path.Base(pth) {
+			return item, rep.Root, true
+		}
+	}
+	return nil, nil, false
+}
+
+// listPath returns the listing of a bucket path.
+// Listings are cached for requests without a thread token.
+func (f *bucketFS) listPath(ctx context.Context, key, pth string) (*pb.ListPathResponse, error) {
+	ctx = common.NewSessionContext(ctx, f.session)
+	id, ok := common.ThreadIDFromContext(ctx)
+	if token, _ := thread.TokenFromContext(ctx); token.Defined() || !ok {
+		return f.client.ListPath(ctx, key, pth)
+	}
+	if rep, ok := f.cache.getPath(key, pth); ok {
+		return rep, nil
+	}
+	version := f.cache.pathVersion()
+	rep, err := f.client.ListPath(ctx, key, pth)
+	if err != nil {
+		return nil, err
+	}
+	f.cache.addPath(id, key, pth, rep, version)
+	return rep, nil
+}
+
+// Website returns the website config for a bucket.
+// Configs are cached with bucket listings if the bucket's thread is watched for changes,
+// which avoids listing the bucket root on every request. Otherwise, they're cached until
+// the bucket root changes.
+func (f *bucketFS) Website(ctx context.Context, key string) (*website, error) {
+	if site, ok := f.cache.getWebsite(key); ok {
+		return site, nil
+	}
+	ctx = common.NewSessionContext(ctx, f.session)
+	version := f.cache.pathVersion()
+	rep, err := f.listPath(ctx, key, "")
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := f.sites.Get(key); ok && v.(*website).root == rep.Root.Path {
+		f.addWebsite(ctx, key, v.(*website), version)
+		return v.(*website), nil
+	}
+	site := &website{root: rep.Root.Path}
+	for _, item := range rep.Item.Items {
+		if item.IsDir {
+			continue
+		}
+		switch item.Name {
+		case notFoundFile:
+			site.notFound = true
+		case redirectsFile, headersFile:
+			if item.Size > maxWebsiteConfigSize {
+				return nil, fmt.Errorf("%s exceeds max size of %d bytes", item.Name, maxWebsiteConfigSize)
+			}
+			var buf bytes.Buffer
+			if err := f.client.PullPath(ctx, key, item.Name, &buf); err != nil {
+				return nil, err
+			}
+			if item.Name == redirectsFile {
+				site.redirects, err = parseRedirects(buf.Bytes())
+			} else {
+				site.headers, err = parseHeaders(buf.Bytes())
+			}
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %v", item.Name, err)
+			}
+		}
+	}
+	f.sites.Add(key, site)
+	f.addWebsite(ctx, key, site, version)
+	return site, nil
+}
+
+// addWebsite caches a website config with the bucket listings.
+// Configs loaded with a thread token aren't cached, like listings.
+func (f *bucketFS) addWebsite(ctx context.Context, key string, site *website, version int64) {
+	id, ok := common.ThreadIDFromContext(ctx)
+	if token, _ := thread.TokenFromContext(ctx); token.Defined() || !ok {
+		return
+	}
+	f.cache.addWebsite(id, key, site, version)
 }
 
 func (f *bucketFS) ValidHost() string {
@@ -251,10 +391,13 @@ func (g *Gateway) renderWWWBucket(c *gin.Context, key string) {
 		render404(c)
 		return
 	}
+	if serveWebsite(c, ctx, g.bucketFS, buck.Key) {
+		return
+	}
 	for _, item := range rep.Item.Items {
-		if item.Name == "index.html" {
-			c.Writer.WriteHeader(http.StatusOK)
+		if item.Name == indexFile {
 			c.Writer.Header().Set("Content-Type", "text/html")
+			c.Writer.WriteHeader(http.StatusOK)
 			if err := g.buckets.PullPath(ctx, buck.Key, item.Name, c.Writer); err != nil {
 				render404(c)
 			}
@@ -0,0 +1,410 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+const (
+	// maxCacheEntryDivisor bounds a single content entry to a fraction of the cache size.
+	maxCacheEntryDivisor = 16
+	// resolvedCacheSize is the number of immutable IPFS path resolutions kept in memory.
+	resolvedCacheSize = 16384
+	// bucketCacheSize is the number of buckets with cached paths.
+	bucketCacheSize = 4096
+	// maxBucketPaths is the number of cached paths per bucket.
+	maxBucketPaths = 1024
+	// maxWatchedThreads is the number of threads listened to for bucket changes.
+	maxWatchedThreads = 1024
+	// watchRetryInterval is how long to wait before listening to a thread again after a failure.
+	watchRetryInterval = time.Minute
+)
+
+// listenFunc listens for bucket changes in a thread.
+// The returned channel receives changed bucket keys and is closed when listening stops.
+type listenFunc func(ctx context.Context, id thread.ID) (<-chan string, error)
+
+// cache holds gateway responses in memory.
+// IPFS content is keyed by cid, which makes entries immutable. Bucket content is keyed by
+// bucket key, root, and path, so decrypted bucket files are never served for IPFS paths.
+// Bucket path listings are only cached for threads with an active listener,
+// which invalidates them when buckets change.
+// A nil cache is valid and caches nothing.
+type cache struct {
+	maxSize  int64
+	maxEntry int64
+
+	lk      sync.Mutex
+	content *simplelru.LRU
+	size    int64
+
+	resolved *lru.Cache
+	buckets  *lru.Cache
+
+	listen    listenFunc
+	ctx       context.Context
+	cancel    context.CancelFunc
+	watchLock sync.Mutex
+	watched   map[thread.ID]bool
+	failed    map[thread.ID]time.Time
+
+	contentHits   int64
+	contentMisses int64
+	pathHits      int64
+	pathMisses    int64
+	evictions     int64
+	invalidations int64
+}
+
+// bucketPaths are the cached path listings and website config of a bucket.
+type bucketPaths struct {
+	thread thread.ID
+	lk     sync.Mutex
+	paths  map[string]*pb.ListPathResponse
+	site   *website
+}
+
+// bucketContentKey is the content cache key of a bucket file.
+type bucketContentKey struct {
+	key  string
+	root string
+	path string
+}
+
+// cacheStats are cache hit and miss counts and sizes.
+type cacheStats struct {
+	ContentHits    int64 `json:"content_hits"`
+	ContentMisses  int64 `json:"content_misses"`
+	ContentEntries int   `json:"content_entries"`
+	ContentBytes   int64 `json:"content_bytes"`
+	PathHits       int64 `json:"path_hits"`
+	PathMisses     int64 `json:"path_misses"`
+	Evictions      int64 `json:"evictions"`
+	Invalidations  int64 `json:"invalidations"`
+	WatchedThreads int   `json:"watched_threads"`
+}
+
+// newCache returns a cache bounded to size bytes of content.
+// It returns nil if size is not positive.
+func newCache(size int64, listen listenFunc) *cache {
+	if size <= 0 {
+		return nil
+	}
+	c := &cache{
+		maxSize:  size,
+		maxEntry: size / maxCacheEntryDivisor,
+		listen:   listen,
+		watched:  make(map[thread.ID]bool),
+		failed:   make(map[thread.ID]time.Time),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	// The content LRU isn't bounded by count, eviction is handled by size in addContent
+	c.content, _ = simplelru.NewLRU(int(^uint(0)>>1), func(_, v interface{}) {
+		c.size -= int64(len(v.([]byte)))
+		atomic.AddInt64(&c.evictions, 1)
+	})
+	c.resolved, _ = lru.New(resolvedCacheSize)
+	c.buckets, _ = lru.New(bucketCacheSize)
+	return c
+}
+
+// getContent returns the cached content for a cid.
+func (c *cache) getContent(id string) ([]byte, bool) {
+	if c == nil || id == "" {
+		return nil, false
+	}
+	return c.get(id)
+}
+
+// canAddContent returns whether content of size bytes would be cached.
+func (c *cache) canAddContent(size int64) bool {
+	return c != nil && size <= c.maxEntry
+}
+
+// addContent caches the content for a cid, evicting the least recently used content as needed.
+func (c *cache) addContent(id string, data []byte) {
+	if c == nil || id == "" {
+		return
+	}
+	c.add(id, data)
+}
+
+// writeContent writes the content of cid id to w from the cache.
+// On a miss, pull writes the content, which is cached if size allows.
+func (c *cache) writeContent(id string, size int64, w io.Writer, pull func(io.Writer) error) error {
+	if c == nil || id == "" {
+		return pull(w)
+	}
+	return c.write(id, size, w, pull)
+}
+
+// writeBucketContent writes the content of a bucket file at root to w from the cache.
+// On a miss, pull writes the content, which is cached if size allows.
+// Only content that's readable without a thread token should be written through the cache.
+func (c *cache) writeBucketContent(key, root, pth string, size int64, w io.Writer, pull func(io.Writer) error) error {
+	if c == nil || key == "" || root == "" {
+		return pull(w)
+	}
+	return c.write(bucketContentKey{key: key, root: root, path: pth}, size, w, pull)
+}
+
+func (c *cache) get(k interface{}) ([]byte, bool) {
+	c.lk.Lock()
+	v, ok := c.content.Get(k)
+	c.lk.Unlock()
+	if !ok {
+		atomic.AddInt64(&c.contentMisses, 1)
+		return nil, false
+	}
+	atomic.AddInt64(&c.contentHits, 1)
+	return v.([]byte), true
+}
+
+func (c *cache) add(k interface{}, data []byte) {
+	if !c.canAddContent(int64(len(data))) {
+		return
+	}
+	c.lk.Lock()
+	defer c.lk.Unlock()
+	if c.content.Contains(k) {
+		return
+	}
+	c.content.Add(k, data)
+	c.size += int64(len(data))
+	for c.size > c.maxSize {
+		c.content.RemoveOldest()
+	}
+}
+
+func (c *cache) write(k interface{}, size int64, w io.Writer, pull func(io.Writer) error) error {
+	if data, ok := c.get(k); ok {
+		_, err := w.Write(data)
+		return err
+	}
+	if !c.canAddContent(size) {
+		return pull(w)
+	}
+	var buf bytes.Buffer
+	if err := pull(&buf); err != nil {
+		return err
+	}
+	c.add(k, buf.Bytes())
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// getResolved returns the cached cid of an immutable IPFS path.
+func (c *cache) getResolved(pth string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	v, ok := c.resolved.Get(pth)
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// addResolved caches the cid of an immutable IPFS path.
+func (c *cache) addResolved(pth, id string) {
+	if c == nil {
+		return
+	}
+	c.resolved.Add(pth, id)
+}
+
+// getPath returns the cached listing of a bucket path.
+func (c *cache) getPath(key, pth string) (*pb.ListPathResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if v, ok := c.buckets.Get(key); ok {
+		b := v.(*bucketPaths)
+		b.lk.Lock()
+		rep, ok := b.paths[pth]
+		b.lk.Unlock()
+		if ok {
+			atomic.AddInt64(&c.pathHits, 1)
+			return rep, true
+		}
+	}
+	atomic.AddInt64(&c.pathMisses, 1)
+	return nil, false
+}
+
+// pathVersion returns a version that changes whenever a bucket is invalidated.
+// Pass it to addPath to avoid caching a listing fetched before an invalidation.
+func (c *cache) pathVersion() int64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&c.invalidations)
+}
+
+// addPath caches the listing of a bucket path in thread id.
+// Listings are only cached if the thread is being watched for changes
+// and no bucket was invalidated since version.
+func (c *cache) addPath(id thread.ID, key, pth string, rep *pb.ListPathResponse, version int64) {
+	b, ok := c.bucket(id, key, version)
+	if !ok {
+		return
+	}
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	if len(b.paths) >= maxBucketPaths {
+		b.paths = make(map[string]*pb.ListPathResponse)
+	}
+	b.paths[pth] = rep
+}
+
+// getWebsite returns the cached website config of a bucket.
+func (c *cache) getWebsite(key string) (*website, bool) {
+	if c == nil {
+		return nil, false
+	}
+	v, ok := c.buckets.Get(key)
+	if !ok {
+		return nil, false
+	}
+	b := v.(*bucketPaths)
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	return b.site, b.site != nil
+}
+
+// addWebsite caches the website config of a bucket in thread id.
+// Like listings, configs are only cached if the thread is being watched for changes
+// and no bucket was invalidated since version.
+func (c *cache) addWebsite(id thread.ID, key string, site *website, version int64) {
+	b, ok := c.bucket(id, key, version)
+	if !ok {
+		return
+	}
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	b.site = site
+}
+
+// bucket returns the cached entries of a bucket in thread id, adding them if needed.
+// It returns false if the bucket can't be cached.
+func (c *cache) bucket(id thread.ID, key string, version int64) (*bucketPaths, bool) {
+	if c == nil || !c.watch(id) || c.pathVersion() != version {
+		return nil, false
+	}
+	b := &bucketPaths{
+		thread: id,
+		paths:  make(map[string]*pb.ListPathResponse),
+	}
+	if v, ok, _ := c.buckets.PeekOrAdd(key, b); ok {
+		b = v.(*bucketPaths)
+	}
+	return b, true
+}
+
+// invalidateBucket removes the cached listings and website config of a bucket.
+func (c *cache) invalidateBucket(key string) {
+	if c == nil {
+		return
+	}
+	atomic.AddInt64(&c.invalidations, 1)
+	c.buckets.Remove(key)
+}
+
+// invalidateThread removes the cached listings of all buckets in thread id.
+func (c *cache) invalidateThread(id thread.ID) {
+	for _, k := range c.buckets.Keys() {
+		if v, ok := c.buckets.Peek(k); ok && v.(*bucketPaths).thread == id {
+			c.invalidateBucket(k.(string))
+		}
+	}
+}
+
+// watch returns whether thread id is being watched for bucket changes.
+// If not, a listener is started and subsequent calls return true once it's running.
+func (c *cache) watch(id thread.ID) bool {
+	if c.listen == nil {
+		return false
+	}
+	c.watchLock.Lock()
+	defer c.watchLock.Unlock()
+	if ready, ok := c.watched[id]; ok {
+		return ready
+	}
+	if len(c.watched) >= maxWatchedThreads || c.ctx.Err() != nil {
+		return false
+	}
+	if t, ok := c.failed[id]; ok && time.Since(t) < watchRetryInterval {
+		return false
+	}
+	c.watched[id] = false
+	go c.watchThread(id)
+	return false
+}
+
+func (c *cache) watchThread(id thread.ID) {
+	defer func() {
+		c.watchLock.Lock()
+		delete(c.watched, id)
+		c.watchLock.Unlock()
+		c.invalidateThread(id)
+	}()
+	changes, err := c.listen(c.ctx, id)
+	if err != nil {
+		log.Debugf("listening for bucket changes in %s: %v", id, err)
+		c.watchLock.Lock()
+		if len(c.failed) >= maxWatchedThreads {
+			c.failed = make(map[thread.ID]time.Time)
+		}
+		c.failed[id] = time.Now()
+		c.watchLock.Unlock()
+		return
+	}
+	c.watchLock.Lock()
+	c.watched[id] = true
+	delete(c.failed, id)
+	c.watchLock.Unlock()
+	for key := range changes {
+		c.invalidateBucket(key)
+	}
+}
+
+// stats returns cache statistics.
+func (c *cache) stats() cacheStats {
+	if c == nil {
+		return cacheStats{}
+	}
+	c.lk.Lock()
+	entries, size := c.content.Len(), c.size
+	c.lk.Unlock()
+	c.watchLock.Lock()
+	watched := len(c.watched)
+	c.watchLock.Unlock()
+	return cacheStats{
+		ContentHits:    atomic.LoadInt64(&c.contentHits),
+		ContentMisses:  atomic.LoadInt64(&c.contentMisses),
+		ContentEntries: entries,
+		ContentBytes:   size,
+		PathHits:       atomic.LoadInt64(&c.pathHits),
+		PathMisses:     atomic.LoadInt64(&c.pathMisses),
+		Evictions:      atomic.LoadInt64(&c.evictions),
+		Invalidations:  atomic.LoadInt64(&c.invalidations),
+		WatchedThreads: watched,
+	}
+}
+
+// close stops all listeners.
+func (c *cache) close() {
+	if c == nil {
+		return
+	}
+	c.cancel()
+}
@@ -0,0 +1,186 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+func TestCache_Nil(t *testing.T) {
+	c := newCache(0, nil)
+	require.Nil(t, c)
+
+	c.addContent("a", []byte("data"))
+	_, ok := c.getContent("a")
+	assert.False(t, ok)
+	_, ok = c.getPath("key", "")
+	assert.False(t, ok)
+	assert.Equal(t, cacheStats{}, c.stats())
+
+	var buf bytes.Buffer
+	err := c.writeContent("a", 4, &buf, func(w io.Writer) error {
+		_, err := w.Write([]byte("data"))
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "data", buf.String())
+	c.close()
+}
+
+func TestCache_Content(t *testing.T) {
+	c := newCache(64, nil)
+	defer c.close()
+
+	// Entries larger than a sixteenth of the cache are skipped
+	c.addContent("big", make([]byte, 5))
+	_, ok := c.getContent("big")
+	assert.False(t, ok)
+
+	for i := 0; i < 20; i++ {
+		c.addContent(fmt.Sprintf("%d", i), make([]byte, 4))
+	}
+	s := c.stats()
+	assert.Equal(t, 16, s.ContentEntries)
+	assert.Equal(t, int64(64), s.ContentBytes)
+	assert.Equal(t, int64(4), s.Evictions)
+
+	// The oldest entries were evicted
+	_, ok = c.getContent("0")
+	assert.False(t, ok)
+	_, ok = c.getContent("19")
+	assert.True(t, ok)
+	s = c.stats()
+	assert.Equal(t, int64(1), s.ContentHits)
+	assert.Equal(t, int64(2), s.ContentMisses)
+}
+
+func TestCache_WriteContent(t *testing.T) {
+	c := newCache(1024, nil)
+	defer c.close()
+
+	var pulls int
+	pull := func(w io.Writer) error {
+		pulls++
+		_, err := w.Write([]byte("hello"))
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, c.writeContent("a", 5, &buf, pull))
+		assert.Equal(t, "hello", buf.String())
+	}
+	assert.Equal(t, 1, pulls)
+
+	// Content too large to cache is always pulled
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, c.writeContent("b", 1024, &buf, pull))
+	}
+	assert.Equal(t, 3, pulls)
+
+	// Bucket content is only served for the same bucket root and path
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, c.writeBucketContent("key", "/ipfs/root", "file", 5, &buf, pull))
+		assert.Equal(t, "hello", buf.String())
+	}
+	assert.Equal(t, 4, pulls)
+	var buf bytes.Buffer
+	require.NoError(t, c.writeBucketContent("key", "/ipfs/root2", "file", 5, &buf, pull))
+	assert.Equal(t, 5, pulls)
+	_, ok := c.getContent("file")
+	assert.False(t, ok)
+}
+
+func TestCache_Paths(t *testing.T) {
+	changes := make(chan string)
+	listening := make(chan struct{})
+	c := newCache(1024, func(ctx context.Context, id thread.ID) (<-chan string, error) {
+		close(listening)
+		return changes, nil
+	})
+	defer c.close()
+
+	id := thread.NewIDV1(thread.Raw, 32)
+	rep := &pb.ListPathResponse{Item: &pb.PathItem{Name: "file"}}
+
+	// Paths aren't cached until the thread is being watched
+	c.addPath(id, "key", "file", rep, c.pathVersion())
+	_, ok := c.getPath("key", "file")
+	assert.False(t, ok)
+	<-listening
+	require.Eventually(t, func() bool {
+		return c.watch(id)
+	}, time.Second, time.Millisecond*10)
+
+	c.addPath(id, "key", "file", rep, c.pathVersion())
+	cached, ok := c.getPath("key", "file")
+	require.True(t, ok)
+	assert.Equal(t, rep, cached)
+	site := &website{notFound: true}
+	c.addWebsite(id, "key", site, c.pathVersion())
+	cachedSite, ok := c.getWebsite("key")
+	require.True(t, ok)
+	assert.Equal(t, site, cachedSite)
+
+	// A listing fetched before an invalidation isn't cached
+	version := c.pathVersion()
+	changes <- "other"
+	require.Eventually(t, func() bool {
+		return c.pathVersion() != version
+	}, time.Second, time.Millisecond*10)
+	c.addPath(id, "other", "file", rep, version)
+	_, ok = c.getPath("other", "file")
+	assert.False(t, ok)
+
+	// Bucket changes invalidate its paths and website
+	changes <- "key"
+	require.Eventually(t, func() bool {
+		_, ok := c.getPath("key", "file")
+		return !ok
+	}, time.Second, time.Millisecond*10)
+	_, ok = c.getWebsite("key")
+	assert.False(t, ok)
+
+	s := c.stats()
+	assert.Equal(t, int64(1), s.PathHits)
+	assert.Equal(t, 1, s.WatchedThreads)
+
+	// Paths are dropped when listening stops
+	c.addPath(id, "key", "file", rep, c.pathVersion())
+	close(changes)
+	require.Eventually(t, func() bool {
+		_, ok := c.getPath("key", "file")
+		return !ok && c.stats().WatchedThreads == 0
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestCache_WatchFailure(t *testing.T) {
+	var calls int
+	failed := make(chan struct{})
+	c := newCache(1024, func(ctx context.Context, id thread.ID) (<-chan string, error) {
+		calls++
+		close(failed)
+		return nil, fmt.Errorf("listen failed")
+	})
+	defer c.close()
+
+	id := thread.NewIDV1(thread.Raw, 32)
+	assert.False(t, c.watch(id))
+	<-failed
+	require.Eventually(t, func() bool {
+		return c.stats().WatchedThreads == 0
+	}, time.Second, time.Millisecond*10)
+
+	// Failed threads aren't retried right away
+	assert.False(t, c.watch(id))
+	assert.Equal(t, 1, calls)
+}
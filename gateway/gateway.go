@@ -29,7 +29,10 @@ import (
 	tutil "github.com/textileio/go-threads/util"
 	bucketsclient "github.com/textileio/textile/v2/api/bucketsd/client"
 	"github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/buckets"
+	"github.com/textileio/textile/v2/domains"
 	mdb "github.com/textileio/textile/v2/mongodb"
+	"github.com/textileio/textile/v2/oidc"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
 )
@@ -53,7 +56,9 @@ type link struct {
 // Gateway provides HTTP-based access to Textile.
 type Gateway struct {
 	server        *http.Server
+	tlsServer     *http.Server
 	addr          ma.Multiaddr
+	tlsAddr       ma.Multiaddr
 	url           string
 	subdomains    bool
 	bucketsDomain string
@@ -62,6 +67,10 @@ type Gateway struct {
 	apiSession  string
 	threads     *threadsclient.Client
 	buckets     *bucketsclient.Client
+	bucketFS    *bucketFS
+	cache       *cache
+	domains     *domains.Manager
+	acme        domains.CertConfig
 	hub         bool
 
 	ipfs iface.CoreAPI
@@ -82,6 +91,15 @@ type Config struct {
 	EmailSessionBus *broadcast.Broadcaster
 	Hub             bool
 	Debug           bool
+
+	// CacheSize is the max size in bytes of cached content. Zero disables caching.
+	CacheSize int64
+
+	// Domains enables serving bucket websites at verified custom domains.
+	Domains *domains.Manager
+	// TLSAddr enables HTTPS for custom domains with certificates issued by ACME.
+	TLSAddr ma.Multiaddr
+	ACME    domains.CertConfig
 }
 
 // NewGateway returns a new gateway.
@@ -110,8 +128,9 @@ func NewGateway(conf Config) (*Gateway, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Gateway{
+	g := &Gateway{
 		addr:            conf.Addr,
+		tlsAddr:         conf.TLSAddr,
 		url:             conf.URL,
 		subdomains:      conf.Subdomains,
 		bucketsDomain:   conf.BucketsDomain,
@@ -119,10 +138,39 @@ func NewGateway(conf Config) (*Gateway, error) {
 		apiSession:      conf.APISession,
 		threads:         tc,
 		buckets:         bc,
+		domains:         conf.Domains,
+		acme:            conf.ACME,
 		hub:             conf.Hub,
 		ipfs:            conf.IPFSClient,
 		emailSessionBus: conf.EmailSessionBus,
-	}, nil
+	}
+	g.cache = newCache(conf.CacheSize, g.listenBuckets)
+	g.bucketFS = newBucketFS(bc, conf.Collections.IPNSKeys, conf.Domains, g.cache, conf.APISession, conf.BucketsDomain)
+	return g, nil
+}
+
+// listenBuckets returns a channel of bucket keys that change in thread id.
+func (g *Gateway) listenBuckets(ctx context.Context, id thread.ID) (<-chan string, error) {
+	ctx = common.NewSessionContext(ctx, g.apiSession)
+	events, err := g.threads.Listen(ctx, id, []threadsclient.ListenOption{{
+		Type:       threadsclient.ListenAll,
+		Collection: buckets.CollectionName,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	changes := make(chan string)
+	go func() {
+		defer close(changes)
+		for e := range events {
+			if e.Err != nil {
+				log.Debugf("listening for bucket changes in %s: %v", id, e.Err)
+				return
+			}
+			changes <- e.Action.InstanceID
+		}
+	}()
+	return changes, nil
 }
 
 // Start the gateway.
@@ -141,17 +189,15 @@ func (g *Gateway) Start() {
 
 	router.Use(location.Default())
 	router.Use(static.Serve("", &fileSystem{Assets}))
-	router.Use(serveBucket(&bucketFS{
-		client:  g.buckets,
-		keys:    g.collections.IPNSKeys,
-		session: g.apiSession,
-		host:    g.bucketsDomain,
-	}))
+	router.Use(serveBucket(g.bucketFS))
 	router.Use(gincors.New(cors.Options{}))
 
 	router.GET("/health", func(c *gin.Context) {
 		c.Writer.WriteHeader(http.StatusNoContent)
 	})
+	router.GET("/health/cache", func(c *gin.Context) {
+		c.JSON(http.StatusOK, g.cache.stats())
+	})
 
 	router.GET("/thread/:thread/:collection", g.subdomainOptionHandler, g.collectionHandler)
 	router.GET("/thread/:thread/:collection/:id", g.subdomainOptionHandler, g.instanceHandler)
@@ -169,13 +215,19 @@ func (g *Gateway) Start() {
 		router.GET("/dashboard/:username", g.dashboardHandler)
 		router.GET("/confirm/:secret", g.confirmEmail)
 		router.GET("/consent/:invite", g.consentInvite)
+		router.GET(oidc.CallbackPath, g.ssoCallback)
 	}
 
 	router.NoRoute(g.subdomainHandler)
 
+	var handler http.Handler = router
+	if g.tlsAddr != nil && g.domains != nil {
+		handler = g.startTLS(router)
+	}
+
 	g.server = &http.Server{
 		Addr:    addr,
-		Handler: router,
+		Handler: handler,
 	}
 	go func() {
 		if err := g.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -186,6 +238,32 @@ func (g *Gateway) Start() {
 	log.Infof("gateway listening at %s", g.server.Addr)
 }
 
+// startTLS starts an HTTPS server for verified custom domains.
+// The returned handler answers ACME HTTP challenges and falls back to router.
+func (g *Gateway) startTLS(router http.Handler) http.Handler {
+	addr, err := tutil.TCPAddrFromMultiAddr(g.tlsAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := g.domains.CertManager(g.acme)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.tlsServer = &http.Server{
+		Addr:      addr,
+		Handler:   router,
+		TLSConfig: m.TLSConfig(),
+	}
+	go func() {
+		if err := g.tlsServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("gateway tls error: %s", err)
+		}
+		log.Info("gateway tls was shutdown")
+	}()
+	log.Infof("gateway tls listening at %s", g.tlsServer.Addr)
+	return m.HTTPHandler(router)
+}
+
 // loadTemplate loads HTML templates.
 func loadTemplate() (*template.Template, error) {
 	t := template.New("")
@@ -212,11 +290,17 @@ func (g *Gateway) Addr() string {
 
 // Stop the gateway.
 func (g *Gateway) Stop() error {
+	g.cache.close()
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 	if err := g.server.Shutdown(ctx); err != nil {
 		return err
 	}
+	if g.tlsServer != nil {
+		if err := g.tlsServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
 	if err := g.threads.Close(); err != nil {
 		return err
 	}
@@ -249,7 +333,18 @@ func (g *Gateway) dashboardHandler(c *gin.Context) {
 }
 
 // confirmEmail verifies an emailed secret.
+// The hub is notified so it can complete pending logins.
 func (g *Gateway) confirmEmail(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	defer cancel()
+	if err := g.collections.Logins.Confirm(ctx, c.Param("secret")); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			render404(c)
+			return
+		}
+		renderError(c, http.StatusInternalServerError, err)
+		return
+	}
 	if err := g.emailSessionBus.Send(c.Param("secret")); err != nil {
 		renderError(c, http.StatusInternalServerError, err)
 		return
@@ -257,6 +352,35 @@ func (g *Gateway) confirmEmail(c *gin.Context) {
 	c.HTML(http.StatusOK, "/public/html/confirm.gohtml", nil)
 }
 
+// ssoCallback receives the result of a single sign-on from an identity provider.
+// The result is saved with the sign on state so the client can complete it with SSOSignin.
+func (g *Gateway) ssoCallback(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	defer cancel()
+	state := c.Query("state")
+	errMsg := c.Query("error")
+	if desc := c.Query("error_description"); desc != "" {
+		errMsg += ": " + desc
+	}
+	if state == "" || (c.Query("code") == "" && errMsg == "") {
+		renderError(c, http.StatusBadRequest, fmt.Errorf("invalid sign on response"))
+		return
+	}
+	if err := g.collections.SSOStates.SetResult(ctx, state, c.Query("code"), errMsg); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			renderError(c, http.StatusNotFound, fmt.Errorf("sign on not found"))
+			return
+		}
+		renderError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if errMsg != "" {
+		renderError(c, http.StatusUnauthorized, fmt.Errorf("sign on failed: %s", errMsg))
+		return
+	}
+	c.HTML(http.StatusOK, "/public/html/sso.gohtml", nil)
+}
+
 // consentInvite marks an invite as accepted.
 // If the associated email belongs to an existing user, they will be added to the org.
 func (g *Gateway) consentInvite(c *gin.Context) {
@@ -280,9 +404,17 @@ func (g *Gateway) consentInvite(c *gin.Context) {
 			}
 			return
 		}
-		dev, err := g.collections.Accounts.GetByUsernameOrEmail(ctx, invite.EmailTo)
+		dev, err := g.collections.Accounts.GetByUsernameOrEmail(ctx, invite.To())
 		if err != nil {
 			if errors.Is(err, mongo.ErrNoDocuments) {
+				if invite.UsernameTo != "" { // Only email invites can wait for a signup
+					if err := g.collections.Invites.Delete(ctx, invite.Token); err != nil {
+						renderError(c, http.StatusInternalServerError, err)
+					} else {
+						renderError(c, http.StatusNotFound, fmt.Errorf("user not found"))
+					}
+					return
+				}
 				if err := g.collections.Invites.Accept(ctx, invite.Token); err != nil {
 					renderError(c, http.StatusInternalServerError, err)
 				}
@@ -317,17 +449,25 @@ func (g *Gateway) consentInvite(c *gin.Context) {
 	}
 	c.HTML(http.StatusOK, "/public/html/consent.gohtml", gin.H{
 		"Org":   invite.Org,
-		"Email": invite.EmailTo,
+		"Email": invite.To(),
 	})
 }
 
-// render404 renders the 404 template.
+// render404 renders the 404 template, or a JSON error if the client asked for JSON.
 func render404(c *gin.Context) {
+	if wantsJSON(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": http.StatusText(http.StatusNotFound)})
+		return
+	}
 	c.HTML(http.StatusNotFound, "/public/html/404.gohtml", nil)
 }
 
-// renderError renders the error template.
+// renderError renders the error template, or a JSON error if the client asked for JSON.
 func renderError(c *gin.Context, code int, err error) {
+	if wantsJSON(c) {
+		c.JSON(code, gin.H{"error": err.Error()})
+		return
+	}
 	c.HTML(code, "/public/html/error.gohtml", gin.H{
 		"Code":  code,
 		"Error": formatError(err),
@@ -353,6 +493,11 @@ func (g *Gateway) subdomainHandler(c *gin.Context) {
 		g.renderWWWBucket(c, key)
 		return
 	}
+	// Render buckets at verified custom domains
+	if bkey, ok := g.bucketFS.CustomDomain(c.Request.Context(), c.Request.Host); ok {
+		g.renderWWWBucket(c, bkey)
+		return
+	}
 
 	if len(parts) < 3 {
 		render404(c)
@@ -365,6 +510,10 @@ func (g *Gateway) subdomainHandler(c *gin.Context) {
 	}
 	switch ns {
 	case "ipfs":
+		if format := trustlessFormat(c); format != "" {
+			g.renderTrustless(c, strings.TrimSuffix("/ipfs/"+key+c.Request.URL.Path, "/"), format)
+			return
+		}
 		g.renderIPFSPath(c, "ipfs/"+key, "/ipfs/"+key+c.Request.URL.Path)
 	case "ipns":
 		g.renderIPNSKey(c, key, c.Request.URL.Path)
@@ -7,19 +7,30 @@ import (
 	"net/http"
 	gopath "path"
 	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/render"
+	"github.com/ipfs/go-cid"
 	files "github.com/ipfs/go-ipfs-files"
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	"github.com/ipfs/go-unixfs"
+	upb "github.com/ipfs/go-unixfs/pb"
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/libp2p/go-libp2p-core/peer"
-	"github.com/textileio/textile/v2/util"
 )
 
 func (g *Gateway) ipfsHandler(c *gin.Context) {
 	base := fmt.Sprintf("ipfs/%s", c.Param("root"))
 	pth := fmt.Sprintf("/%s%s", base, c.Param("path"))
+	// Trustless responses and listings are negotiated with the Accept header
+	c.Header("Vary", "Accept")
+	if format := trustlessFormat(c); format != "" {
+		g.renderTrustless(c, strings.TrimSuffix(pth, "/"), format)
+		return
+	}
 	g.renderIPFSPath(c, base, pth)
 }
 
@@ -48,18 +59,15 @@ func (g *Gateway) renderIPFSPath(c *gin.Context, base, pth string) {
 			}
 			lctx, lcancel := context.WithTimeout(context.Background(), handlerTimeout)
 			defer lcancel()
-			ilinks, err := g.ipfs.Object().Links(lctx, path.New(pth))
+			opts, err := parseListOptions(c)
 			if err != nil {
-				renderError(c, http.StatusNotFound, err)
+				renderError(c, http.StatusBadRequest, err)
 				return
 			}
-			var links []link
-			for _, l := range ilinks {
-				links = append(links, link{
-					Name: l.Name,
-					Path: gopath.Join(dir, l.Name),
-					Size: util.ByteCountDecimal(int64(l.Size)),
-				})
+			entries, err := g.lsPath(lctx, path.New(pth), dir, opts)
+			if err != nil {
+				renderError(c, http.StatusNotFound, err)
+				return
 			}
 			var index string
 			if strings.HasPrefix(base, "ipns") {
@@ -67,28 +75,202 @@ func (g *Gateway) renderIPFSPath(c *gin.Context, base, pth string) {
 			} else {
 				index = gopath.Join(root, dir)
 			}
+			var dirCid string
+			if rp, err := g.ipfs.ResolvePath(lctx, path.New(pth)); err == nil {
+				dirCid = rp.Cid().String()
+			}
 			if !g.subdomains {
 				dir = strings.TrimPrefix(strings.Replace(dir, base, "", 1), "/")
 			}
-			params := gin.H{
+			renderListing(c, listing{Path: index, Cid: dirCid}, entries, gin.H{
 				"Title":   "Index of " + index,
 				"Root":    "/" + dir,
 				"Path":    pth,
 				"Updated": "",
 				"Back":    strings.TrimPrefix(back, "/"),
-				"Links":   links,
-			}
-			c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", params)
+			})
 		} else {
 			renderError(c, http.StatusBadRequest, err)
 			return
 		}
 	} else {
+		setDownload(c, gopath.Base(pth))
 		c.Render(200, render.Data{Data: data})
 	}
 }
 
+// lsConcurrency is the number of directory entries resolved in parallel.
+const lsConcurrency = 16
+
+// lsPath returns the entries of the directory at pth linked under dir.
+// Entries are read from the directory links, including those in HAMT shards, so children
+// aren't fetched and sizes are the cumulative sizes of the links. Only the entries on the
+// page requested by opts are resolved for their type, unless all are needed to sort by type.
+func (g *Gateway) lsPath(ctx context.Context, pth path.Path, dir string, opts listOptions) ([]entry, error) {
+	resolved, err := g.ipfs.ResolvePath(ctx, pth)
+	if err != nil {
+		return nil, err
+	}
+	links, err := dirLinks(ctx, g.ipfs.Dag(), resolved.Cid())
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]entry, len(links))
+	for i, l := range links {
+		entries[i] = entry{
+			Name: l.Name,
+			Path: gopath.Join(dir, l.Name),
+			Cid:  l.Cid.String(),
+			Size: int64(l.Size),
+		}
+		if l.Cid.Type() == cid.Raw {
+			entries[i].Type = entryFile
+		}
+	}
+	page := entries
+	if opts.sort != "type" {
+		// The page is a slice of entries, so they're updated in place
+		page = opts.page(entries)
+	}
+	if err := resolveEntries(ctx, g.ipfs.Dag(), page); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// dirLinks returns the links of the UnixFS directory c without fetching its children.
+// The links of HAMT sharded directories are collected from all of their shards.
+func dirLinks(ctx context.Context, ng ipld.NodeGetter, c cid.Cid) ([]*ipld.Link, error) {
+	n, err := ng.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fsn, err := unixfsNode(n)
+	if err != nil {
+		return nil, err
+	}
+	switch fsn.Type() {
+	case upb.Data_Directory:
+		return n.Links(), nil
+	case upb.Data_HAMTShard:
+		padLen := shardPadLen(fsn)
+		var links []*ipld.Link
+		for _, l := range n.Links() {
+			if len(l.Name) == padLen {
+				sub, err := dirLinks(ctx, ng, l.Cid)
+				if err != nil {
+					return nil, err
+				}
+				links = append(links, sub...)
+				continue
+			}
+			links = append(links, &ipld.Link{Name: l.Name[padLen:], Cid: l.Cid, Size: l.Size})
+		}
+		return links, nil
+	default:
+		return nil, iface.ErrNotSupported
+	}
+}
+
+// resolveEntries fetches the root blocks of entries to set their type,
+// and the size of files.
+func resolveEntries(ctx context.Context, ng ipld.NodeGetter, entries []entry) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg   sync.WaitGroup
+		lk   sync.Mutex
+		lerr error
+		sem  = make(chan struct{}, lsConcurrency)
+	)
+	for i := range entries {
+		if entries[i].Type != "" {
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(e *entry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := resolveEntry(ctx, ng, e)
+			if err != nil {
+				lk.Lock()
+				if lerr == nil {
+					lerr = err
+					cancel()
+				}
+				lk.Unlock()
+			}
+		}(&entries[i])
+	}
+	wg.Wait()
+	return lerr
+}
+
+func resolveEntry(ctx context.Context, ng ipld.NodeGetter, e *entry) error {
+	c, err := cid.Decode(e.Cid)
+	if err != nil {
+		return err
+	}
+	n, err := ng.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	fsn, err := unixfsNode(n)
+	if err != nil {
+		return err
+	}
+	switch fsn.Type() {
+	case upb.Data_Directory, upb.Data_HAMTShard:
+		e.Type = entryDirectory
+	case upb.Data_Symlink:
+		e.Type = entrySymlink
+		e.Size = int64(len(fsn.Data()))
+	default:
+		e.Type = entryFile
+		e.Size = int64(fsn.FileSize())
+	}
+	return nil
+}
+
+// unixfsNode returns the UnixFS data of a dag-pb node.
+func unixfsNode(n ipld.Node) (*unixfs.FSNode, error) {
+	pn, ok := n.(*dag.ProtoNode)
+	if !ok {
+		return nil, iface.ErrNotSupported
+	}
+	return unixfs.FSNodeFromBytes(pn.Data())
+}
+
+// openPath returns the file at pth.
+// Files at immutable paths are served from the cache if possible.
 func (g *Gateway) openPath(ctx context.Context, pth path.Path) ([]byte, error) {
+	if g.cache == nil || pth.Mutable() {
+		return g.readPath(ctx, pth)
+	}
+	id, ok := g.cache.getResolved(pth.String())
+	if !ok {
+		resolved, err := g.ipfs.ResolvePath(ctx, pth)
+		if err != nil {
+			return nil, err
+		}
+		id = resolved.Cid().String()
+		g.cache.addResolved(pth.String(), id)
+	}
+	if data, ok := g.cache.getContent(id); ok {
+		return data, nil
+	}
+	data, err := g.readPath(ctx, path.New("/ipfs/"+id))
+	if err != nil {
+		return nil, err
+	}
+	g.cache.addContent(id, data)
+	return data, nil
+}
+
+func (g *Gateway) readPath(ctx context.Context, pth path.Path) ([]byte, error) {
 	f, err := g.ipfs.Unixfs().Get(ctx, pth)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	mdtest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipfs/go-unixfs"
+	"github.com/ipfs/go-unixfs/hamt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirLinks(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+
+	file := dag.NewRawNode([]byte("hello"))
+	sub := unixfs.EmptyDirNode()
+	dir := unixfs.EmptyDirNode()
+	require.NoError(t, dir.AddNodeLink("file", file))
+	require.NoError(t, dir.AddNodeLink("sub", sub))
+	require.NoError(t, ds.AddMany(ctx, []ipld.Node{file, sub, dir}))
+
+	links, err := dirLinks(ctx, ds, dir.Cid())
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, "file", links[0].Name)
+	assert.Equal(t, uint64(5), links[0].Size)
+
+	// Children aren't fetched until they're resolved
+	entries := []entry{
+		{Name: "file", Cid: file.Cid().String(), Type: entryFile, Size: 5},
+		{Name: "sub", Cid: sub.Cid().String()},
+	}
+	require.NoError(t, resolveEntries(ctx, ds, entries))
+	assert.Equal(t, entryDirectory, entries[1].Type)
+
+	// Sharded directories are listed from all shards
+	shard, err := hamt.NewShard(ds, 256)
+	require.NoError(t, err)
+	var names []string
+	for i := 0; i < 500; i++ {
+		name := fmt.Sprintf("file%d", i)
+		names = append(names, name)
+		require.NoError(t, shard.Set(ctx, name, file))
+	}
+	nd, err := shard.Node()
+	require.NoError(t, err)
+	links, err = dirLinks(ctx, ds, nd.Cid())
+	require.NoError(t, err)
+	var got []string
+	for _, l := range links {
+		assert.Equal(t, file.Cid(), l.Cid)
+		got = append(got, l.Name)
+	}
+	sort.Strings(names)
+	sort.Strings(got)
+	assert.Equal(t, names, got)
+}
@@ -0,0 +1,204 @@
+package gateway
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/textile/v2/util"
+)
+
+const (
+	// defaultListLimit is the default number of entries in a directory listing page.
+	defaultListLimit = 1000
+	// maxListLimit is the maximum number of entries in a directory listing page.
+	maxListLimit = 10000
+)
+
+// Entry types.
+const (
+	entryFile      = "file"
+	entryDirectory = "directory"
+	entrySymlink   = "symlink"
+)
+
+// entry is a directory listing entry.
+type entry struct {
+	Name string `json:"name"`
+	// Path is the gateway URL path of the entry.
+	Path string `json:"path"`
+	Cid  string `json:"cid,omitempty"`
+	Size int64  `json:"size"`
+	Type string `json:"type"`
+	// Items is the number of entries in a directory.
+	Items int `json:"items,omitempty"`
+}
+
+// listing is a directory listing page.
+type listing struct {
+	Path    string  `json:"path"`
+	Cid     string  `json:"cid,omitempty"`
+	Entries []entry `json:"entries"`
+	Total   int     `json:"total"`
+	Offset  int     `json:"offset"`
+	Limit   int     `json:"limit"`
+}
+
+// listOptions control sorting and pagination of directory listings.
+type listOptions struct {
+	sort   string
+	desc   bool
+	offset int
+	limit  int
+}
+
+// parseListOptions reads listing options from the query params sort, order, offset and limit.
+func parseListOptions(c *gin.Context) (opts listOptions, err error) {
+	opts.sort = c.DefaultQuery("sort", "name")
+	switch opts.sort {
+	case "name", "size", "type":
+	default:
+		return opts, fmt.Errorf("invalid sort %s (options: name, size, type)", opts.sort)
+	}
+	switch order := c.DefaultQuery("order", "asc"); order {
+	case "asc":
+	case "desc":
+		opts.desc = true
+	default:
+		return opts, fmt.Errorf("invalid order %s (options: asc, desc)", order)
+	}
+	if opts.offset, err = queryInt(c, "offset", 0); err != nil {
+		return
+	}
+	if opts.limit, err = queryInt(c, "limit", defaultListLimit); err != nil {
+		return
+	}
+	if opts.limit < 1 || opts.limit > maxListLimit {
+		return opts, fmt.Errorf("limit must be between 1 and %d", maxListLimit)
+	}
+	return opts, nil
+}
+
+func queryInt(c *gin.Context, key string, def int) (int, error) {
+	v := c.Query(key)
+	if v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		return 0, fmt.Errorf("invalid %s %s", key, v)
+	}
+	return i, nil
+}
+
+// page sorts entries and returns the requested page.
+func (o listOptions) page(entries []entry) []entry {
+	less := func(a, b entry) bool {
+		switch o.sort {
+		case "size":
+			if a.Size != b.Size {
+				return a.Size < b.Size
+			}
+		case "type":
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+		}
+		return a.Name < b.Name
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if o.desc {
+			return less(entries[j], entries[i])
+		}
+		return less(entries[i], entries[j])
+	})
+	if o.offset >= len(entries) {
+		return []entry{}
+	}
+	end := o.offset + o.limit
+	if end > len(entries) {
+		end = len(entries)
+	}
+	return entries[o.offset:end]
+}
+
+// wantsJSON returns whether the client asked for JSON with ?format=json or the Accept header.
+func wantsJSON(c *gin.Context) bool {
+	switch c.Query("format") {
+	case "json":
+		return true
+	case "html":
+		return false
+	}
+	return c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON
+}
+
+// renderListing renders a page of entries as JSON or with the unixfs template.
+// params are added to the template params.
+func renderListing(c *gin.Context, l listing, entries []entry, params gin.H) {
+	opts, err := parseListOptions(c)
+	if err != nil {
+		renderError(c, http.StatusBadRequest, err)
+		return
+	}
+	l.Total = len(entries)
+	l.Offset = opts.offset
+	l.Limit = opts.limit
+	l.Entries = opts.page(entries)
+	if wantsJSON(c) {
+		for i := range l.Entries {
+			l.Entries[i].Path = "/" + l.Entries[i].Path
+		}
+		c.JSON(http.StatusOK, l)
+		return
+	}
+	links := make([]link, len(l.Entries))
+	for i, e := range l.Entries {
+		var size string
+		if e.Type != entryDirectory || e.Size > 0 {
+			size = util.ByteCountDecimal(e.Size)
+		}
+		links[i] = link{
+			Name:  e.Name,
+			Path:  e.Path,
+			Size:  size,
+			Links: strconv.Itoa(e.Items),
+		}
+	}
+	params["Links"] = links
+	if opts.offset > 0 {
+		prev := opts.offset - opts.limit
+		if prev < 0 {
+			prev = 0
+		}
+		params["Prev"] = pageQuery(c, prev)
+	}
+	if opts.offset+opts.limit < l.Total {
+		params["Next"] = pageQuery(c, opts.offset+opts.limit)
+	}
+	c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", params)
+}
+
+// pageQuery returns the request query with offset replaced.
+func pageQuery(c *gin.Context, offset int) string {
+	q := c.Request.URL.Query()
+	q.Set("offset", strconv.Itoa(offset))
+	return "?" + q.Encode()
+}
+
+// setDownload forces the response to be saved as a file named name if the request has ?download=true.
+func setDownload(c *gin.Context, name string) {
+	if c.Query("download") != "true" {
+		return
+	}
+	name = strings.Trim(name, "/")
+	if name == "" {
+		c.Header("Content-Disposition", "attachment")
+		return
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+}
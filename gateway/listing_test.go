@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderListing_JSON(t *testing.T) {
+	entries := []entry{
+		{Name: "b", Path: "dir/b", Size: 30, Type: entryFile},
+		{Name: "a", Path: "dir/a", Size: 10, Type: entryFile},
+		{Name: "c", Path: "dir/c", Size: 20, Type: entryDirectory},
+	}
+	get := func(target, accept string) (*httptest.ResponseRecorder, listing) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		if accept != "" {
+			c.Request.Header.Set("Accept", accept)
+		}
+		renderListing(c, listing{Path: "/dir"}, append([]entry{}, entries...), gin.H{})
+		var l listing
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &l))
+		}
+		return w, l
+	}
+
+	_, l := get("/dir?format=json", "")
+	assert.Equal(t, 3, l.Total)
+	require.Len(t, l.Entries, 3)
+	assert.Equal(t, "a", l.Entries[0].Name)
+	assert.Equal(t, "/dir/a", l.Entries[0].Path)
+
+	_, l = get("/dir?sort=size&order=desc&offset=1&limit=1", "application/json")
+	require.Len(t, l.Entries, 1)
+	assert.Equal(t, "c", l.Entries[0].Name)
+	assert.Equal(t, 1, l.Offset)
+	assert.Equal(t, 1, l.Limit)
+
+	w, _ := get("/dir?format=json&sort=color", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"error"`)
+}
+
+func TestSetDownload(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/file?download=true", nil)
+	setDownload(c, "my report.pdf")
+	assert.Equal(t, `attachment; filename="my report.pdf"`, w.Header().Get("Content-Disposition"))
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/file", nil)
+	setDownload(c, "report.pdf")
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+}
@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/gin-gonic/gin"
+	coredb "github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/db"
+)
+
+const (
+	// maxQueryLimit is the maximum number of instances returned by a collection query.
+	maxQueryLimit = 1000
+	// idField is the instance ID field name.
+	idField = "_id"
+	// maxSchemaRefs is the maximum number of schema references followed when resolving a field.
+	maxSchemaRefs = 32
+)
+
+// queryOps are the supported where operators, longest first so that >= isn't read as >.
+var queryOps = []string{">=", "<=", "!=", "=", ">", "<"}
+
+// parseQuery builds a collection query from the query params where, sort, limit, skip and seek.
+//
+// where may be repeated and is of the form field<op>value, where op is one of =, !=, >, >=, < or <=.
+// Values are parsed as booleans or numbers if possible, and can be quoted to force a string.
+// sort is a field name, prefixed with - for descending order.
+func parseQuery(c *gin.Context) (*db.Query, error) {
+	q := &db.Query{}
+	for _, w := range c.QueryArray("where") {
+		if err := addCriterion(q, w); err != nil {
+			return nil, err
+		}
+	}
+	if s := c.Query("sort"); s != "" {
+		desc := strings.HasPrefix(s, "-")
+		field := strings.TrimPrefix(s, "-")
+		switch {
+		case field == "":
+			return nil, fmt.Errorf("invalid sort %s", s)
+		case field == idField && desc:
+			q.OrderByIDDesc()
+		case field == idField:
+			q.OrderByID()
+		case desc:
+			q.OrderByDesc(field)
+		default:
+			q.OrderBy(field)
+		}
+	}
+	limit, err := queryInt(c, "limit", 0)
+	if err != nil {
+		return nil, err
+	}
+	if limit > maxQueryLimit {
+		return nil, fmt.Errorf("limit must be at most %d", maxQueryLimit)
+	}
+	skip, err := queryInt(c, "skip", 0)
+	if err != nil {
+		return nil, err
+	}
+	q.LimitTo(limit).SkipNum(skip)
+	if seek := c.Query("seek"); seek != "" {
+		q.SeekID(coredb.InstanceID(seek))
+	}
+	return q, nil
+}
+
+// addCriterion adds a where condition like age>30 or name=bob to q.
+func addCriterion(q *db.Query, w string) error {
+	i := strings.IndexAny(w, "=!<>")
+	if i < 1 {
+		return fmt.Errorf("invalid where %s", w)
+	}
+	field, rest := w[:i], w[i:]
+	for _, op := range queryOps {
+		if !strings.HasPrefix(rest, op) {
+			continue
+		}
+		crit := q.And(field)
+		v := parseValue(strings.TrimPrefix(rest, op))
+		switch op {
+		case "=":
+			crit.Eq(v)
+		case "!=":
+			crit.Ne(v)
+		case ">":
+			crit.Gt(v)
+		case ">=":
+			crit.Ge(v)
+		case "<":
+			crit.Lt(v)
+		case "<=":
+			crit.Le(v)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid where %s", w)
+}
+
+// parseValue returns s as a bool, float64, or string.
+func parseValue(s string) interface{} {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	return s
+}
+
+// checkSortField returns an error if field isn't a string, number, or boolean in schema.
+// Threads can't sort instances by values of mixed types.
+func checkSortField(schema *jsonschema.Schema, field string) error {
+	if schema == nil || schema.Type == nil {
+		return fmt.Errorf("collection has no schema to sort by %s", field)
+	}
+	t := schema.Type
+	for _, p := range strings.Split(field, ".") {
+		t = resolveSchemaRef(schema, t)
+		if t == nil || t.Properties[p] == nil {
+			return fmt.Errorf("sort field %s is not in the collection schema", field)
+		}
+		t = t.Properties[p]
+	}
+	if t = resolveSchemaRef(schema, t); t == nil {
+		return fmt.Errorf("sort field %s is not in the collection schema", field)
+	}
+	switch t.Type {
+	case "string", "number", "integer", "boolean":
+		return nil
+	default:
+		return fmt.Errorf("sort field %s must be a string, number, or boolean", field)
+	}
+}
+
+// resolveSchemaRef returns the schema definition t refers to, or t if it isn't a reference.
+func resolveSchemaRef(schema *jsonschema.Schema, t *jsonschema.Type) *jsonschema.Type {
+	for i := 0; t != nil && t.Ref != ""; i++ {
+		if i == maxSchemaRefs {
+			return nil
+		}
+		name := strings.TrimPrefix(t.Ref, "#/definitions/")
+		if name == t.Ref {
+			return nil
+		}
+		t = schema.Definitions[name]
+	}
+	return t
+}
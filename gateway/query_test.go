@@ -0,0 +1,105 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coredb "github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/db"
+)
+
+func TestParseQuery(t *testing.T) {
+	parse := func(target string) (*db.Query, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		return parseQuery(c)
+	}
+
+	q, err := parse("/c")
+	require.NoError(t, err)
+	assert.Empty(t, q.Ands)
+	assert.Equal(t, 0, q.Limit)
+
+	q, err = parse(`/c?where=age>=30&where=name=bob&where=id="30"&where=active!=true&sort=-created&limit=50&skip=5&seek=abc`)
+	require.NoError(t, err)
+	require.Len(t, q.Ands, 4)
+	assert.Equal(t, "age", q.Ands[0].FieldPath)
+	assert.Equal(t, db.Ge, q.Ands[0].Operation)
+	assert.Equal(t, 30.0, *q.Ands[0].Value.Float)
+	assert.Equal(t, "name", q.Ands[1].FieldPath)
+	assert.Equal(t, db.Eq, q.Ands[1].Operation)
+	assert.Equal(t, "bob", *q.Ands[1].Value.String)
+	assert.Equal(t, "30", *q.Ands[2].Value.String)
+	assert.Equal(t, db.Ne, q.Ands[3].Operation)
+	assert.True(t, *q.Ands[3].Value.Bool)
+	assert.Equal(t, db.Sort{FieldPath: "created", Desc: true}, q.Sort)
+	assert.Equal(t, 50, q.Limit)
+	assert.Equal(t, 5, q.Skip)
+	assert.Equal(t, coredb.InstanceID("abc"), q.Seek)
+
+	// The query must survive the trip to threads
+	_, err = json.Marshal(q)
+	require.NoError(t, err)
+
+	q, err = parse("/c?where=score<NaN&sort=_id")
+	require.NoError(t, err)
+	assert.Equal(t, "NaN", *q.Ands[0].Value.String)
+	assert.Equal(t, db.Sort{FieldPath: idField}, q.Sort)
+
+	for _, target := range []string{
+		"/c?where=age",
+		"/c?where=>30",
+		"/c?where=age!30",
+		"/c?sort=-",
+		"/c?limit=1001",
+		"/c?limit=-1",
+		"/c?skip=x",
+	} {
+		_, err = parse(target)
+		assert.Error(t, err, target)
+	}
+}
+
+func TestCheckSortField(t *testing.T) {
+	schema := &jsonschema.Schema{
+		Type: &jsonschema.Type{Ref: "#/definitions/Person"},
+		Definitions: jsonschema.Definitions{
+			"Person": {
+				Type: "object",
+				Properties: map[string]*jsonschema.Type{
+					"name":    {Type: "string"},
+					"age":     {Type: "integer"},
+					"tags":    {Type: "array"},
+					"address": {Ref: "#/definitions/Address"},
+				},
+			},
+			"Address": {
+				Type: "object",
+				Properties: map[string]*jsonschema.Type{
+					"city": {Type: "string"},
+				},
+			},
+			"Loop": {Ref: "#/definitions/Loop"},
+		},
+	}
+	assert.NoError(t, checkSortField(schema, "name"))
+	assert.NoError(t, checkSortField(schema, "age"))
+	assert.NoError(t, checkSortField(schema, "address.city"))
+	assert.Error(t, checkSortField(schema, "tags"))
+	assert.Error(t, checkSortField(schema, "address"))
+	assert.Error(t, checkSortField(schema, "missing"))
+	assert.Error(t, checkSortField(schema, "address.missing"))
+	assert.Error(t, checkSortField(&jsonschema.Schema{}, "name"))
+
+	loop := &jsonschema.Schema{
+		Type:        &jsonschema.Type{Ref: "#/definitions/Loop"},
+		Definitions: schema.Definitions,
+	}
+	assert.Error(t, checkSortField(loop, "name"))
+}
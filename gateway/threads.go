@@ -23,23 +23,54 @@ func (g *Gateway) collectionHandler(c *gin.Context) {
 	g.renderCollection(c, threadID, c.Param("collection"))
 }
 
-// renderCollection renders all instances in a collection.
+// renderCollection renders the instances in a collection matching the request query.
+// If the collection is buckets, the built-in buckets UI is rendered instead.
+// This can be overridden with the query param json=true.
+// The query param schema=true renders the collection's JSON Schema.
 func (g *Gateway) renderCollection(c *gin.Context, threadID thread.ID, collection string) {
 	ctx, cancel := context.WithTimeout(common.NewSessionContext(context.Background(), g.apiSession), handlerTimeout)
 	defer cancel()
 	ctx = common.NewThreadIDContext(ctx, threadID)
+	// All thread requests are authorized with the same token: the collection info lookup reads it
+	// from ctx, and Find replaces the ctx token with its txn option.
 	token := thread.Token(c.Query("token"))
 	if token.Defined() {
 		ctx = thread.NewTokenContext(ctx, token)
 	}
 
+	if c.Query("schema") == "true" {
+		info, err := g.threads.GetCollectionInfo(ctx, threadID, collection)
+		if err != nil {
+			render404(c)
+			return
+		}
+		c.JSON(http.StatusOK, info.Schema)
+		return
+	}
+
 	jsn := c.Query("json") == "true"
 	if collection == buckets.CollectionName && !jsn {
 		g.renderBucket(c, ctx, threadID, token)
 		return
 	} else {
+		query, err := parseQuery(c)
+		if err != nil {
+			renderError(c, http.StatusBadRequest, err)
+			return
+		}
+		if query.Sort.FieldPath != "" && query.Sort.FieldPath != idField {
+			info, err := g.threads.GetCollectionInfo(ctx, threadID, collection)
+			if err != nil {
+				render404(c)
+				return
+			}
+			if err := checkSortField(info.Schema, query.Sort.FieldPath); err != nil {
+				renderError(c, http.StatusBadRequest, err)
+				return
+			}
+		}
 		var dummy interface{}
-		res, err := g.threads.Find(ctx, threadID, collection, &db.Query{}, &dummy, db.WithTxnToken(token))
+		res, err := g.threads.Find(ctx, threadID, collection, query, &dummy, db.WithTxnToken(token))
 		if err != nil {
 			render404(c)
 			return
@@ -59,7 +90,7 @@ func (g *Gateway) instanceHandler(c *gin.Context) {
 }
 
 // renderInstance renders an instance in a collection.
-// If the collection is buckets, the built-in buckets UI in rendered instead.
+// If the collection is buckets, the built-in buckets UI is rendered instead.
 // This can be overridden with the query param json=true.
 func (g *Gateway) renderInstance(c *gin.Context, threadID thread.ID, collection, id, pth string) {
 	pth = strings.TrimPrefix(pth, "/")
@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-unixfs"
+	"github.com/ipfs/go-unixfs/hamt"
+	upb "github.com/ipfs/go-unixfs/pb"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/textile/v2/car"
+)
+
+// Trustless response content types.
+// See https://specs.ipfs.tech/http-gateways/trustless-gateway/.
+const (
+	mimeRaw = "application/vnd.ipld.raw"
+	mimeCar = "application/vnd.ipld.car"
+)
+
+// DAG scopes for CAR responses.
+const (
+	// scopeAll includes the entire DAG below the path.
+	scopeAll = "all"
+	// scopeEntity includes the blocks of a file, or only the directory blocks of a directory.
+	scopeEntity = "entity"
+	// scopeBlock includes only the block at the path.
+	scopeBlock = "block"
+)
+
+// trustlessFormat returns the requested trustless response type from ?format or the Accept header.
+// It returns an empty string if a trustless response was not requested.
+func trustlessFormat(c *gin.Context) string {
+	switch c.Query("format") {
+	case "raw":
+		return mimeRaw
+	case "car":
+		return mimeCar
+	}
+	for _, a := range strings.Split(c.GetHeader("Accept"), ",") {
+		switch strings.TrimSpace(strings.SplitN(a, ";", 2)[0]) {
+		case mimeRaw:
+			return mimeRaw
+		case mimeCar:
+			return mimeCar
+		}
+	}
+	return ""
+}
+
+// renderTrustless writes the block or CAR for pth.
+func (g *Gateway) renderTrustless(c *gin.Context, pth, format string) {
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	defer cancel()
+	resolved, err := g.ipfs.ResolvePath(ctx, path.New(pth))
+	if err != nil {
+		renderError(c, http.StatusNotFound, err)
+		return
+	}
+	root := resolved.Cid()
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("X-Ipfs-Path", pth)
+	c.Header("Cache-Control", "public, max-age=29030400, immutable")
+
+	if format == mimeRaw {
+		r, err := g.ipfs.Block().Get(ctx, resolved)
+		if err != nil {
+			renderError(c, http.StatusNotFound, err)
+			return
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			renderError(c, http.StatusInternalServerError, err)
+			return
+		}
+		c.Header("Etag", fmt.Sprintf(`"%s.raw"`, root))
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.bin"`, root))
+		c.Data(http.StatusOK, mimeRaw, data)
+		return
+	}
+
+	scope := c.DefaultQuery("dag-scope", scopeAll)
+	switch scope {
+	case scopeAll, scopeEntity, scopeBlock:
+	default:
+		renderError(c, http.StatusBadRequest, fmt.Errorf("invalid dag-scope %s (options: all, entity, block)", scope))
+		return
+	}
+	// Include the blocks traversed to resolve the path so clients can verify it from the root
+	parents, err := pathParents(ctx, g.ipfs.Dag(), pth)
+	if err != nil {
+		renderError(c, http.StatusNotFound, err)
+		return
+	}
+	c.Header("Etag", fmt.Sprintf(`"%s.car.%s"`, root, scope))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.car"`, root))
+	c.Header("Content-Type", mimeCar+"; version=1")
+	c.Status(http.StatusOK)
+	roots := []cid.Cid{root}
+	if len(parents) > 0 {
+		roots = []cid.Cid{parents[0]}
+	}
+	if err := writeCar(ctx, c.Writer, g.ipfs.Dag(), roots, parents, root, scope); err != nil {
+		// Headers are already written, so we can only log and stop
+		log.Errorf("writing car for %s: %v", pth, err)
+	}
+}
+
+// pathParents returns the cids of the blocks traversed to resolve the last segment of pth,
+// which is an /ipfs path. This includes the HAMT shard blocks of sharded directories.
+func pathParents(ctx context.Context, ds ipld.DAGService, pth string) ([]cid.Cid, error) {
+	parts := strings.Split(strings.Trim(pth, "/"), "/")
+	if len(parts) < 3 {
+		return nil, nil
+	}
+	c, err := cid.Decode(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	var parents []cid.Cid
+	for _, name := range parts[2:] {
+		n, err := ds.Get(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		parents = append(parents, c)
+		if fsn, err := unixfsNode(n); err == nil && fsn.Type() == upb.Data_HAMTShard {
+			rec := &recordingDAG{DAGService: ds}
+			shard, err := hamt.NewHamtFromDag(rec, n)
+			if err != nil {
+				return nil, err
+			}
+			l, err := shard.Find(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			parents = append(parents, rec.cids...)
+			c = l.Cid
+			continue
+		}
+		l, _, err := n.ResolveLink([]string{name})
+		if err != nil {
+			return nil, err
+		}
+		c = l.Cid
+	}
+	return parents, nil
+}
+
+// recordingDAG records the cids of nodes fetched with Get.
+type recordingDAG struct {
+	ipld.DAGService
+	cids []cid.Cid
+}
+
+func (d *recordingDAG) Get(ctx context.Context, c cid.Cid) (ipld.Node, error) {
+	n, err := d.DAGService.Get(ctx, c)
+	if err == nil {
+		d.cids = append(d.cids, c)
+	}
+	return n, err
+}
+
+// writeCar writes a CARv1 with roots, the blocks of parents, and the blocks of target in scope.
+// Blocks are written in depth-first order and only once.
+func writeCar(
+	ctx context.Context,
+	w io.Writer,
+	ng ipld.NodeGetter,
+	roots, parents []cid.Cid,
+	target cid.Cid,
+	scope string,
+) error {
+	cw, err := car.NewWriter(w, roots)
+	if err != nil {
+		return err
+	}
+	for _, p := range parents {
+		n, err := ng.Get(ctx, p)
+		if err != nil {
+			return err
+		}
+		if err := cw.Put(n); err != nil {
+			return err
+		}
+	}
+	switch scope {
+	case scopeBlock:
+		err = cw.Walk(ctx, ng, target, car.NoLinks)
+	case scopeEntity:
+		// Files are included entirely, directories only with their HAMT shards
+		err = cw.Walk(ctx, ng, target, entityLinks)
+	default:
+		err = cw.Walk(ctx, ng, target, car.AllLinks)
+	}
+	if err != nil {
+		return err
+	}
+	return cw.Flush()
+}
+
+// entityLinks returns the links of n that are part of the same UnixFS entity.
+// Directories have none, and HAMT shards only link to their child shards.
+func entityLinks(n ipld.Node) []*ipld.Link {
+	fsn, err := unixfsNode(n)
+	if err != nil {
+		return n.Links()
+	}
+	switch fsn.Type() {
+	case upb.Data_Directory:
+		return nil
+	case upb.Data_HAMTShard:
+		padLen := shardPadLen(fsn)
+		var links []*ipld.Link
+		for _, l := range n.Links() {
+			if len(l.Name) == padLen {
+				links = append(links, l)
+			}
+		}
+		return links
+	default:
+		return n.Links()
+	}
+}
+
+// shardPadLen returns the length of the hex bucket index prefix of HAMT shard link names.
+// Child shard links are named with only the index.
+func shardPadLen(fsn *unixfs.FSNode) int {
+	return len(fmt.Sprintf("%X", fsn.Fanout()-1))
+}
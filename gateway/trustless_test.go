@@ -0,0 +1,136 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ipfs/go-cid"
+	cbornode "github.com/ipfs/go-ipld-cbor"
+	ipld "github.com/ipfs/go-ipld-format"
+	dag "github.com/ipfs/go-merkledag"
+	mdtest "github.com/ipfs/go-merkledag/test"
+	"github.com/ipfs/go-unixfs"
+	"github.com/ipfs/go-unixfs/hamt"
+	upb "github.com/ipfs/go-unixfs/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustlessFormat(t *testing.T) {
+	get := func(target, accept string) string {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		c.Request.Header.Set("Accept", accept)
+		return trustlessFormat(c)
+	}
+	assert.Equal(t, mimeRaw, get("/ipfs/cid?format=raw", ""))
+	assert.Equal(t, mimeCar, get("/ipfs/cid?format=car", ""))
+	assert.Equal(t, mimeCar, get("/ipfs/cid", "application/vnd.ipld.car; version=1"))
+	assert.Equal(t, mimeRaw, get("/ipfs/cid", "text/html, application/vnd.ipld.raw"))
+	assert.Equal(t, "", get("/ipfs/cid?format=json", "text/html"))
+}
+
+func TestWriteCar(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+
+	chunk := dag.NewRawNode([]byte("hello"))
+	fsn := unixfs.NewFSNode(upb.Data_File)
+	fsn.AddBlockSize(uint64(len(chunk.RawData())))
+	data, err := fsn.GetBytes()
+	require.NoError(t, err)
+	file := dag.NodeWithData(data)
+	require.NoError(t, file.AddNodeLink("", chunk))
+	dir := unixfs.EmptyDirNode()
+	require.NoError(t, dir.AddNodeLink("file", file))
+	require.NoError(t, ds.AddMany(ctx, []ipld.Node{chunk, file, dir}))
+
+	read := func(scope string, target cid.Cid, parents []cid.Cid) []cid.Cid {
+		var buf bytes.Buffer
+		err := writeCar(ctx, &buf, ds, []cid.Cid{dir.Cid()}, parents, target, scope)
+		require.NoError(t, err)
+		return readCarCids(t, &buf)
+	}
+
+	assert.Equal(t, []cid.Cid{dir.Cid(), file.Cid(), chunk.Cid()}, read(scopeAll, dir.Cid(), nil))
+	assert.Equal(t, []cid.Cid{dir.Cid()}, read(scopeEntity, dir.Cid(), nil))
+	assert.Equal(t, []cid.Cid{dir.Cid(), file.Cid(), chunk.Cid()}, read(scopeEntity, file.Cid(), []cid.Cid{dir.Cid()}))
+	assert.Equal(t, []cid.Cid{dir.Cid(), file.Cid()}, read(scopeBlock, file.Cid(), []cid.Cid{dir.Cid()}))
+}
+
+func TestPathParents(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+
+	file := dag.NewRawNode([]byte("hello"))
+	shard, err := hamt.NewShard(ds, 256)
+	require.NoError(t, err)
+	for i := 0; i < 500; i++ {
+		require.NoError(t, shard.Set(ctx, fmt.Sprintf("file%d", i), file))
+	}
+	nd, err := shard.Node()
+	require.NoError(t, err)
+	dir := unixfs.EmptyDirNode()
+	require.NoError(t, dir.AddNodeLink("sharded", nd))
+	require.NoError(t, ds.AddMany(ctx, []ipld.Node{file, dir}))
+
+	parents, err := pathParents(ctx, ds, fmt.Sprintf("/ipfs/%s/sharded/file42", dir.Cid()))
+	require.NoError(t, err)
+	require.True(t, len(parents) > 2)
+	assert.Equal(t, dir.Cid(), parents[0])
+	assert.Equal(t, nd.Cid(), parents[1])
+
+	// The parent blocks alone are enough to resolve the path
+	only := mdtest.Mock()
+	for _, c := range parents {
+		n, err := ds.Get(ctx, c)
+		require.NoError(t, err)
+		require.NoError(t, only.Add(ctx, n))
+	}
+	n, err := only.Get(ctx, nd.Cid())
+	require.NoError(t, err)
+	s, err := hamt.NewHamtFromDag(only, n)
+	require.NoError(t, err)
+	l, err := s.Find(ctx, "file42")
+	require.NoError(t, err)
+	assert.Equal(t, file.Cid(), l.Cid)
+}
+
+// readCarCids returns the block cids in a CAR after checking each block matches its cid.
+func readCarCids(t *testing.T, r io.Reader) []cid.Cid {
+	br := bufio.NewReader(r)
+	l, err := binary.ReadUvarint(br)
+	require.NoError(t, err)
+	header := make([]byte, l)
+	_, err = io.ReadFull(br, header)
+	require.NoError(t, err)
+	var h map[string]interface{}
+	require.NoError(t, cbornode.DecodeInto(header, &h))
+	require.EqualValues(t, 1, h["version"])
+	require.Len(t, h["roots"], 1)
+	var cids []cid.Cid
+	for {
+		l, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return cids
+		}
+		require.NoError(t, err)
+		section := make([]byte, l)
+		_, err = io.ReadFull(br, section)
+		require.NoError(t, err)
+		n, c, err := cid.CidFromBytes(section)
+		require.NoError(t, err)
+		sum, err := c.Prefix().Sum(section[n:])
+		require.NoError(t, err)
+		require.True(t, c.Equals(sum))
+		cids = append(cids, c)
+	}
+}
@@ -0,0 +1,320 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// indexFile is served for directory paths.
+	indexFile = "index.html"
+	// notFoundFile is served with a 404 status when nothing else matches.
+	notFoundFile = "404.html"
+	// redirectsFile contains redirect and rewrite rules, one per line:
+	//   /from /to [status][!]
+	// Status defaults to 301. A 200 status rewrites the path without a redirect.
+	// Rules only apply if no file exists at the path, unless forced with a trailing "!".
+	// Paths may contain :placeholders and end with a * splat, which can be used in the target as :splat.
+	// For example, single page apps can serve index.html for all unknown paths with:
+	//   /* /index.html 200
+	redirectsFile = "_redirects"
+	// headersFile contains custom response headers for matching paths:
+	//   /path/*
+	//     Header-Name: value
+	headersFile = "_headers"
+
+	// maxWebsiteConfigSize is the maximum size of a website config file.
+	maxWebsiteConfigSize = 1 << 16
+)
+
+// website describes how a bucket is served as a static website.
+type website struct {
+	// root is the bucket root path the website was loaded from.
+	root      string
+	redirects []redirectRule
+	headers   []headerRule
+	notFound  bool
+}
+
+type redirectRule struct {
+	from   pattern
+	to     string
+	status int
+	force  bool
+}
+
+type headerRule struct {
+	path   pattern
+	values http.Header
+}
+
+// pattern is a path pattern with optional :placeholder parts and a trailing * splat.
+type pattern struct {
+	parts []string
+	splat bool
+}
+
+func parsePattern(s string) pattern {
+	var p pattern
+	parts := splitPath(s)
+	if len(parts) > 0 && parts[len(parts)-1] == "*" {
+		p.splat = true
+		parts = parts[:len(parts)-1]
+	}
+	p.parts = parts
+	return p
+}
+
+// match returns the placeholder values if pth matches the pattern.
+func (p pattern) match(pth string) (map[string]string, bool) {
+	parts := splitPath(pth)
+	if len(parts) < len(p.parts) || (!p.splat && len(parts) != len(p.parts)) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, pp := range p.parts {
+		if strings.HasPrefix(pp, ":") && len(pp) > 1 {
+			params[pp[1:]] = parts[i]
+		} else if pp != parts[i] {
+			return nil, false
+		}
+	}
+	if p.splat {
+		params["splat"] = strings.Join(parts[len(p.parts):], "/")
+	}
+	return params, true
+}
+
+func splitPath(pth string) []string {
+	pth = strings.Trim(path.Clean("/"+pth), "/")
+	if pth == "" {
+		return nil
+	}
+	return strings.Split(pth, "/")
+}
+
+// expand replaces placeholders in target with params.
+func expand(target string, params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for n := range params {
+		names = append(names, n)
+	}
+	// Replace longer names first so :splat isn't clobbered by :s, etc.
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+	pairs := make([]string, 0, len(names)*2)
+	for _, n := range names {
+		pairs = append(pairs, ":"+n, params[n])
+	}
+	return strings.NewReplacer(pairs...).Replace(target)
+}
+
+// parseRedirects parses the contents of a _redirects file.
+func parseRedirects(data []byte) ([]redirectRule, error) {
+	var rules []redirectRule
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: missing redirect target", n)
+		}
+		r := redirectRule{
+			from:   parsePattern(fields[0]),
+			to:     fields[1],
+			status: http.StatusMovedPermanently,
+		}
+		if len(fields) > 2 {
+			code := fields[2]
+			if strings.HasSuffix(code, "!") {
+				r.force = true
+				code = strings.TrimSuffix(code, "!")
+			}
+			status, err := strconv.Atoi(code)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid status %s", n, fields[2])
+			}
+			r.status = status
+		}
+		switch r.status {
+		case http.StatusOK, http.StatusNotFound:
+			if !strings.HasPrefix(r.to, "/") {
+				return nil, fmt.Errorf("line %d: rewrite target must be a path", n)
+			}
+		case http.StatusMovedPermanently,
+			http.StatusFound,
+			http.StatusSeeOther,
+			http.StatusTemporaryRedirect,
+			http.StatusPermanentRedirect:
+			if !strings.HasPrefix(r.to, "/") &&
+				!strings.HasPrefix(r.to, "http://") &&
+				!strings.HasPrefix(r.to, "https://") {
+				return nil, fmt.Errorf("line %d: redirect target must be a path or URL", n)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unsupported status %d", n, r.status)
+		}
+		rules = append(rules, r)
+	}
+	return rules, s.Err()
+}
+
+// parseHeaders parses the contents of a _headers file.
+func parseHeaders(data []byte) ([]headerRule, error) {
+	var rules []headerRule
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; s.Scan(); n++ {
+		raw := s.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if raw[0] != ' ' && raw[0] != '\t' {
+			rules = append(rules, headerRule{path: parsePattern(line), values: make(http.Header)})
+			continue
+		}
+		if len(rules) == 0 {
+			return nil, fmt.Errorf("line %d: header must follow a path", n)
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("line %d: invalid header", n)
+		}
+		rules[len(rules)-1].values.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return rules, s.Err()
+}
+
+// redirect returns the first rule and expanded target that matches pth.
+func (w *website) redirect(pth string, forced bool) (redirectRule, string, bool) {
+	for _, r := range w.redirects {
+		if r.force != forced {
+			continue
+		}
+		if params, ok := r.from.match(pth); ok {
+			return r, expand(r.to, params), true
+		}
+	}
+	return redirectRule{}, "", false
+}
+
+// headersFor returns the custom headers for pth.
+func (w *website) headersFor(pth string) http.Header {
+	h := make(http.Header)
+	for _, r := range w.headers {
+		if _, ok := r.path.match(pth); ok {
+			for k, vals := range r.values {
+				for _, v := range vals {
+					h.Add(k, v)
+				}
+			}
+		}
+	}
+	return h
+}
+
+// websiteRequest is a request for a path in a bucket website.
+type websiteRequest struct {
+	c    *gin.Context
+	ctx  context.Context
+	fs   serveBucketFS
+	key  string
+	site *website
+}
+
+// serveWebsite serves the request path from the bucket as a static website.
+// It returns false if there was nothing to serve.
+func serveWebsite(c *gin.Context, ctx context.Context, fs serveBucketFS, key string) bool {
+	site, err := fs.Website(ctx, key)
+	if err != nil {
+		log.Debugf("loading website for bucket %s: %v", key, err)
+		site = &website{}
+	}
+	r := &websiteRequest{c: c, ctx: ctx, fs: fs, key: key, site: site}
+	pth := c.Request.URL.Path
+	if rule, to, ok := site.redirect(pth, true); ok {
+		return r.serveRule(rule, to)
+	}
+	if r.serveContent(pth, http.StatusOK) {
+		return true
+	}
+	if rule, to, ok := site.redirect(pth, false); ok {
+		return r.serveRule(rule, to)
+	}
+	return r.serveNotFound()
+}
+
+func (r *websiteRequest) serveRule(rule redirectRule, to string) bool {
+	switch rule.status {
+	case http.StatusOK, http.StatusNotFound:
+		if r.serveContent(to, rule.status) {
+			return true
+		}
+		return r.serveNotFound()
+	default:
+		if q := r.c.Request.URL.RawQuery; q != "" && !strings.Contains(to, "?") {
+			to += "?" + q
+		}
+		r.setHeaders()
+		r.c.Redirect(rule.status, to)
+		r.c.Abort()
+		return true
+	}
+}
+
+func (r *websiteRequest) serveNotFound() bool {
+	if !r.site.notFound {
+		return false
+	}
+	return r.serveContent("/"+notFoundFile, http.StatusNotFound)
+}
+
+// serveContent writes the file at pth, or its index file if pth is a directory.
+func (r *websiteRequest) serveContent(pth string, status int) bool {
+	exists, target := r.fs.Exists(r.ctx, r.key, pth)
+	if !exists {
+		if target == "" {
+			return false
+		}
+		pth = path.Join(pth, target)
+	}
+	r.setHeaders()
+	if r.c.Writer.Header().Get("Content-Type") == "" {
+		ctype := mime.TypeByExtension(path.Ext(pth))
+		if ctype == "" {
+			ctype = "application/octet-stream"
+		}
+		r.c.Writer.Header().Set("Content-Type", ctype)
+	}
+	setDownload(r.c, path.Base(pth))
+	r.c.Writer.WriteHeader(status)
+	if err := r.fs.Write(r.ctx, r.key, pth, r.c.Writer); err != nil {
+		renderError(r.c, http.StatusInternalServerError, err)
+		return true
+	}
+	r.c.Abort()
+	return true
+}
+
+// setHeaders adds custom headers for the request path.
+func (r *websiteRequest) setHeaders() {
+	for k, vals := range r.site.headersFor(r.c.Request.URL.Path) {
+		for _, v := range vals {
+			r.c.Writer.Header().Add(k, v)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+func TestParseRedirects(t *testing.T) {
+	rules, err := parseRedirects([]byte(`
+# Comment
+/old /new
+/blog/:year/* /posts/:year/:splat 302
+/api/* https://api.example.com/:splat 307!
+/* /index.html 200
+`))
+	require.NoError(t, err)
+	require.Len(t, rules, 4)
+	assert.Equal(t, http.StatusMovedPermanently, rules[0].status)
+	assert.Equal(t, http.StatusFound, rules[1].status)
+	assert.True(t, rules[2].force)
+	assert.Equal(t, http.StatusOK, rules[3].status)
+
+	site := &website{redirects: rules}
+	_, to, ok := site.redirect("/blog/2020/10/hello", false)
+	require.True(t, ok)
+	assert.Equal(t, "/posts/2020/10/hello", to)
+	_, to, ok = site.redirect("/api/v1/users", true)
+	require.True(t, ok)
+	assert.Equal(t, "https://api.example.com/v1/users", to)
+	_, _, ok = site.redirect("/api/v1/users", false)
+	assert.True(t, ok, "non-forced catch-all should match")
+
+	for _, bad := range []string{
+		"/only-from",
+		"/a /b 418",
+		"/a /b abc",
+		"/a https://example.com 200",
+		"/a b 301",
+	} {
+		_, err := parseRedirects([]byte(bad))
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	rules, err := parseHeaders([]byte(`
+/*
+  X-Frame-Options: DENY
+/assets/*
+  Cache-Control: public, max-age=31536000
+  X-Custom: one
+  X-Custom: two
+`))
+	require.NoError(t, err)
+	site := &website{headers: rules}
+	h := site.headersFor("/assets/app.js")
+	assert.Equal(t, "DENY", h.Get("X-Frame-Options"))
+	assert.Equal(t, "public, max-age=31536000", h.Get("Cache-Control"))
+	assert.Equal(t, []string{"one", "two"}, h.Values("X-Custom"))
+	h = site.headersFor("/index.html")
+	assert.Equal(t, "", h.Get("Cache-Control"))
+
+	_, err = parseHeaders([]byte("  X-Orphan: yes"))
+	assert.Error(t, err)
+	_, err = parseHeaders([]byte("/\n  no-colon"))
+	assert.Error(t, err)
+}
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		params  map[string]string
+		ok      bool
+	}{
+		{"/", "/", map[string]string{}, true},
+		{"/a", "/a/", map[string]string{}, true},
+		{"/a", "/a/b", nil, false},
+		{"/*", "/", map[string]string{"splat": ""}, true},
+		{"/a/*", "/a/b/c", map[string]string{"splat": "b/c"}, true},
+		{"/:lang/about", "/en/about", map[string]string{"lang": "en"}, true},
+		{"/:lang/about", "/en/contact", nil, false},
+	}
+	for _, tc := range tests {
+		params, ok := parsePattern(tc.pattern).match(tc.path)
+		assert.Equal(t, tc.ok, ok, "%s %s", tc.pattern, tc.path)
+		assert.Equal(t, tc.params, params, "%s %s", tc.pattern, tc.path)
+	}
+}
+
+func TestServeWebsite(t *testing.T) {
+	fs := &memBucketFS{files: map[string]string{
+		"index.html":      "index",
+		"docs/index.html": "docs",
+		"style.css":       "css",
+		"404.html":        "not found",
+		"_redirects": `/old /new 301
+/app/* /index.html 200
+/style.css /index.html 200!`,
+		"_headers": `/*
+  X-Frame-Options: DENY`,
+	}}
+
+	tests := []struct {
+		path     string
+		status   int
+		body     string
+		location string
+	}{
+		{path: "/", status: http.StatusOK, body: "index"},
+		{path: "/docs", status: http.StatusOK, body: "docs"},
+		{path: "/app/settings/profile", status: http.StatusOK, body: "index"},
+		{path: "/style.css", status: http.StatusOK, body: "index"},
+		{path: "/old?x=1", status: http.StatusMovedPermanently, location: "/new?x=1"},
+		{path: "/missing", status: http.StatusNotFound, body: "not found"},
+	}
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, tc.path, nil)
+		ok := serveWebsite(c, context.Background(), fs, "key")
+		require.True(t, ok, tc.path)
+		assert.Equal(t, tc.status, w.Code, tc.path)
+		assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"), tc.path)
+		if tc.body != "" {
+			assert.Equal(t, tc.body, w.Body.String(), tc.path)
+		}
+		if tc.location != "" {
+			assert.Equal(t, tc.location, w.Header().Get("Location"), tc.path)
+		}
+	}
+
+	delete(fs.files, "404.html")
+	fs.files["_redirects"] = ""
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/missing", nil)
+	assert.False(t, serveWebsite(c, context.Background(), fs, "key"))
+}
+
+// memBucketFS is an in-memory bucket.
+type memBucketFS struct {
+	files map[string]string
+}
+
+func (f *memBucketFS) GetThread(context.Context, string) (thread.ID, error) {
+	return thread.Undef, nil
+}
+
+func (f *memBucketFS) Exists(_ context.Context, _, pth string) (bool, string) {
+	pth = strings.Trim(pth, "/")
+	if _, ok := f.files[pth]; ok {
+		return true, ""
+	}
+	if _, ok := f.files[path.Join(pth, indexFile)]; ok {
+		return false, indexFile
+	}
+	return false, ""
+}
+
+func (f *memBucketFS) Write(_ context.Context, _, pth string, w io.Writer) error {
+	data, ok := f.files[strings.Trim(pth, "/")]
+	if !ok {
+		return fmt.Errorf("not found: %s", pth)
+	}
+	_, err := io.WriteString(w, data)
+	return err
+}
+
+func (f *memBucketFS) Website(context.Context, string) (*website, error) {
+	site := &website{}
+	if _, ok := f.files[notFoundFile]; ok {
+		site.notFound = true
+	}
+	var err error
+	if site.redirects, err = parseRedirects([]byte(f.files[redirectsFile])); err != nil {
+		return nil, err
+	}
+	if site.headers, err = parseHeaders([]byte(f.files[headersFile])); err != nil {
+		return nil, err
+	}
+	return site, nil
+}
+
+func (f *memBucketFS) ValidHost() string {
+	return ""
+}
+
+func (f *memBucketFS) CustomDomain(context.Context, string) (string, bool) {
+	return "", false
+}
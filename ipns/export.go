@@ -0,0 +1,175 @@
+package ipns
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// exportVersion is the version of the exported key format.
+	exportVersion = 1
+	// schemePassword encrypts with a key derived from a password.
+	schemePassword = "scrypt-secretbox"
+	// schemeIdentity encrypts to a thread identity's public key.
+	schemeIdentity = "identity"
+)
+
+var (
+	// ErrInvalidExport indicates the exported key data is malformed.
+	ErrInvalidExport = errors.New("invalid exported ipns key")
+	// ErrDecryptExport indicates the exported key could not be decrypted.
+	ErrDecryptExport = errors.New("failed to decrypt exported ipns key")
+)
+
+// exportedKey is the JSON envelope of an exported key.
+type exportedKey struct {
+	Version int    `json:"version"`
+	Scheme  string `json:"scheme"`
+	Salt    []byte `json:"salt,omitempty"`
+	Nonce   []byte `json:"nonce,omitempty"`
+	Data    []byte `json:"data"`
+}
+
+// EncryptKeyWithPassword returns sk encrypted with a key derived from password.
+func EncryptKeyWithPassword(sk crypto.PrivKey, password string) ([]byte, error) {
+	if password == "" {
+		return nil, errors.New("password is required")
+	}
+	data, err := crypto.MarshalPrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	env := exportedKey{
+		Version: exportVersion,
+		Scheme:  schemePassword,
+		Salt:    make([]byte, 32),
+		Nonce:   make([]byte, 24),
+	}
+	if _, err := rand.Read(env.Salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return nil, err
+	}
+	key, err := passwordKey(password, env.Salt)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	copy(nonce[:], env.Nonce)
+	env.Data = secretbox.Seal(nil, data, &nonce, key)
+	return json.Marshal(env)
+}
+
+// EncryptKeyForIdentity returns sk encrypted to the identity with public key pk.
+func EncryptKeyForIdentity(sk crypto.PrivKey, pk thread.PubKey) ([]byte, error) {
+	data, err := crypto.MarshalPrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := pk.Encrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(exportedKey{
+		Version: exportVersion,
+		Scheme:  schemeIdentity,
+		Data:    ciphertext,
+	})
+}
+
+// DecryptKey returns the private key in an exported key.
+// Password-encrypted keys require password and identity-encrypted keys require identity.
+func DecryptKey(ctx context.Context, exported []byte, password string, identity thread.Identity) (crypto.PrivKey, error) {
+	var env exportedKey
+	if err := json.Unmarshal(exported, &env); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+	if env.Version != exportVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidExport, env.Version)
+	}
+	var data []byte
+	switch env.Scheme {
+	case schemePassword:
+		if password == "" {
+			return nil, fmt.Errorf("%w: password is required", ErrDecryptExport)
+		}
+		if len(env.Nonce) != 24 {
+			return nil, fmt.Errorf("%w: bad nonce", ErrInvalidExport)
+		}
+		key, err := passwordKey(password, env.Salt)
+		if err != nil {
+			return nil, err
+		}
+		var nonce [24]byte
+		copy(nonce[:], env.Nonce)
+		var ok bool
+		data, ok = secretbox.Open(nil, env.Data, &nonce, key)
+		if !ok {
+			return nil, ErrDecryptExport
+		}
+	case schemeIdentity:
+		if identity == nil {
+			return nil, fmt.Errorf("%w: identity is required", ErrDecryptExport)
+		}
+		var err error
+		data, err = identity.Decrypt(ctx, env.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDecryptExport, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown scheme %s", ErrInvalidExport, env.Scheme)
+	}
+	sk, err := crypto.UnmarshalPrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+	return sk, nil
+}
+
+// passwordKey derives a secretbox key from password and salt.
+func passwordKey(password string, salt []byte) (*[32]byte, error) {
+	k, err := scrypt.Key([]byte(password), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	var key [32]byte
+	copy(key[:], k)
+	return &key, nil
+}
+
+// sealKey returns sk encrypted with secret for storage.
+// The random nonce is prepended to the ciphertext.
+func sealKey(sk crypto.PrivKey, secret *[32]byte) ([]byte, error) {
+	data, err := crypto.MarshalPrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+	return secretbox.Seal(nonce[:], data, &nonce, secret), nil
+}
+
+// openKey decrypts a private key encrypted by sealKey.
+func openKey(data []byte, secret *[32]byte) (crypto.PrivKey, error) {
+	if len(data) < 24 {
+		return nil, errors.New("invalid stored ipns key")
+	}
+	var nonce [24]byte
+	copy(nonce[:], data[:24])
+	plain, ok := secretbox.Open(nil, data[24:], &nonce, secret)
+	if !ok {
+		return nil, errors.New("failed to decrypt stored ipns key")
+	}
+	return crypto.UnmarshalPrivateKey(plain)
+}
@@ -0,0 +1,68 @@
+package ipns
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+func TestDecryptKey_Password(t *testing.T) {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	data, err := EncryptKeyWithPassword(sk, "secret")
+	require.NoError(t, err)
+
+	got, err := DecryptKey(context.Background(), data, "secret", nil)
+	require.NoError(t, err)
+	assert.True(t, sk.Equals(got))
+
+	_, err = DecryptKey(context.Background(), data, "wrong", nil)
+	assert.ErrorIs(t, err, ErrDecryptExport)
+	_, err = DecryptKey(context.Background(), []byte("junk"), "secret", nil)
+	assert.ErrorIs(t, err, ErrInvalidExport)
+}
+
+func TestDecryptKey_Identity(t *testing.T) {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	isk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	identity := thread.NewLibp2pIdentity(isk)
+
+	data, err := EncryptKeyForIdentity(sk, identity.GetPublic())
+	require.NoError(t, err)
+
+	got, err := DecryptKey(context.Background(), data, "", identity)
+	require.NoError(t, err)
+	assert.True(t, sk.Equals(got))
+
+	_, err = DecryptKey(context.Background(), data, "", nil)
+	assert.ErrorIs(t, err, ErrDecryptExport)
+}
+
+func TestSealKey(t *testing.T) {
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	secret := sha256.Sum256([]byte("secret"))
+
+	data, err := sealKey(sk, &secret)
+	require.NoError(t, err)
+	raw, err := crypto.MarshalPrivateKey(sk)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), string(raw))
+
+	got, err := openKey(data, &secret)
+	require.NoError(t, err)
+	assert.True(t, sk.Equals(got))
+
+	wrong := sha256.Sum256([]byte("wrong"))
+	_, err = openKey(data, &wrong)
+	assert.Error(t, err)
+}
@@ -2,7 +2,9 @@ package ipns
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	mbase "github.com/multiformats/go-multibase"
 	cron "github.com/robfig/cron/v3"
@@ -17,6 +20,7 @@ import (
 	tutil "github.com/textileio/go-threads/util"
 	mdb "github.com/textileio/textile/v2/mongodb"
 	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -31,25 +35,58 @@ const (
 	maxCancelPublishTries = 10
 	// list all keys timeout
 	listKeysTimeout = time.Hour
+	// DefaultLifetime is the default lifetime of published records.
+	DefaultLifetime = time.Hour * 24
+	// maxCoalesceInterval is the maximum delay between an update and publishing.
+	maxCoalesceInterval = time.Hour * 24
+)
+
+var (
+	// ErrInvalidSettings indicates the IPNS settings are not valid.
+	ErrInvalidSettings = errors.New("invalid ipns settings")
+	// ErrKeyExists indicates an imported key is already in use.
+	ErrKeyExists = errors.New("ipns key already exists")
+	// ErrKeyNotExportable indicates a key was generated inside the IPFS node keystore and can't be exported.
+	ErrKeyNotExportable = errors.New("ipns key is not exportable")
+	// ErrImportNotSupported indicates the manager has no keystore to import keys into.
+	ErrImportNotSupported = errors.New("ipns key import is not supported")
 )
 
 // Manager handles bucket name publishing to IPNS.
 type Manager struct {
-	keys    *mdb.IPNSKeys
-	keyAPI  iface.KeyAPI
-	nameAPI iface.NameAPI
+	keys     *mdb.IPNSKeys
+	keyAPI   iface.KeyAPI
+	nameAPI  iface.NameAPI
+	keystore Keystore
+	// secret encrypts private keys saved with exportable keys.
+	secret *[32]byte
 
 	sync.Mutex
 	keyLocks    map[string]chan struct{}
 	ctxsLock    sync.Mutex
 	ctxs        map[string]context.CancelFunc
+	timersLock  sync.Mutex
+	timers      map[string]*time.Timer
 	republisher *cron.Cron
+	// republishInterval is the longest time between republishes.
+	republishInterval time.Duration
 	// maximum ipns records to republish per batch
 	maxRepublishingConcurrency int
 }
 
 // NewManager returns a new IPNS manager.
-func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI, maxRepublishingConcurrency int, debug bool) (*Manager, error) {
+// If keystore is not nil and secret is not empty, keys are generated by the manager and imported
+// into the IPFS node, and their private keys are saved encrypted with secret, which allows them
+// to be exported later. Otherwise, keys are generated inside the node keystore.
+func NewManager(
+	keys *mdb.IPNSKeys,
+	keyAPI iface.KeyAPI,
+	nameAPI iface.NameAPI,
+	keystore Keystore,
+	secret string,
+	maxRepublishingConcurrency int,
+	debug bool,
+) (*Manager, error) {
 	if debug {
 		if err := tutil.SetLogLevels(map[string]logging.LogLevel{
 			"ipns": logging.LevelDebug,
@@ -57,11 +94,19 @@ func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI,
 			return nil, err
 		}
 	}
+	var sec *[32]byte
+	if secret != "" {
+		s := sha256.Sum256([]byte(secret))
+		sec = &s
+	}
 	return &Manager{
 		keys:                       keys,
 		keyAPI:                     keyAPI,
 		nameAPI:                    nameAPI,
+		keystore:                   keystore,
+		secret:                     sec,
 		ctxs:                       make(map[string]context.CancelFunc),
+		timers:                     make(map[string]*time.Timer),
 		keyLocks:                   make(map[string]chan struct{}),
 		republisher:                cron.New(),
 		maxRepublishingConcurrency: maxRepublishingConcurrency,
@@ -70,20 +115,50 @@ func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI,
 
 // StartRepublishing initializes a key republishing cron
 func (m *Manager) StartRepublishing(schedule string) error {
-	if _, err := m.republisher.AddFunc(schedule, func() {
-		if err := m.republish(); err != nil {
-			log.Errorf("republishing ipns keys: %v", err)
-		}
-	}); err != nil {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
 		log.Errorf("republishing aborted: %v", err)
 		return err
 	}
+	m.republishInterval = scheduleInterval(sched, time.Now())
+	if DefaultLifetime < m.republishInterval {
+		log.Warnf("default record lifetime %v is shorter than the republish interval %v", DefaultLifetime, m.republishInterval)
+	}
+	m.republisher.Schedule(sched, cron.FuncJob(func() {
+		if err := m.republish(); err != nil {
+			log.Errorf("republishing ipns keys: %v", err)
+		}
+	}))
 	m.republisher.Start()
 	return nil
 }
 
+// scheduleInterval returns the longest time between the upcoming activations of sched.
+func scheduleInterval(sched cron.Schedule, from time.Time) time.Duration {
+	var max time.Duration
+	prev := sched.Next(from)
+	for i := 0; i < 32; i++ {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		if d := next.Sub(prev); d > max {
+			max = d
+		}
+		prev = next
+	}
+	return max
+}
+
 // CreateKey generates and saves a new IPNS key.
 func (m *Manager) CreateKey(ctx context.Context, dbID thread.ID, path path.Path) (keyID string, err error) {
+	if m.keystore != nil && m.secret != nil {
+		sk, _, err := crypto.GenerateKeyPair(crypto.RSA, 2048)
+		if err != nil {
+			return "", err
+		}
+		return m.ImportKey(ctx, dbID, path, sk)
+	}
 	key, err := m.keyAPI.Generate(ctx, util.MakeToken(nameLen), options.Key.Type(options.RSAKey))
 	if err != nil {
 		return
@@ -92,12 +167,63 @@ func (m *Manager) CreateKey(ctx context.Context, dbID thread.ID, path path.Path)
 	if err != nil {
 		return
 	}
-	if err = m.keys.Create(ctx, key.Name(), keyID, dbID, path.String()); err != nil {
+	if err = m.keys.Create(ctx, key.Name(), keyID, dbID, path.String(), nil); err != nil {
+		return
+	}
+	return keyID, nil
+}
+
+// ImportKey saves an existing private key as a new IPNS key.
+// The key is added to the IPFS node keystore and removed again if it can't be saved.
+// The private key is only saved, encrypted, if the manager has a secret.
+func (m *Manager) ImportKey(ctx context.Context, dbID thread.ID, path path.Path, sk crypto.PrivKey) (keyID string, err error) {
+	if m.keystore == nil {
+		return "", ErrImportNotSupported
+	}
+	pid, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		return
+	}
+	keyID, err = peer.ToCid(pid).StringOfBase(mbase.Base32)
+	if err != nil {
+		return
+	}
+	if _, err = m.keys.GetByCid(ctx, keyID); err == nil {
+		return "", ErrKeyExists
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return
+	}
+	var data []byte
+	if m.secret != nil {
+		if data, err = sealKey(sk, m.secret); err != nil {
+			return
+		}
+	}
+	name := util.MakeToken(nameLen)
+	if err = m.keystore.Import(ctx, name, sk); err != nil {
+		return "", fmt.Errorf("importing key: %v", err)
+	}
+	if err = m.keys.Create(ctx, name, keyID, dbID, path.String(), data); err != nil {
+		if _, rerr := m.keyAPI.Remove(ctx, name); rerr != nil {
+			log.Errorf("removing key %s: %v", name, rerr)
+		}
 		return
 	}
 	return keyID, nil
 }
 
+// ExportKey returns the private key for key ID.
+func (m *Manager) ExportKey(ctx context.Context, keyID string) (crypto.PrivKey, error) {
+	key, err := m.keys.GetByCid(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(key.PrivKey) == 0 || m.secret == nil {
+		return nil, ErrKeyNotExportable
+	}
+	return openKey(key.PrivKey, m.secret)
+}
+
 // RemoveKey removes an IPNS key.
 func (m *Manager) RemoveKey(ctx context.Context, keyID string) error {
 	key, err := m.keys.GetByCid(ctx, keyID)
@@ -107,9 +233,59 @@ func (m *Manager) RemoveKey(ctx context.Context, keyID string) error {
 	if _, err = m.keyAPI.Remove(ctx, key.Name); err != nil {
 		return err
 	}
+	m.stopTimer(keyID)
 	return m.keys.Delete(ctx, key.Name)
 }
 
+// Settings returns the publishing settings for key ID.
+func (m *Manager) Settings(ctx context.Context, keyID string) (mdb.IPNSSettings, error) {
+	key, err := m.keys.GetByCid(ctx, keyID)
+	if err != nil {
+		return mdb.IPNSSettings{}, err
+	}
+	return key.Settings, nil
+}
+
+// SetSettings updates the publishing settings for key ID.
+// Enabling a disabled key publishes its current path.
+func (m *Manager) SetSettings(ctx context.Context, keyID string, settings mdb.IPNSSettings) error {
+	if err := ValidateSettings(settings, m.republishInterval); err != nil {
+		return err
+	}
+	key, err := m.keys.GetByCid(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if err := m.keys.SetSettings(ctx, key.Name, settings); err != nil {
+		return err
+	}
+	if settings.Disabled {
+		m.stopTimer(keyID)
+		m.cancelPublish(keyID)
+	} else if key.Settings.Disabled && key.Path != "" {
+		go m.publish(path.New(key.Path), keyID)
+	}
+	return nil
+}
+
+// ValidateSettings returns an error if settings are not valid.
+// Records must outlive republishInterval, otherwise they expire before they're republished.
+func ValidateSettings(settings mdb.IPNSSettings, republishInterval time.Duration) error {
+	if settings.Lifetime < 0 || settings.TTL < 0 || settings.CoalesceInterval < 0 {
+		return fmt.Errorf("%w: durations must not be negative", ErrInvalidSettings)
+	}
+	if settings.Lifetime != 0 && settings.Lifetime < time.Minute {
+		return fmt.Errorf("%w: lifetime must be at least one minute", ErrInvalidSettings)
+	}
+	if settings.Lifetime != 0 && settings.Lifetime < republishInterval {
+		return fmt.Errorf("%w: lifetime must be at least the republish interval %v", ErrInvalidSettings, republishInterval)
+	}
+	if settings.CoalesceInterval > maxCoalesceInterval {
+		return fmt.Errorf("%w: coalesce interval must be at most %v", ErrInvalidSettings, maxCoalesceInterval)
+	}
+	return nil
+}
+
 // Publish publishes a path to IPNS with key ID.
 // Publishing can take up to a minute. Pending publishes are cancelled by consecutive
 // calls with the same key ID, which results in only the most recent publish succeeding.
@@ -126,14 +302,72 @@ func (m *Manager) Publish(pth path.Path, keyID string) {
 		log.Error("set path failed: %s", keyID)
 		return
 	}
+	if key.Settings.Disabled {
+		return
+	}
+	if key.Settings.CoalesceInterval > 0 {
+		m.schedule(keyID, key.Settings.CoalesceInterval)
+		return
+	}
 	m.publish(pth, keyID)
 }
 
+// schedule publishes the latest path for key ID after interval.
+// Updates before a scheduled publish fires are coalesced into it.
+func (m *Manager) schedule(keyID string, interval time.Duration) {
+	m.timersLock.Lock()
+	defer m.timersLock.Unlock()
+	if _, ok := m.timers[keyID]; ok {
+		return
+	}
+	m.timers[keyID] = time.AfterFunc(interval, func() {
+		m.timersLock.Lock()
+		delete(m.timers, keyID)
+		m.timersLock.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		defer cancel()
+		key, err := m.keys.GetByCid(ctx, keyID)
+		if err != nil {
+			log.Errorf("getting key %s: %v", keyID, err)
+			return
+		}
+		if key.Settings.Disabled || key.Path == "" {
+			return
+		}
+		m.publish(path.New(key.Path), keyID)
+	})
+}
+
+func (m *Manager) stopTimer(keyID string) {
+	m.timersLock.Lock()
+	defer m.timersLock.Unlock()
+	if t, ok := m.timers[keyID]; ok {
+		t.Stop()
+		delete(m.timers, keyID)
+	}
+}
+
+// cancelPublish cancels a pending publish for key ID.
+func (m *Manager) cancelPublish(keyID string) {
+	m.ctxsLock.Lock()
+	defer m.ctxsLock.Unlock()
+	if cancel, ok := m.ctxs[keyID]; ok {
+		cancel()
+	}
+}
+
 // Close manager.
 func (m *Manager) Close() {
 	ctx := m.republisher.Stop()
 	<-ctx.Done()
 	log.Info("republisher was shutdown")
+	m.timersLock.Lock()
+	for id, t := range m.timers {
+		t.Stop()
+		delete(m.timers, id)
+	}
+	m.timersLock.Unlock()
 	m.cancel()
 	log.Info("all pending ipns publishes were cancelled")
 }
@@ -197,7 +431,10 @@ func (m *Manager) publishUnsafe(ctx context.Context, pth path.Path, keyID string
 	if err != nil {
 		return err
 	}
-	entry, err := m.nameAPI.Publish(ctx, pth, options.Name.Key(key.Name))
+	if key.Settings.Disabled {
+		return nil
+	}
+	entry, err := m.nameAPI.Publish(ctx, pth, publishOptions(key)...)
 	if err != nil {
 		return err
 	}
@@ -205,6 +442,22 @@ func (m *Manager) publishUnsafe(ctx context.Context, pth path.Path, keyID string
 	return nil
 }
 
+// publishOptions returns the name publishing options for key.
+func publishOptions(key *mdb.IPNSKey) []options.NamePublishOption {
+	lifetime := key.Settings.Lifetime
+	if lifetime == 0 {
+		lifetime = DefaultLifetime
+	}
+	opts := []options.NamePublishOption{
+		options.Name.Key(key.Name),
+		options.Name.ValidTime(lifetime),
+	}
+	if key.Settings.TTL > 0 {
+		opts = append(opts, options.Name.TTL(key.Settings.TTL))
+	}
+	return opts
+}
+
 func (m *Manager) getSemaphore(key string) chan struct{} {
 	var ptl chan struct{}
 	var ok bool
@@ -230,7 +483,7 @@ func (m *Manager) republish() error {
 	lim := make(chan struct{}, m.maxRepublishingConcurrency)
 	for _, key := range keys {
 		key := key
-		if key.Path != "" {
+		if key.Path != "" && !key.Settings.Disabled {
 			withPath++
 			lim <- struct{}{}
 			eg.Go(func() error {
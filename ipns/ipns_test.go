@@ -0,0 +1,34 @@
+package ipns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mdb "github.com/textileio/textile/v2/mongodb"
+)
+
+func TestValidateSettings(t *testing.T) {
+	err := ValidateSettings(mdb.IPNSSettings{Lifetime: time.Hour}, time.Hour*24)
+	assert.True(t, errors.Is(err, ErrInvalidSettings))
+
+	err = ValidateSettings(mdb.IPNSSettings{Lifetime: time.Hour * 48}, time.Hour*24)
+	assert.NoError(t, err)
+
+	// Zero uses the default lifetime
+	err = ValidateSettings(mdb.IPNSSettings{}, time.Hour*24)
+	assert.NoError(t, err)
+}
+
+func TestScheduleInterval(t *testing.T) {
+	sched, err := cron.ParseStandard("CRON_TZ=UTC 0 1 * * *")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour*24, scheduleInterval(sched, time.Now()))
+
+	sched, err = cron.ParseStandard("CRON_TZ=UTC 0 */6 * * 1-5")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour*54, scheduleInterval(sched, time.Now()))
+}
@@ -0,0 +1,37 @@
+package ipns
+
+import (
+	"bytes"
+	"context"
+
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+// Keystore imports private keys into an IPFS node keystore.
+type Keystore interface {
+	// Import adds sk to the keystore under name.
+	Import(ctx context.Context, name string, sk crypto.PrivKey) error
+}
+
+// httpKeystore imports keys with the IPFS HTTP API.
+type httpKeystore struct {
+	api *httpapi.HttpApi
+}
+
+// NewHTTPKeystore returns a Keystore backed by the key/import command of the IPFS HTTP API.
+func NewHTTPKeystore(api *httpapi.HttpApi) Keystore {
+	return &httpKeystore{api: api}
+}
+
+func (k *httpKeystore) Import(ctx context.Context, name string, sk crypto.PrivKey) error {
+	data, err := crypto.MarshalPrivateKey(sk)
+	if err != nil {
+		return err
+	}
+	var out struct {
+		Name string
+		Id   string
+	}
+	return k.api.Request("key/import", name).FileBody(bytes.NewReader(data)).Exec(ctx, &out)
+}
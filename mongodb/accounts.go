@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
@@ -18,11 +19,23 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const (
+	// maxTwoFactorFailures is the number of consecutive rejected two-factor codes before a dev is locked out.
+	maxTwoFactorFailures = 5
+	// twoFactorLockout is the first lockout period. It doubles with each further rejected code.
+	twoFactorLockout = time.Minute
+	// maxTwoFactorLockout caps the lockout period.
+	maxTwoFactorLockout = time.Hour
+)
+
 var (
 	usernameRx *regexp.Regexp
 
 	ErrInvalidUsername = fmt.Errorf("username may only contain alphanumeric characters or single hyphens, " +
 		"and cannot begin or end with a hyphen")
+	ErrLastOwner = fmt.Errorf("an org must have at least one owner")
+	// ErrSSOLinked indicates an identity provider account is already linked to another dev.
+	ErrSSOLinked = fmt.Errorf("identity provider account is linked to another account")
 )
 
 func init() {
@@ -39,7 +52,63 @@ type Account struct {
 	Token     thread.Token
 	Members   []Member
 	PowInfo   *PowInfo
-	CreatedAt time.Time
+	SSO       *SSOConfig
+	Transfer  *OwnershipTransfer
+	TwoFactor *TwoFactor
+	// SSOLinks are the identity provider accounts a dev can sign on with.
+	SSOLinks []SSOLink
+	// RequireTwoFactor restricts org access to members with two-factor authentication enabled.
+	RequireTwoFactor bool
+	CreatedAt        time.Time
+}
+
+// TwoFactor is a dev's TOTP two-factor authentication config.
+type TwoFactor struct {
+	Secret string
+	// Enabled is false until enrollment is confirmed with a code.
+	Enabled bool
+	// RecoveryCodes are hashes of the unused recovery codes.
+	RecoveryCodes []string
+	// LastStep is the time step of the last accepted code. Codes can't be reused.
+	LastStep int64
+	// FailedAttempts is the number of consecutive rejected codes.
+	FailedAttempts int
+	// LockedUntil is when codes are accepted again after too many rejected codes.
+	LockedUntil time.Time
+}
+
+// TwoFactorLocked returns whether the account is locked out of two-factor authentication at t.
+func (a *Account) TwoFactorLocked(t time.Time) bool {
+	return a.TwoFactor != nil && t.Before(a.TwoFactor.LockedUntil)
+}
+
+// TwoFactorEnabled returns whether the account has confirmed two-factor authentication.
+func (a *Account) TwoFactorEnabled() bool {
+	return a.TwoFactor != nil && a.TwoFactor.Enabled
+}
+
+// OwnershipTransfer is a pending transfer of org ownership.
+// The transfer completes when the recipient accepts it.
+type OwnershipTransfer struct {
+	From       thread.PubKey
+	To         thread.PubKey
+	ToUsername string
+	ExpiresAt  time.Time
+}
+
+// SSOConfig is an org's single sign-on identity provider.
+type SSOConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// Required restricts org access to sessions signed on with the provider.
+	Required bool
+}
+
+// SSOLink is a dev's account with an identity provider.
+type SSOLink struct {
+	Issuer  string
+	Subject string
 }
 
 type AccountType int
@@ -61,6 +130,10 @@ type Role int
 const (
 	OrgOwner Role = iota
 	OrgMember
+	OrgAdmin
+	OrgDeveloper
+	OrgBillingManager
+	OrgReadOnly
 )
 
 func (r Role) String() (s string) {
@@ -69,10 +142,103 @@ func (r Role) String() (s string) {
 		s = "owner"
 	case OrgMember:
 		s = "member"
+	case OrgAdmin:
+		s = "admin"
+	case OrgDeveloper:
+		s = "developer"
+	case OrgBillingManager:
+		s = "billing-manager"
+	case OrgReadOnly:
+		s = "read-only"
 	}
 	return
 }
 
+// ParseRole returns the role named s.
+func ParseRole(s string) (Role, error) {
+	for r := OrgOwner; r <= OrgReadOnly; r++ {
+		if r.String() == s {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid role %s", s)
+}
+
+// Permission is an action that org roles can allow.
+type Permission int
+
+const (
+	// PermRemoveOrg allows destroying the org.
+	PermRemoveOrg Permission = iota
+	// PermManageMembers allows changing member roles and removing members.
+	PermManageMembers
+	// PermInviteMembers allows inviting new members.
+	PermInviteMembers
+	// PermManageKeys allows creating, listing, rotating, and invalidating API keys.
+	PermManageKeys
+	// PermManageBilling allows setting up and managing billing.
+	PermManageBilling
+	// PermWriteBuckets allows changing org buckets.
+	PermWriteBuckets
+	// PermReadBuckets allows reading org buckets.
+	PermReadBuckets
+	// PermWriteThreads allows changing org threads, mailboxes, and archives.
+	PermWriteThreads
+	// PermReadThreads allows reading org threads, mailboxes, archives, and usage.
+	PermReadThreads
+)
+
+// rolePermissions is the permission matrix for org roles.
+// Members keep the permissions they had before there were other roles.
+var rolePermissions = map[Role][]Permission{
+	OrgOwner: {
+		PermRemoveOrg, PermManageMembers, PermInviteMembers, PermManageKeys, PermManageBilling,
+		PermWriteBuckets, PermReadBuckets, PermWriteThreads, PermReadThreads,
+	},
+	OrgAdmin: {
+		PermManageMembers, PermInviteMembers, PermManageKeys, PermManageBilling, PermWriteBuckets, PermReadBuckets,
+		PermWriteThreads, PermReadThreads,
+	},
+	OrgMember: {
+		PermInviteMembers, PermManageKeys, PermManageBilling, PermWriteBuckets, PermReadBuckets,
+		PermWriteThreads, PermReadThreads,
+	},
+	OrgDeveloper:      {PermManageKeys, PermWriteBuckets, PermReadBuckets, PermWriteThreads, PermReadThreads},
+	OrgBillingManager: {PermManageBilling, PermReadBuckets, PermReadThreads},
+	OrgReadOnly:       {PermReadBuckets, PermReadThreads},
+}
+
+// Allows returns whether the role has permission p.
+func (r Role) Allows(p Permission) bool {
+	for _, i := range rolePermissions[r] {
+		if i == p {
+			return true
+		}
+	}
+	return false
+}
+
+// MemberRole returns the role of member in an org account.
+func (a *Account) MemberRole(member thread.PubKey) (Role, bool) {
+	for _, m := range a.Members {
+		if m.Key.Equals(member) {
+			return m.Role, true
+		}
+	}
+	return 0, false
+}
+
+// CountOwners returns the number of owners of an org account.
+func (a *Account) CountOwners() int {
+	var n int
+	for _, m := range a.Members {
+		if m.Role == OrgOwner {
+			n++
+		}
+	}
+	return n
+}
+
 type AccountCtx struct {
 	User *Account
 	Org  *Account
@@ -130,6 +296,15 @@ func NewAccounts(ctx context.Context, db *mongo.Database) (*Accounts, error) {
 		{
 			Keys: bson.D{primitive.E{Key: "members._id", Value: 1}},
 		},
+		{
+			Keys: bson.D{
+				primitive.E{Key: "sso_links.issuer", Value: 1},
+				primitive.E{Key: "sso_links.subject", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{primitive.E{Key: "sso_links.subject", Value: bson.M{"$exists": 1}}}),
+		},
 	})
 	return a, err
 }
@@ -311,6 +486,45 @@ func (a *Accounts) GetByUsernameOrEmail(ctx context.Context, usernameOrEmail str
 	return decodeAccount(raw)
 }
 
+// GetBySSOLink returns the dev that linked the identity provider account subject at issuer.
+func (a *Accounts) GetBySSOLink(ctx context.Context, issuer, subject string) (*Account, error) {
+	res := a.col.FindOne(ctx, bson.M{"sso_links": bson.M{"$elemMatch": bson.M{
+		"issuer":  issuer,
+		"subject": subject,
+	}}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeAccount(raw)
+}
+
+// AddSSOLink links an identity provider account to a dev.
+// An identity provider account can only be linked to one dev.
+func (a *Accounts) AddSSOLink(ctx context.Context, key thread.PubKey, link SSOLink) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$addToSet": bson.M{"sso_links": bson.M{
+		"issuer":  link.Issuer,
+		"subject": link.Subject,
+	}}})
+	if err != nil {
+		if strings.Contains(err.Error(), DuplicateErrMsg) {
+			return ErrSSOLinked
+		}
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (a *Accounts) ValidateUsername(username string) error {
 	if !usernameRx.MatchString(username) {
 		return ErrInvalidUsername
@@ -381,6 +595,262 @@ func (a *Accounts) UpdatePowInfo(ctx context.Context, key thread.PubKey, powInfo
 	return a.Get(ctx, key)
 }
 
+// SetSSO sets or removes an org's single sign-on identity provider.
+func (a *Accounts) SetSSO(ctx context.Context, key thread.PubKey, conf *SSOConfig) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$unset": bson.M{"sso": ""}}
+	if conf != nil {
+		update = bson.M{"$set": bson.M{"sso": bson.M{
+			"issuer":        conf.Issuer,
+			"client_id":     conf.ClientID,
+			"client_secret": conf.ClientSecret,
+			"required":      conf.Required,
+		}}}
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetTransfer sets or, if transfer is nil, cancels an org's pending ownership transfer.
+func (a *Accounts) SetTransfer(ctx context.Context, key thread.PubKey, transfer *OwnershipTransfer) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$unset": bson.M{"transfer": ""}}
+	if transfer != nil {
+		fromID, err := transfer.From.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		toID, err := transfer.To.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		update = bson.M{"$set": bson.M{"transfer": bson.M{
+			"from_id":     fromID,
+			"to_id":       toID,
+			"to_username": transfer.ToUsername,
+			"expires_at":  transfer.ExpiresAt,
+		}}}
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetTwoFactor sets or, if tf is nil, removes a dev's two-factor authentication config.
+func (a *Accounts) SetTwoFactor(ctx context.Context, key thread.PubKey, tf *TwoFactor) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$unset": bson.M{"two_factor": ""}}
+	if tf != nil {
+		codes := tf.RecoveryCodes
+		if codes == nil {
+			codes = []string{}
+		}
+		update = bson.M{"$set": bson.M{"two_factor": bson.M{
+			"secret":         tf.Secret,
+			"enabled":        tf.Enabled,
+			"recovery_codes": codes,
+			"last_step":      tf.LastStep,
+		}}}
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// UseTwoFactorStep records that a code from time step was accepted.
+// mongo.ErrNoDocuments is returned if a code from the same or a later step was already accepted,
+// or if the dev is locked out.
+func (a *Accounts) UseTwoFactorStep(ctx context.Context, key thread.PubKey, step int64) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{
+		"_id":                     id,
+		"two_factor.last_step":    bson.M{"$lt": step},
+		"two_factor.locked_until": bson.M{"$not": bson.M{"$gt": time.Now()}},
+	}, bson.M{
+		"$set":   bson.M{"two_factor.last_step": step, "two_factor.failed_attempts": 0},
+		"$unset": bson.M{"two_factor.locked_until": ""},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// UseRecoveryCode removes the recovery code with hash so it can't be used again.
+// mongo.ErrNoDocuments is returned if the code doesn't exist or if the dev is locked out.
+func (a *Accounts) UseRecoveryCode(ctx context.Context, key thread.PubKey, hash string) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{
+		"_id":                       id,
+		"two_factor.enabled":        true,
+		"two_factor.recovery_codes": hash,
+		"two_factor.locked_until":   bson.M{"$not": bson.M{"$gt": time.Now()}},
+	}, bson.M{
+		"$pull":  bson.M{"two_factor.recovery_codes": hash},
+		"$set":   bson.M{"two_factor.failed_attempts": 0},
+		"$unset": bson.M{"two_factor.locked_until": ""},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// FailTwoFactor records a rejected two-factor code for a dev.
+// After maxTwoFactorFailures consecutive rejected codes, the dev is locked out
+// and the returned time is when codes are accepted again. Otherwise, the returned time is zero.
+func (a *Accounts) FailTwoFactor(ctx context.Context, key thread.PubKey) (time.Time, error) {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return time.Time{}, err
+	}
+	res := a.col.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id, "two_factor": bson.M{"$exists": true}},
+		bson.M{"$inc": bson.M{"two_factor.failed_attempts": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+	if res.Err() != nil {
+		return time.Time{}, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return time.Time{}, err
+	}
+	doc, err := decodeAccount(raw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	failures := doc.TwoFactor.FailedAttempts
+	if failures < maxTwoFactorFailures {
+		return time.Time{}, nil
+	}
+	lockout := maxTwoFactorLockout
+	if shift := failures - maxTwoFactorFailures; shift < 8 && twoFactorLockout<<shift < lockout {
+		lockout = twoFactorLockout << shift
+	}
+	until := time.Now().Add(lockout)
+	if _, err := a.col.UpdateOne(ctx, bson.M{"_id": id, "two_factor": bson.M{"$exists": true}}, bson.M{
+		"$set": bson.M{"two_factor.locked_until": until},
+	}); err != nil {
+		return time.Time{}, err
+	}
+	return until, nil
+}
+
+// SetRequireTwoFactor sets whether an org requires members to use two-factor authentication.
+func (a *Accounts) SetRequireTwoFactor(ctx context.Context, key thread.PubKey, required bool) error {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"require_two_factor": required}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// MarkQuotaWarned records that the account was warned about its quota in the usage period
+// starting at period. It returns false if a warning was already recorded for the period.
+func (a *Accounts) MarkQuotaWarned(ctx context.Context, key thread.PubKey, period int64) (bool, error) {
+	id, err := key.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	res, err := a.col.UpdateOne(ctx, bson.M{
+		"_id":                 id,
+		"quota_warned_period": bson.M{"$ne": period},
+	}, bson.M{"$set": bson.M{"quota_warned_period": period}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// ClaimTransfer removes the unexpired ownership transfer of org username to recipient.
+// The transfer can only be claimed while its sender is still an org owner.
+// The org is returned as it was before the transfer was claimed, so that only one caller can complete it.
+func (a *Accounts) ClaimTransfer(ctx context.Context, username string, recipient thread.PubKey) (*Account, error) {
+	toID, err := recipient.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{
+		"username":            username,
+		"transfer.to_id":      toID,
+		"transfer.expires_at": bson.M{"$gt": time.Now()},
+	}
+	found := a.col.FindOne(ctx, filter)
+	if found.Err() != nil {
+		return nil, found.Err()
+	}
+	var raw bson.M
+	if err := found.Decode(&raw); err != nil {
+		return nil, err
+	}
+	pending, err := decodeAccount(raw)
+	if err != nil {
+		return nil, err
+	}
+	fromID, err := pending.Transfer.From.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	// The sender must still be an owner when the transfer is claimed
+	filter["transfer.from_id"] = fromID
+	filter["members"] = bson.M{"$elemMatch": bson.M{"_id": fromID, "role": OrgOwner}}
+	res := a.col.FindOneAndUpdate(ctx, filter, bson.M{"$unset": bson.M{"transfer": ""}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	raw = bson.M{}
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeAccount(raw)
+}
+
 func (a *Accounts) ListByMember(ctx context.Context, member thread.PubKey) ([]Account, error) {
 	mid, err := member.MarshalBinary()
 	if err != nil {
@@ -527,53 +997,71 @@ func (a *Accounts) RemoveMember(ctx context.Context, username string, member thr
 	if err != nil {
 		return err
 	}
+	mid, err := member.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"username": username}
 	if isOwner { // Ensure there will still be at least one owner left
-		cursor, err := a.col.Aggregate(ctx, mongo.Pipeline{
-			bson.D{primitive.E{Key: "$match", Value: bson.M{"username": username}}},
-			bson.D{primitive.E{Key: "$project", Value: bson.M{
-				"members": bson.M{
-					"$filter": bson.M{
-						"input": "$members",
-						"as":    "member",
-						"cond":  bson.M{"$eq": bson.A{"$$member.role", 0}},
-					},
-				},
-			}}},
-			bson.D{primitive.E{Key: "$count", Value: "members"}},
-		}, options.Aggregate().SetHint(bson.D{primitive.E{Key: "username", Value: 1}}))
-		if err != nil {
-			return err
-		}
-		defer cursor.Close(ctx)
-		type res struct {
-			Owners int `bson:"members"`
-		}
-		var r res
-		cursor.Next(ctx)
-		if err := cursor.Decode(&r); err != nil {
-			return err
-		}
-		if err := cursor.Err(); err != nil {
-			return err
-		}
-		if r.Owners < 2 {
-			return fmt.Errorf("an org must have at least one owner")
+		filter["members"] = otherOwnerFilter(mid)
+	}
+	res, err := a.col.UpdateOne(ctx, filter, bson.M{"$pull": bson.M{"members": bson.M{"_id": mid}}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if isOwner {
+			return ErrLastOwner
 		}
+		return mongo.ErrNoDocuments
+	}
+	return a.cancelTransfer(ctx, username, mid)
+}
+
+// SetMemberRole changes the role of an org member.
+func (a *Accounts) SetMemberRole(ctx context.Context, username string, member thread.PubKey, role Role) error {
+	isOwner, err := a.IsOwner(ctx, username, member)
+	if err != nil {
+		return err
 	}
 	mid, err := member.MarshalBinary()
 	if err != nil {
 		return err
 	}
-	res, err := a.col.UpdateOne(ctx, bson.M{
-		"username": username,
-	}, bson.M{"$pull": bson.M{"members": bson.M{"_id": mid}}})
+	filter := bson.M{"username": username, "members._id": mid}
+	if isOwner && role != OrgOwner { // Ensure there will still be at least one owner left
+		filter["members"] = otherOwnerFilter(mid)
+	}
+	res, err := a.col.UpdateOne(
+		ctx,
+		filter,
+		bson.M{"$set": bson.M{"members.$[m].role": int32(role)}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"m._id": mid}}}),
+	)
 	if err != nil {
 		return err
 	}
 	if res.MatchedCount == 0 {
+		if isOwner {
+			return ErrLastOwner
+		}
 		return mongo.ErrNoDocuments
 	}
-	return nil
+	return a.cancelTransfer(ctx, username, mid)
+}
+
+// cancelTransfer removes any pending ownership transfer of org username sent by or to member.
+func (a *Accounts) cancelTransfer(ctx context.Context, username string, member []byte) error {
+	_, err := a.col.UpdateOne(ctx, bson.M{
+		"username": username,
+		"$or":      bson.A{bson.M{"transfer.from_id": member}, bson.M{"transfer.to_id": member}},
+	}, bson.M{"$unset": bson.M{"transfer": ""}})
+	return err
+}
+
+// otherOwnerFilter matches orgs with an owner other than member.
+func otherOwnerFilter(member []byte) bson.M {
+	return bson.M{"$elemMatch": bson.M{"_id": bson.M{"$ne": member}, "role": OrgOwner}}
 }
 
 func (a *Accounts) Delete(ctx context.Context, key thread.PubKey) error {
@@ -638,20 +1126,88 @@ func decodeAccount(raw bson.M) (*Account, error) {
 			}
 		}
 	}
+	var sso *SSOConfig
+	if v, ok := raw["sso"]; ok {
+		rsso := v.(bson.M)
+		sso = &SSOConfig{
+			Issuer:       rsso["issuer"].(string),
+			ClientID:     rsso["client_id"].(string),
+			ClientSecret: rsso["client_secret"].(string),
+			Required:     rsso["required"].(bool),
+		}
+	}
+	var transfer *OwnershipTransfer
+	if v, ok := raw["transfer"]; ok {
+		rt := v.(bson.M)
+		from := &thread.Libp2pPubKey{}
+		if err := from.UnmarshalBinary(rt["from_id"].(primitive.Binary).Data); err != nil {
+			return nil, err
+		}
+		to := &thread.Libp2pPubKey{}
+		if err := to.UnmarshalBinary(rt["to_id"].(primitive.Binary).Data); err != nil {
+			return nil, err
+		}
+		transfer = &OwnershipTransfer{
+			From:       from,
+			To:         to,
+			ToUsername: rt["to_username"].(string),
+			ExpiresAt:  rt["expires_at"].(primitive.DateTime).Time(),
+		}
+	}
+	var tf *TwoFactor
+	if v, ok := raw["two_factor"]; ok {
+		rtf := v.(bson.M)
+		tf = &TwoFactor{
+			Secret:         rtf["secret"].(string),
+			Enabled:        rtf["enabled"].(bool),
+			LastStep:       rtf["last_step"].(int64),
+			FailedAttempts: int(int64Value(rtf["failed_attempts"])),
+		}
+		if v, ok := rtf["locked_until"].(primitive.DateTime); ok {
+			tf.LockedUntil = v.Time()
+		}
+		if codes, ok := rtf["recovery_codes"].(bson.A); ok {
+			tf.RecoveryCodes = make([]string, len(codes))
+			for i, c := range codes {
+				tf.RecoveryCodes[i] = c.(string)
+			}
+		}
+	}
+	var ssoLinks []SSOLink
+	if v, ok := raw["sso_links"].(bson.A); ok {
+		for _, l := range v {
+			rl, ok := l.(bson.M)
+			if !ok {
+				continue
+			}
+			issuer, _ := rl["issuer"].(string)
+			subject, _ := rl["subject"].(string)
+			ssoLinks = append(ssoLinks, SSOLink{Issuer: issuer, Subject: subject})
+		}
+	}
+	var requireTwoFactor bool
+	if v, ok := raw["require_two_factor"]; ok {
+		requireTwoFactor = v.(bool)
+	}
 	var created time.Time
 	if v, ok := raw["created_at"]; ok {
 		created = v.(primitive.DateTime).Time()
 	}
 	return &Account{
-		Type:      AccountType(raw["type"].(int32)),
-		Key:       key,
-		Secret:    secret,
-		Name:      name,
-		Username:  username,
-		Email:     email,
-		Token:     token,
-		Members:   mems,
-		PowInfo:   decodePowInfo(raw),
-		CreatedAt: created,
+		Type:             AccountType(raw["type"].(int32)),
+		Key:              key,
+		Secret:           secret,
+		Name:             name,
+		Username:         username,
+		Email:            email,
+		Token:            token,
+		Members:          mems,
+		PowInfo:          decodePowInfo(raw),
+		SSO:              sso,
+		SSOLinks:         ssoLinks,
+		Transfer:         transfer,
+		TwoFactor:        tf,
+		RequireTwoFactor: requireTwoFactor,
+		CreatedAt:        created,
 	}, nil
 }
@@ -3,13 +3,17 @@ package mongodb_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/go-threads/core/thread"
 	. "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func TestAccounts_CreateDev(t *testing.T) {
@@ -235,6 +239,333 @@ func TestAccounts_SetToken(t *testing.T) {
 	assert.NotEmpty(t, got.Token)
 }
 
+func TestAccounts_SetSSO(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	org, err := col.CreateOrg(context.Background(), "myorg", []Member{{Key: dev.Key, Username: "jon", Role: OrgOwner}}, nil)
+	require.NoError(t, err)
+
+	conf := &SSOConfig{
+		Issuer:       "https://idp.example.com",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Required:     true,
+	}
+	err = col.SetSSO(context.Background(), org.Key, conf)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.Equal(t, conf, got.SSO)
+
+	err = col.SetSSO(context.Background(), org.Key, nil)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.Nil(t, got.SSO)
+}
+
+func TestAccounts_AddSSOLink(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	dev2, err := col.CreateDev(context.Background(), "jane", "jane@doe.com", nil)
+	require.NoError(t, err)
+
+	_, err = col.GetBySSOLink(context.Background(), "https://idp.example.com", "sub")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	link := SSOLink{Issuer: "https://idp.example.com", Subject: "sub"}
+	err = col.AddSSOLink(context.Background(), dev.Key, link)
+	require.NoError(t, err)
+	got, err := col.GetBySSOLink(context.Background(), link.Issuer, link.Subject)
+	require.NoError(t, err)
+	assert.True(t, got.Key.Equals(dev.Key))
+	assert.Equal(t, []SSOLink{link}, got.SSOLinks)
+
+	// The same subject at another issuer is a different account
+	_, err = col.GetBySSOLink(context.Background(), "https://other.example.com", "sub")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.AddSSOLink(context.Background(), dev2.Key, link)
+	require.True(t, errors.Is(err, ErrSSOLinked))
+}
+
+func TestAccounts_SetTransfer(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	dev2, err := col.CreateDev(context.Background(), "jane", "jane@doe.com", nil)
+	require.NoError(t, err)
+	org, err := col.CreateOrg(context.Background(), "myorg", []Member{{Key: dev.Key, Username: "jon", Role: OrgOwner}}, nil)
+	require.NoError(t, err)
+
+	err = col.SetTransfer(context.Background(), org.Key, &OwnershipTransfer{
+		From:       dev.Key,
+		To:         dev2.Key,
+		ToUsername: dev2.Username,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	require.NotNil(t, got.Transfer)
+	assert.True(t, got.Transfer.From.Equals(dev.Key))
+	assert.True(t, got.Transfer.To.Equals(dev2.Key))
+	assert.Equal(t, dev2.Username, got.Transfer.ToUsername)
+
+	err = col.SetTransfer(context.Background(), org.Key, nil)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.Nil(t, got.Transfer)
+}
+
+func TestAccounts_SetTwoFactor(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	assert.False(t, dev.TwoFactorEnabled())
+
+	err = col.SetTwoFactor(context.Background(), dev.Key, &TwoFactor{
+		Secret:        "secret",
+		Enabled:       true,
+		RecoveryCodes: []string{"a", "b"},
+	})
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), dev.Key)
+	require.NoError(t, err)
+	require.NotNil(t, got.TwoFactor)
+	assert.True(t, got.TwoFactorEnabled())
+	assert.Equal(t, "secret", got.TwoFactor.Secret)
+	assert.Equal(t, []string{"a", "b"}, got.TwoFactor.RecoveryCodes)
+
+	err = col.SetTwoFactor(context.Background(), dev.Key, nil)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Nil(t, got.TwoFactor)
+}
+
+func TestAccounts_UseTwoFactorStep(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	err = col.SetTwoFactor(context.Background(), dev.Key, &TwoFactor{Secret: "secret", Enabled: true})
+	require.NoError(t, err)
+
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 10)
+	require.NoError(t, err)
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 10)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 9)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 11)
+	require.NoError(t, err)
+}
+
+func TestAccounts_UseRecoveryCode(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	err = col.SetTwoFactor(context.Background(), dev.Key, &TwoFactor{
+		Secret:        "secret",
+		Enabled:       true,
+		RecoveryCodes: []string{"a", "b"},
+	})
+	require.NoError(t, err)
+
+	err = col.UseRecoveryCode(context.Background(), dev.Key, "a")
+	require.NoError(t, err)
+	err = col.UseRecoveryCode(context.Background(), dev.Key, "a")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	got, err := col.Get(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, got.TwoFactor.RecoveryCodes)
+}
+
+func TestAccounts_FailTwoFactor(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	err = col.SetTwoFactor(context.Background(), dev.Key, &TwoFactor{
+		Secret:        "secret",
+		Enabled:       true,
+		RecoveryCodes: []string{"a"},
+	})
+	require.NoError(t, err)
+
+	// Devs are locked out for a minute after five rejected codes
+	for i := 1; i < 5; i++ {
+		until, err := col.FailTwoFactor(context.Background(), dev.Key)
+		require.NoError(t, err)
+		assert.True(t, until.IsZero())
+	}
+	until, err := col.FailTwoFactor(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), until, time.Second)
+	got, err := col.Get(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, 5, got.TwoFactor.FailedAttempts)
+	assert.True(t, got.TwoFactorLocked(time.Now()))
+
+	// Codes aren't accepted while locked out
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 10)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.UseRecoveryCode(context.Background(), dev.Key, "a")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	// The lockout doubles with each further failure
+	until, err = col.FailTwoFactor(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), until, time.Second)
+
+	// An accepted code resets the failures
+	_, err = db.Collection("accounts").UpdateOne(context.Background(), bson.M{"username": "jon"},
+		bson.M{"$unset": bson.M{"two_factor.locked_until": ""}})
+	require.NoError(t, err)
+	err = col.UseTwoFactorStep(context.Background(), dev.Key, 10)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), dev.Key)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.TwoFactor.FailedAttempts)
+	assert.False(t, got.TwoFactorLocked(time.Now()))
+}
+
+func TestAccounts_SetRequireTwoFactor(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	org, err := col.CreateOrg(context.Background(), "myorg", []Member{{Key: dev.Key, Username: "jon", Role: OrgOwner}}, nil)
+	require.NoError(t, err)
+	assert.False(t, org.RequireTwoFactor)
+
+	err = col.SetRequireTwoFactor(context.Background(), org.Key, true)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.True(t, got.RequireTwoFactor)
+}
+
+func TestAccounts_MarkQuotaWarned(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+
+	ok, err := col.MarkQuotaWarned(context.Background(), dev.Key, 100)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = col.MarkQuotaWarned(context.Background(), dev.Key, 100)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = col.MarkQuotaWarned(context.Background(), dev.Key, 200)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestAccounts_ClaimTransfer(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	dev, err := col.CreateDev(context.Background(), "jon", "jon@doe.com", nil)
+	require.NoError(t, err)
+	dev2, err := col.CreateDev(context.Background(), "jane", "jane@doe.com", nil)
+	require.NoError(t, err)
+	org, err := col.CreateOrg(context.Background(), "myorg", []Member{{Key: dev.Key, Username: "jon", Role: OrgOwner}}, nil)
+	require.NoError(t, err)
+
+	_, err = col.ClaimTransfer(context.Background(), org.Username, dev2.Key)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.SetTransfer(context.Background(), org.Key, &OwnershipTransfer{
+		From:       dev.Key,
+		To:         dev2.Key,
+		ToUsername: dev2.Username,
+		ExpiresAt:  time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	_, err = col.ClaimTransfer(context.Background(), org.Username, dev2.Key)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments)) // Expired
+
+	err = col.SetTransfer(context.Background(), org.Key, &OwnershipTransfer{
+		From:       dev.Key,
+		To:         dev2.Key,
+		ToUsername: dev2.Username,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	_, err = col.ClaimTransfer(context.Background(), org.Username, dev.Key)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments)) // Wrong recipient
+	claimed, err := col.ClaimTransfer(context.Background(), org.Username, dev2.Key)
+	require.NoError(t, err)
+	require.NotNil(t, claimed.Transfer)
+	assert.True(t, claimed.Transfer.From.Equals(dev.Key))
+	_, err = col.ClaimTransfer(context.Background(), org.Username, dev2.Key)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments)) // Already claimed
+
+	dev3, err := col.CreateDev(context.Background(), "jim", "jim@doe.com", nil)
+	require.NoError(t, err)
+	err = col.AddMember(context.Background(), org.Username, Member{Key: dev3.Key, Username: "jim", Role: OrgOwner})
+	require.NoError(t, err)
+	err = col.SetTransfer(context.Background(), org.Key, &OwnershipTransfer{
+		From:       dev3.Key,
+		To:         dev2.Key,
+		ToUsername: dev2.Username,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	err = col.SetMemberRole(context.Background(), org.Username, dev3.Key, OrgAdmin)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.Nil(t, got.Transfer) // Cancelled by the sender's demotion
+	_, err = col.ClaimTransfer(context.Background(), org.Username, dev2.Key)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.SetMemberRole(context.Background(), org.Username, dev3.Key, OrgOwner)
+	require.NoError(t, err)
+	err = col.SetTransfer(context.Background(), org.Key, &OwnershipTransfer{
+		From:       dev3.Key,
+		To:         dev2.Key,
+		ToUsername: dev2.Username,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	err = col.RemoveMember(context.Background(), org.Username, dev3.Key)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), org.Key)
+	require.NoError(t, err)
+	assert.Nil(t, got.Transfer) // Cancelled by the sender's removal
+}
+
 func TestAccounts_UpdatePowInfo(t *testing.T) {
 	db := newDB(t)
 	col, err := NewAccounts(context.Background(), db)
@@ -454,6 +785,94 @@ func TestAccounts_RemoveMember(t *testing.T) {
 	list, err := col.ListByMember(context.Background(), thread.NewLibp2pPubKey(mem2))
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(list))
+
+	err = col.AddMember(context.Background(), created.Username, Member{
+		Key:      thread.NewLibp2pPubKey(mem2),
+		Username: "member",
+		Role:     OrgOwner,
+	})
+	require.NoError(t, err)
+	err = col.RemoveMember(context.Background(), created.Username, thread.NewLibp2pPubKey(mem1))
+	require.NoError(t, err) // Another owner is left
+}
+
+func TestAccounts_SetMemberRole(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAccounts(context.Background(), db)
+	require.NoError(t, err)
+
+	_, mem1, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	owner := thread.NewLibp2pPubKey(mem1)
+	created, err := col.CreateOrg(context.Background(), "test", []Member{{
+		Key:      owner,
+		Username: "test",
+		Role:     OrgOwner,
+	}}, nil)
+	require.NoError(t, err)
+
+	err = col.SetMemberRole(context.Background(), created.Username, owner, OrgAdmin)
+	require.True(t, errors.Is(err, ErrLastOwner)) // Can't demote the sole owner
+
+	_, mem2, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	member := thread.NewLibp2pPubKey(mem2)
+	err = col.AddMember(context.Background(), created.Username, Member{
+		Key:      member,
+		Username: "member",
+		Role:     OrgMember,
+	})
+	require.NoError(t, err)
+
+	err = col.SetMemberRole(context.Background(), created.Username, member, OrgReadOnly)
+	require.NoError(t, err)
+	got, err := col.GetByUsername(context.Background(), created.Username)
+	require.NoError(t, err)
+	role, ok := got.MemberRole(member)
+	require.True(t, ok)
+	assert.Equal(t, OrgReadOnly, role)
+
+	err = col.SetMemberRole(context.Background(), created.Username, member, OrgOwner)
+	require.NoError(t, err)
+	err = col.SetMemberRole(context.Background(), created.Username, owner, OrgDeveloper)
+	require.NoError(t, err) // There's another owner now
+	got, err = col.GetByUsername(context.Background(), created.Username)
+	require.NoError(t, err)
+	role, ok = got.MemberRole(owner)
+	require.True(t, ok)
+	assert.Equal(t, OrgDeveloper, role)
+
+	_, mem3, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	err = col.SetMemberRole(context.Background(), created.Username, thread.NewLibp2pPubKey(mem3), OrgAdmin)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
+
+func TestRole_Allows(t *testing.T) {
+	assert.True(t, OrgOwner.Allows(PermRemoveOrg))
+	assert.False(t, OrgAdmin.Allows(PermRemoveOrg))
+	assert.True(t, OrgAdmin.Allows(PermManageMembers))
+	assert.False(t, OrgMember.Allows(PermManageMembers))
+	assert.True(t, OrgMember.Allows(PermManageKeys))
+	assert.True(t, OrgDeveloper.Allows(PermWriteBuckets))
+	assert.False(t, OrgDeveloper.Allows(PermManageBilling))
+	assert.True(t, OrgBillingManager.Allows(PermManageBilling))
+	assert.False(t, OrgBillingManager.Allows(PermWriteBuckets))
+	assert.True(t, OrgReadOnly.Allows(PermReadBuckets))
+	assert.False(t, OrgReadOnly.Allows(PermWriteBuckets))
+	assert.True(t, OrgReadOnly.Allows(PermReadThreads))
+	assert.False(t, OrgReadOnly.Allows(PermWriteThreads))
+	assert.True(t, OrgDeveloper.Allows(PermWriteThreads))
+}
+
+func TestParseRole(t *testing.T) {
+	for r := OrgOwner; r <= OrgReadOnly; r++ {
+		got, err := ParseRole(r.String())
+		require.NoError(t, err)
+		assert.Equal(t, r, got)
+	}
+	_, err := ParseRole("superuser")
+	require.Error(t, err)
 }
 
 func TestAccounts_Delete(t *testing.T) {
@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/textileio/go-threads/core/thread"
@@ -9,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -23,6 +26,16 @@ const (
 	UserKey
 )
 
+// apiKeyScopes are the actions that API key scopes can grant for each resource.
+// Write grants all actions for a resource.
+var apiKeyScopes = map[string][]string{
+	"buckets":  {"read", "write"},
+	"threads":  {"read", "write"},
+	"mail":     {"read", "send", "write"},
+	"archives": {"read", "write"},
+	"usage":    {"read"},
+}
+
 type APIKey struct {
 	Key       string
 	Secret    string
@@ -31,6 +44,108 @@ type APIKey struct {
 	Secure    bool
 	Valid     bool
 	CreatedAt time.Time
+
+	// Scopes limit the key to some resources and actions, e.g., "buckets:read".
+	// A key without scopes has full account access.
+	Scopes []string
+	// ExpiresAt is when the key stops working. Zero means never.
+	ExpiresAt time.Time
+	// AllowedOrigins and AllowedIPs limit where the key can be used from, if not empty.
+	AllowedOrigins []string
+	AllowedIPs     []string
+	LastUsedAt     time.Time
+
+	// PreviousSecret is the secret replaced by the last rotation.
+	// It stays valid until PreviousSecretExpiresAt.
+	PreviousSecret           string
+	PreviousSecretExpiresAt  time.Time
+	PreviousSecretLastUsedAt time.Time
+	RotatedAt                time.Time
+}
+
+// APIKeyOptions are restrictions for a new API key.
+type APIKeyOptions struct {
+	Scopes         []string
+	ExpiresAt      time.Time
+	AllowedOrigins []string
+	AllowedIPs     []string
+}
+
+// ValidateScope returns an error if scope is not a valid API key scope.
+// Scopes have the form "resource:action". Bucket scopes can be limited to a bucket with "buckets:action:key".
+func ValidateScope(scope string) error {
+	parts := strings.Split(scope, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return fmt.Errorf("invalid scope %s", scope)
+	}
+	actions, ok := apiKeyScopes[parts[0]]
+	if !ok {
+		return fmt.Errorf("invalid scope %s: unknown resource %s", scope, parts[0])
+	}
+	var valid bool
+	for _, a := range actions {
+		if a == parts[1] {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("invalid scope %s: unknown action %s", scope, parts[1])
+	}
+	if len(parts) == 3 && (parts[0] != "buckets" || parts[2] == "") {
+		return fmt.Errorf("invalid scope %s: only buckets can be limited by key", scope)
+	}
+	return nil
+}
+
+// Expired returns whether the key has expired.
+func (k *APIKey) Expired() bool {
+	return !k.ExpiresAt.IsZero() && time.Now().After(k.ExpiresAt)
+}
+
+// PreviousSecretValid returns whether the secret replaced by the last rotation can still be used.
+func (k *APIKey) PreviousSecretValid() bool {
+	return k.PreviousSecret != "" && time.Now().Before(k.PreviousSecretExpiresAt)
+}
+
+// AllowsAction returns whether the key's scopes grant action on resource for any target.
+func (k *APIKey) AllowsAction(resource, action string) bool {
+	if len(k.Scopes) == 0 {
+		return true
+	}
+	for _, s := range k.Scopes {
+		if r, a, _ := splitScope(s); r == resource && (a == action || a == "write") {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsTarget returns whether the key's scopes grant action on resource for target.
+// An empty target is only granted by scopes that aren't limited to a target.
+func (k *APIKey) AllowsTarget(resource, action, target string) bool {
+	if len(k.Scopes) == 0 {
+		return true
+	}
+	for _, s := range k.Scopes {
+		r, a, t := splitScope(s)
+		if r == resource && (a == action || a == "write") && (t == "" || t == target) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitScope(scope string) (resource, action, target string) {
+	parts := strings.SplitN(scope, ":", 3)
+	resource = parts[0]
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+	if len(parts) > 2 {
+		target = parts[2]
+	}
+	return resource, action, target
 }
 
 func NewAPIKeyContext(ctx context.Context, key *APIKey) context.Context {
@@ -57,20 +172,35 @@ func NewAPIKeys(ctx context.Context, db *mongo.Database) (*APIKeys, error) {
 }
 
 func (k *APIKeys) Create(ctx context.Context, owner thread.PubKey, keyType APIKeyType, secure bool) (*APIKey, error) {
+	return k.CreateWithOptions(ctx, owner, keyType, secure, APIKeyOptions{})
+}
+
+// CreateWithOptions creates a key with scopes, expiry, and usage restrictions.
+func (k *APIKeys) CreateWithOptions(
+	ctx context.Context,
+	owner thread.PubKey,
+	keyType APIKeyType,
+	secure bool,
+	opts APIKeyOptions,
+) (*APIKey, error) {
 	doc := &APIKey{
-		Key:       util.MakeToken(keyLen),
-		Secret:    util.MakeToken(secretLen),
-		Owner:     owner,
-		Type:      keyType,
-		Secure:    secure,
-		Valid:     true,
-		CreatedAt: time.Now(),
+		Key:            util.MakeToken(keyLen),
+		Secret:         util.MakeToken(secretLen),
+		Owner:          owner,
+		Type:           keyType,
+		Secure:         secure,
+		Valid:          true,
+		CreatedAt:      time.Now(),
+		Scopes:         opts.Scopes,
+		ExpiresAt:      opts.ExpiresAt,
+		AllowedOrigins: opts.AllowedOrigins,
+		AllowedIPs:     opts.AllowedIPs,
 	}
 	ownerID, err := owner.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
-	if _, err := k.col.InsertOne(ctx, bson.M{
+	raw := bson.M{
 		"_id":        doc.Key,
 		"secret":     doc.Secret,
 		"owner_id":   ownerID,
@@ -78,7 +208,20 @@ func (k *APIKeys) Create(ctx context.Context, owner thread.PubKey, keyType APIKe
 		"secure":     doc.Secure,
 		"valid":      doc.Valid,
 		"created_at": doc.CreatedAt,
-	}); err != nil {
+	}
+	if len(doc.Scopes) != 0 {
+		raw["scopes"] = doc.Scopes
+	}
+	if !doc.ExpiresAt.IsZero() {
+		raw["expires_at"] = doc.ExpiresAt
+	}
+	if len(doc.AllowedOrigins) != 0 {
+		raw["allowed_origins"] = doc.AllowedOrigins
+	}
+	if len(doc.AllowedIPs) != 0 {
+		raw["allowed_ips"] = doc.AllowedIPs
+	}
+	if _, err := k.col.InsertOne(ctx, raw); err != nil {
 		return nil, err
 	}
 	return doc, nil
@@ -135,6 +278,68 @@ func (k *APIKeys) Invalidate(ctx context.Context, key string) error {
 	return nil
 }
 
+// Rotate replaces the key's secret with a new one.
+// The current secret stays valid for grace, replacing any previous secret that is still in its grace window.
+func (k *APIKeys) Rotate(ctx context.Context, key string, grace time.Duration) (*APIKey, error) {
+	doc, err := k.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	set := bson.M{
+		"secret":     util.MakeToken(secretLen),
+		"rotated_at": now,
+	}
+	update := bson.M{"$set": set}
+	if grace > 0 {
+		set["previous_secret"] = doc.Secret
+		set["previous_secret_expires_at"] = now.Add(grace)
+		update["$unset"] = bson.M{"previous_secret_last_used_at": ""}
+	} else {
+		update["$unset"] = bson.M{
+			"previous_secret":              "",
+			"previous_secret_expires_at":   "",
+			"previous_secret_last_used_at": "",
+		}
+	}
+	// Matching the old secret prevents concurrent rotations from both keeping the same previous secret
+	res := k.col.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": key, "secret": doc.Secret},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeAPIKey(raw)
+}
+
+// Touch records that the key was used.
+func (k *APIKeys) Touch(ctx context.Context, key string) error {
+	return k.touch(ctx, key, "last_used_at")
+}
+
+// TouchPreviousSecret records that the key was used with its previous secret.
+func (k *APIKeys) TouchPreviousSecret(ctx context.Context, key string) error {
+	return k.touch(ctx, key, "previous_secret_last_used_at")
+}
+
+func (k *APIKeys) touch(ctx context.Context, key, field string) error {
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{field: time.Now()}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (k *APIKeys) DeleteByOwner(ctx context.Context, owner thread.PubKey) error {
 	ownerID, err := owner.MarshalBinary()
 	if err != nil {
@@ -158,13 +363,58 @@ func decodeAPIKey(raw bson.M) (*APIKey, error) {
 	if v, ok := raw["secure"]; ok {
 		secure = v.(bool)
 	}
+	var expiry, lastUsed time.Time
+	if v, ok := raw["expires_at"]; ok {
+		expiry = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["last_used_at"]; ok {
+		lastUsed = v.(primitive.DateTime).Time()
+	}
+	var prevSecret string
+	if v, ok := raw["previous_secret"]; ok {
+		prevSecret = v.(string)
+	}
+	var prevExpiry, prevLastUsed, rotated time.Time
+	if v, ok := raw["previous_secret_expires_at"]; ok {
+		prevExpiry = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["previous_secret_last_used_at"]; ok {
+		prevLastUsed = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["rotated_at"]; ok {
+		rotated = v.(primitive.DateTime).Time()
+	}
 	return &APIKey{
-		Key:       raw["_id"].(string),
-		Secret:    raw["secret"].(string),
-		Owner:     owner,
-		Type:      APIKeyType(raw["type"].(int32)),
-		Secure:    secure,
-		Valid:     raw["valid"].(bool),
-		CreatedAt: created,
+		Key:            raw["_id"].(string),
+		Secret:         raw["secret"].(string),
+		Owner:          owner,
+		Type:           APIKeyType(raw["type"].(int32)),
+		Secure:         secure,
+		Valid:          raw["valid"].(bool),
+		CreatedAt:      created,
+		Scopes:         decodeStrings(raw["scopes"]),
+		ExpiresAt:      expiry,
+		AllowedOrigins: decodeStrings(raw["allowed_origins"]),
+		AllowedIPs:     decodeStrings(raw["allowed_ips"]),
+		LastUsedAt:     lastUsed,
+
+		PreviousSecret:           prevSecret,
+		PreviousSecretExpiresAt:  prevExpiry,
+		PreviousSecretLastUsedAt: prevLastUsed,
+		RotatedAt:                rotated,
 	}, nil
 }
+
+func decodeStrings(v interface{}) []string {
+	a, ok := v.(primitive.A)
+	if !ok {
+		return nil
+	}
+	list := make([]string, 0, len(a))
+	for _, i := range a {
+		if s, ok := i.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
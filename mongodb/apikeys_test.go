@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,31 @@ func TestAPIKeys_Create(t *testing.T) {
 	assert.True(t, created.Secure)
 }
 
+func TestAPIKeys_CreateWithOptions(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAPIKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	expiry := time.Now().Add(time.Hour).Round(time.Millisecond)
+	created, err := col.CreateWithOptions(context.Background(), thread.NewLibp2pPubKey(owner), AccountKey, true, APIKeyOptions{
+		Scopes:         []string{"buckets:read", "mail:send"},
+		ExpiresAt:      expiry,
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedIPs:     []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"buckets:read", "mail:send"}, got.Scopes)
+	assert.True(t, expiry.Equal(got.ExpiresAt))
+	assert.Equal(t, []string{"https://example.com"}, got.AllowedOrigins)
+	assert.Equal(t, []string{"10.0.0.0/8"}, got.AllowedIPs)
+	assert.True(t, got.LastUsedAt.IsZero())
+}
+
 func TestAPIKeys_Get(t *testing.T) {
 	db := newDB(t)
 	col, err := NewAPIKeys(context.Background(), db)
@@ -81,6 +107,64 @@ func TestAPIKeys_Invalidate(t *testing.T) {
 	require.False(t, got.Valid)
 }
 
+func TestAPIKeys_Touch(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAPIKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), AccountKey, true)
+	require.NoError(t, err)
+
+	err = col.Touch(context.Background(), created.Key)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), created.Key)
+	require.NoError(t, err)
+	assert.False(t, got.LastUsedAt.IsZero())
+}
+
+func TestAPIKeys_Rotate(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAPIKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), AccountKey, true)
+	require.NoError(t, err)
+
+	t.Run("with grace", func(t *testing.T) {
+		rotated, err := col.Rotate(context.Background(), created.Key, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, created.Key, rotated.Key)
+		assert.NotEqual(t, created.Secret, rotated.Secret)
+		assert.Equal(t, created.Secret, rotated.PreviousSecret)
+		assert.True(t, rotated.PreviousSecretValid())
+		assert.False(t, rotated.RotatedAt.IsZero())
+
+		err = col.TouchPreviousSecret(context.Background(), created.Key)
+		require.NoError(t, err)
+		got, err := col.Get(context.Background(), created.Key)
+		require.NoError(t, err)
+		assert.Equal(t, rotated.Secret, got.Secret)
+		assert.False(t, got.PreviousSecretLastUsedAt.IsZero())
+	})
+
+	t.Run("without grace", func(t *testing.T) {
+		rotated, err := col.Rotate(context.Background(), created.Key, 0)
+		require.NoError(t, err)
+		assert.Empty(t, rotated.PreviousSecret)
+		assert.False(t, rotated.PreviousSecretValid())
+		assert.True(t, rotated.PreviousSecretLastUsedAt.IsZero())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := col.Rotate(context.Background(), "missing", time.Hour)
+		require.Error(t, err)
+	})
+}
+
 func TestAPIKeys_DeleteByOwner(t *testing.T) {
 	db := newDB(t)
 	col, err := NewAPIKeys(context.Background(), db)
@@ -96,3 +180,29 @@ func TestAPIKeys_DeleteByOwner(t *testing.T) {
 	_, err = col.Get(context.Background(), created.Key)
 	require.Error(t, err)
 }
+
+func TestValidateScope(t *testing.T) {
+	for _, s := range []string{"buckets:read", "buckets:write:key", "threads:read", "mail:send", "usage:read"} {
+		assert.NoError(t, ValidateScope(s), s)
+	}
+	for _, s := range []string{"", "buckets", "buckets:delete", "foo:read", "threads:read:id", "buckets:read:", "mail:send:x:y"} {
+		assert.Error(t, ValidateScope(s), s)
+	}
+}
+
+func TestAPIKey_Allows(t *testing.T) {
+	key := &APIKey{Scopes: []string{"buckets:read", "buckets:write:mybucket", "mail:write"}}
+	assert.True(t, key.AllowsAction("buckets", "write"))
+	assert.True(t, key.AllowsAction("mail", "send"))
+	assert.False(t, key.AllowsAction("threads", "read"))
+
+	assert.True(t, key.AllowsTarget("buckets", "read", "other"))
+	assert.True(t, key.AllowsTarget("buckets", "write", "mybucket"))
+	assert.False(t, key.AllowsTarget("buckets", "write", "other"))
+	assert.False(t, key.AllowsTarget("buckets", "write", ""))
+
+	unscoped := &APIKey{}
+	assert.True(t, unscoped.AllowsTarget("threads", "write", ""))
+	assert.False(t, unscoped.Expired())
+	assert.True(t, (&APIKey{ExpiresAt: time.Now().Add(-time.Minute)}).Expired())
+}
@@ -130,3 +130,15 @@ func (k *BucketArchives) GetOrCreate(ctx context.Context, bucketKey string) (*Bu
 	}
 	return &doc, nil
 }
+
+func (k *BucketArchives) Get(ctx context.Context, bucketKey string) (*BucketArchive, error) {
+	res := k.col.FindOne(ctx, bson.M{"_id": bucketKey})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc BucketArchive
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -20,13 +20,17 @@ type ctxKey string
 type Collections struct {
 	m *mongo.Client
 
-	Sessions *Sessions
-	Accounts *Accounts
-	Invites  *Invites
+	Sessions  *Sessions
+	Accounts  *Accounts
+	Invites   *Invites
+	SSOStates *SSOStates
+	Logins    *Logins
+	Exports   *Exports
 
 	Threads         *Threads
 	APIKeys         *APIKeys
 	IPNSKeys        *IPNSKeys
+	Domains         *Domains
 	BucketArchives  *BucketArchives
 	ArchiveTracking *ArchiveTracking
 }
@@ -55,6 +59,18 @@ func NewCollections(ctx context.Context, uri, database string, hub bool) (*Colle
 		if err != nil {
 			return nil, err
 		}
+		c.SSOStates, err = NewSSOStates(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.Logins, err = NewLogins(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.Exports, err = NewExports(ctx, db)
+		if err != nil {
+			return nil, err
+		}
 		c.Threads, err = NewThreads(ctx, db)
 		if err != nil {
 			return nil, err
@@ -73,6 +89,10 @@ func NewCollections(ctx context.Context, uri, database string, hub bool) (*Colle
 	if err != nil {
 		return nil, err
 	}
+	c.Domains, err = NewDomains(ctx, db)
+	if err != nil {
+		return nil, err
+	}
 	c.BucketArchives, err = NewBucketArchives(ctx, db)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,154 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Domain is a custom domain attached to a bucket.
+type Domain struct {
+	Name       string
+	Key        string
+	ThreadID   thread.ID
+	Challenge  string
+	Verified   bool
+	CreatedAt  time.Time
+	VerifiedAt time.Time
+}
+
+type Domains struct {
+	col *mongo.Collection
+}
+
+func NewDomains(ctx context.Context, db *mongo.Database) (*Domains, error) {
+	d := &Domains{col: db.Collection("domains")}
+	_, err := d.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{primitive.E{Key: "key", Value: 1}},
+		},
+	})
+	return d, err
+}
+
+// Create attaches name to a bucket with an ownership challenge.
+// An existing unverified entry for name is replaced.
+func (d *Domains) Create(ctx context.Context, name, key string, threadID thread.ID, challenge string) (*Domain, error) {
+	doc := &Domain{
+		Name:      strings.ToLower(name),
+		Key:       key,
+		ThreadID:  threadID,
+		Challenge: challenge,
+		CreatedAt: time.Now(),
+	}
+	_, err := d.col.ReplaceOne(ctx, bson.M{"_id": doc.Name, "verified": false}, bson.M{
+		"_id":        doc.Name,
+		"key":        doc.Key,
+		"thread_id":  doc.ThreadID.Bytes(),
+		"challenge":  doc.Challenge,
+		"verified":   false,
+		"created_at": doc.CreatedAt,
+	}, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (d *Domains) Get(ctx context.Context, name string) (*Domain, error) {
+	res := d.col.FindOne(ctx, bson.M{"_id": strings.ToLower(name)})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeDomain(raw)
+}
+
+func (d *Domains) ListByKey(ctx context.Context, key string) ([]Domain, error) {
+	cursor, err := d.col.Find(ctx, bson.M{"key": key})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []Domain
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeDomain(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// SetVerified marks the domain as verified.
+func (d *Domains) SetVerified(ctx context.Context, name string) error {
+	res, err := d.col.UpdateOne(
+		ctx,
+		bson.M{"_id": strings.ToLower(name)},
+		bson.D{{"$set", bson.D{{"verified", true}, {"verified_at", time.Now()}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (d *Domains) Delete(ctx context.Context, name string) error {
+	res, err := d.col.DeleteOne(ctx, bson.M{"_id": strings.ToLower(name)})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteByKey removes all domains attached to a bucket.
+func (d *Domains) DeleteByKey(ctx context.Context, key string) error {
+	_, err := d.col.DeleteMany(ctx, bson.M{"key": key})
+	return err
+}
+
+func decodeDomain(raw bson.M) (*Domain, error) {
+	threadID, err := thread.Cast(raw["thread_id"].(primitive.Binary).Data)
+	if err != nil {
+		return nil, err
+	}
+	var created, verifiedAt time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["verified_at"]; ok {
+		verifiedAt = v.(primitive.DateTime).Time()
+	}
+	return &Domain{
+		Name:       raw["_id"].(string),
+		Key:        raw["key"].(string),
+		ThreadID:   threadID,
+		Challenge:  raw["challenge"].(string),
+		Verified:   raw["verified"].(bool),
+		CreatedAt:  created,
+		VerifiedAt: verifiedAt,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDomains_Create(t *testing.T) {
+	db := newDB(t)
+	col, err := NewDomains(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), "Example.com", "key", thread.NewIDV1(thread.Raw, 32), "challenge")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", created.Name)
+	assert.False(t, created.Verified)
+}
+
+func TestDomains_Get(t *testing.T) {
+	db := newDB(t)
+	col, err := NewDomains(context.Background(), db)
+	require.NoError(t, err)
+
+	threadID := thread.NewIDV1(thread.Raw, 32)
+	_, err = col.Create(context.Background(), "example.com", "key", threadID, "challenge")
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), "EXAMPLE.com")
+	require.NoError(t, err)
+	assert.Equal(t, "key", got.Key)
+	assert.Equal(t, threadID, got.ThreadID)
+	assert.Equal(t, "challenge", got.Challenge)
+}
+
+func TestDomains_SetVerified(t *testing.T) {
+	db := newDB(t)
+	col, err := NewDomains(context.Background(), db)
+	require.NoError(t, err)
+
+	_, err = col.Create(context.Background(), "example.com", "key", thread.NewIDV1(thread.Raw, 32), "challenge")
+	require.NoError(t, err)
+	err = col.SetVerified(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), "example.com")
+	require.NoError(t, err)
+	assert.True(t, got.Verified)
+	assert.False(t, got.VerifiedAt.IsZero())
+
+	// Verified domains can't be claimed by another bucket
+	_, err = col.Create(context.Background(), "example.com", "other", thread.NewIDV1(thread.Raw, 32), "challenge")
+	require.Error(t, err)
+}
+
+func TestDomains_ListByKey(t *testing.T) {
+	db := newDB(t)
+	col, err := NewDomains(context.Background(), db)
+	require.NoError(t, err)
+
+	threadID := thread.NewIDV1(thread.Raw, 32)
+	_, err = col.Create(context.Background(), "one.com", "key", threadID, "challenge")
+	require.NoError(t, err)
+	_, err = col.Create(context.Background(), "two.com", "key", threadID, "challenge")
+	require.NoError(t, err)
+
+	list, err := col.ListByKey(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+func TestDomains_Delete(t *testing.T) {
+	db := newDB(t)
+	col, err := NewDomains(context.Background(), db)
+	require.NoError(t, err)
+
+	threadID := thread.NewIDV1(thread.Raw, 32)
+	_, err = col.Create(context.Background(), "one.com", "key", threadID, "challenge")
+	require.NoError(t, err)
+	_, err = col.Create(context.Background(), "two.com", "key", threadID, "challenge")
+	require.NoError(t, err)
+
+	err = col.Delete(context.Background(), "one.com")
+	require.NoError(t, err)
+	_, err = col.Get(context.Background(), "one.com")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+
+	err = col.DeleteByKey(context.Background(), "key")
+	require.NoError(t, err)
+	list, err := col.ListByKey(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
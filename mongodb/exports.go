@@ -0,0 +1,259 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	exportDur = time.Hour * 24 * 7
+)
+
+type ExportStatus int
+
+const (
+	ExportPending ExportStatus = iota
+	ExportRunning
+	ExportComplete
+	ExportFailed
+)
+
+// Export is an account data export job.
+type Export struct {
+	ID    string
+	Owner thread.PubKey
+	// Replica identifies the hub replica running the export.
+	Replica string
+	Status  ExportStatus
+	// Stage describes the current step of a running export.
+	Stage string
+	// Done and Total count the completed and total steps.
+	Done  int
+	Total int
+	// Size is the size in bytes of a complete export archive.
+	Size      int64
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Finished returns whether the export is complete or failed.
+func (e *Export) Finished() bool {
+	return e.Status == ExportComplete || e.Status == ExportFailed
+}
+
+type Exports struct {
+	col *mongo.Collection
+}
+
+func NewExports(ctx context.Context, db *mongo.Database) (*Exports, error) {
+	e := &Exports{col: db.Collection("exports")}
+	_, err := e.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{primitive.E{Key: "owner_id", Value: 1}},
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	return e, err
+}
+
+// Create adds a pending export for owner that's run by replica.
+func (e *Exports) Create(ctx context.Context, owner thread.PubKey, replica string) (*Export, error) {
+	ownerID, err := owner.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	doc := &Export{
+		ID:        util.MakeToken(tokenLen),
+		Owner:     owner,
+		Replica:   replica,
+		Status:    ExportPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(exportDur),
+	}
+	if _, err := e.col.InsertOne(ctx, bson.M{
+		"_id":        doc.ID,
+		"owner_id":   ownerID,
+		"replica":    doc.Replica,
+		"status":     int(doc.Status),
+		"stage":      doc.Stage,
+		"done":       doc.Done,
+		"total":      doc.Total,
+		"size":       doc.Size,
+		"error":      doc.Error,
+		"created_at": doc.CreatedAt,
+		"updated_at": doc.UpdatedAt,
+		"expires_at": doc.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (e *Exports) Get(ctx context.Context, id string) (*Export, error) {
+	res := e.col.FindOne(ctx, bson.M{"_id": id, "expires_at": bson.M{"$gt": time.Now()}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeExport(raw)
+}
+
+// ListByOwner returns unexpired exports for owner, newest first.
+func (e *Exports) ListByOwner(ctx context.Context, owner thread.PubKey) ([]Export, error) {
+	ownerID, err := owner.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"owner_id": ownerID, "expires_at": bson.M{"$gt": time.Now()}}
+	opts := options.Find().SetSort(bson.D{primitive.E{Key: "created_at", Value: -1}})
+	cursor, err := e.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []Export
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeExport(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// SetProgress marks an export as running and records its current stage.
+// Like Complete and Fail, it returns mongo.ErrNoDocuments if the export has already finished.
+func (e *Exports) SetProgress(ctx context.Context, id, stage string, done, total int) error {
+	return e.updateUnfinished(ctx, id, bson.M{
+		"status": int(ExportRunning),
+		"stage":  stage,
+		"done":   done,
+		"total":  total,
+	})
+}
+
+// Complete marks an export as complete with the size of its archive.
+func (e *Exports) Complete(ctx context.Context, id string, size int64) error {
+	return e.updateUnfinished(ctx, id, bson.M{
+		"status": int(ExportComplete),
+		"stage":  "",
+		"size":   size,
+	})
+}
+
+// Fail marks an export as failed with msg.
+func (e *Exports) Fail(ctx context.Context, id, msg string) error {
+	return e.updateUnfinished(ctx, id, bson.M{
+		"status": int(ExportFailed),
+		"error":  msg,
+	})
+}
+
+// FailUnfinished marks the pending and running exports of replica as failed with msg.
+// It's used to fail exports that were interrupted by a restart of replica.
+func (e *Exports) FailUnfinished(ctx context.Context, replica, msg string) error {
+	_, err := e.col.UpdateMany(ctx, bson.M{
+		"replica": replica,
+		"status":  unfinishedExport(),
+	}, bson.M{"$set": bson.M{
+		"status":     int(ExportFailed),
+		"error":      msg,
+		"updated_at": time.Now(),
+	}})
+	return err
+}
+
+// updateUnfinished sets fields of the export with id if it's pending or running,
+// so a status set by another replica isn't overwritten.
+func (e *Exports) updateUnfinished(ctx context.Context, id string, set bson.M) error {
+	set["updated_at"] = time.Now()
+	res, err := e.col.UpdateOne(ctx, bson.M{"_id": id, "status": unfinishedExport()}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (e *Exports) Delete(ctx context.Context, id string) error {
+	res, err := e.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func unfinishedExport() bson.M {
+	return bson.M{"$in": bson.A{int(ExportPending), int(ExportRunning)}}
+}
+
+func decodeExport(raw bson.M) (*Export, error) {
+	owner := &thread.Libp2pPubKey{}
+	if err := owner.UnmarshalBinary(raw["owner_id"].(primitive.Binary).Data); err != nil {
+		return nil, err
+	}
+	date := func(k string) time.Time {
+		if v, ok := raw[k].(primitive.DateTime); ok {
+			return v.Time()
+		}
+		return time.Time{}
+	}
+	num := func(k string) int64 {
+		switch v := raw[k].(type) {
+		case int32:
+			return int64(v)
+		case int64:
+			return v
+		default:
+			return 0
+		}
+	}
+	str := func(k string) string {
+		v, _ := raw[k].(string)
+		return v
+	}
+	return &Export{
+		ID:        str("_id"),
+		Owner:     owner,
+		Replica:   str("replica"),
+		Status:    ExportStatus(num("status")),
+		Stage:     str("stage"),
+		Done:      int(num("done")),
+		Total:     int(num("total")),
+		Size:      num("size"),
+		Error:     str("error"),
+		CreatedAt: date("created_at"),
+		UpdatedAt: date("updated_at"),
+		ExpiresAt: date("expires_at"),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/v2/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestExports_Create(t *testing.T) {
+	db := newDB(t)
+	col, err := NewExports(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "replica", created.Replica)
+	assert.Equal(t, ExportPending, created.Status)
+	assert.True(t, created.ExpiresAt.After(time.Now()))
+}
+
+func TestExports_Progress(t *testing.T) {
+	db := newDB(t)
+	col, err := NewExports(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+
+	err = col.SetProgress(context.Background(), created.ID, "Exporting buckets", 2, 5)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportRunning, got.Status)
+	assert.Equal(t, "Exporting buckets", got.Stage)
+	assert.Equal(t, 2, got.Done)
+	assert.Equal(t, 5, got.Total)
+	assert.False(t, got.Finished())
+
+	err = col.Complete(context.Background(), created.ID, 1024)
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportComplete, got.Status)
+	assert.EqualValues(t, 1024, got.Size)
+	assert.True(t, got.Finished())
+
+	// Finished exports keep their status
+	err = col.Fail(context.Background(), created.ID, "Interrupted")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.SetProgress(context.Background(), created.ID, "Exporting mail", 4, 5)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	got, err = col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportComplete, got.Status)
+	assert.Empty(t, got.Error)
+}
+
+func TestExports_ListByOwner(t *testing.T) {
+	db := newDB(t)
+	col, err := NewExports(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	first, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+	second, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+
+	list, err := col.ListByOwner(context.Background(), thread.NewLibp2pPubKey(owner))
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, second.ID, list[0].ID)
+	assert.Equal(t, first.ID, list[1].ID)
+}
+
+func TestExports_FailUnfinished(t *testing.T) {
+	db := newDB(t)
+	col, err := NewExports(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	running, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+	err = col.SetProgress(context.Background(), running.ID, "Exporting threads", 1, 5)
+	require.NoError(t, err)
+	complete, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "replica")
+	require.NoError(t, err)
+	err = col.Complete(context.Background(), complete.ID, 10)
+	require.NoError(t, err)
+
+	other, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), "other")
+	require.NoError(t, err)
+
+	err = col.FailUnfinished(context.Background(), "replica", "Interrupted")
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportFailed, got.Status)
+	assert.Equal(t, "Interrupted", got.Error)
+	got, err = col.Get(context.Background(), complete.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportComplete, got.Status)
+	got, err = col.Get(context.Background(), other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ExportPending, got.Status)
+}
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -16,12 +17,23 @@ const (
 )
 
 type Invite struct {
-	Token     string
-	Org       string
-	From      thread.PubKey
-	EmailTo   string
-	Accepted  bool
-	ExpiresAt time.Time
+	Token   string
+	Org     string
+	From    thread.PubKey
+	EmailTo string
+	// UsernameTo is set instead of EmailTo for invites to existing users.
+	UsernameTo string
+	Accepted   bool
+	ExpiresAt  time.Time
+	SentAt     time.Time
+}
+
+// To returns the email address or username the invite was sent to.
+func (i *Invite) To() string {
+	if i.UsernameTo != "" {
+		return i.UsernameTo
+	}
+	return i.EmailTo
 }
 
 type Invites struct {
@@ -45,26 +57,43 @@ func NewInvites(ctx context.Context, db *mongo.Database) (*Invites, error) {
 }
 
 func (i *Invites) Create(ctx context.Context, from thread.PubKey, org, emailTo string) (*Invite, error) {
+	return i.create(ctx, from, org, emailTo, "")
+}
+
+// CreateForUsername creates an invite for an existing user.
+func (i *Invites) CreateForUsername(ctx context.Context, from thread.PubKey, org, username string) (*Invite, error) {
+	return i.create(ctx, from, org, "", username)
+}
+
+func (i *Invites) create(ctx context.Context, from thread.PubKey, org, emailTo, usernameTo string) (*Invite, error) {
+	now := time.Now()
 	doc := &Invite{
-		Token:     util.MakeToken(tokenLen),
-		Org:       org,
-		From:      from,
-		EmailTo:   emailTo,
-		Accepted:  false,
-		ExpiresAt: time.Now().Add(inviteDur),
+		Token:      util.MakeToken(tokenLen),
+		Org:        org,
+		From:       from,
+		EmailTo:    emailTo,
+		UsernameTo: usernameTo,
+		Accepted:   false,
+		ExpiresAt:  now.Add(inviteDur),
+		SentAt:     now,
 	}
 	fromID, err := from.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
-	if _, err := i.col.InsertOne(ctx, bson.M{
+	raw := bson.M{
 		"_id":        doc.Token,
 		"org":        doc.Org,
 		"from_id":    fromID,
 		"email_to":   doc.EmailTo,
 		"accepted":   doc.Accepted,
 		"expires_at": doc.ExpiresAt,
-	}); err != nil {
+		"sent_at":    doc.SentAt,
+	}
+	if doc.UsernameTo != "" {
+		raw["username_to"] = doc.UsernameTo
+	}
+	if _, err := i.col.InsertOne(ctx, raw); err != nil {
 		return nil, err
 	}
 	return doc, nil
@@ -106,6 +135,50 @@ func (i *Invites) ListByEmail(ctx context.Context, email string) ([]Invite, erro
 	return docs, nil
 }
 
+// ListByOrg returns all invites to org.
+func (i *Invites) ListByOrg(ctx context.Context, org string) ([]Invite, error) {
+	cursor, err := i.col.Find(ctx, bson.M{"org": org}, options.Find().SetSort(bson.D{primitive.E{Key: "sent_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []Invite
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeInvite(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Renew extends an invite's expiry for sending it again.
+func (i *Invites) Renew(ctx context.Context, token string) (*Invite, error) {
+	now := time.Now()
+	res := i.col.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": token},
+		bson.M{"$set": bson.M{"expires_at": now.Add(inviteDur), "sent_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	)
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeInvite(raw)
+}
+
 func (i *Invites) Accept(ctx context.Context, token string) error {
 	res, err := i.col.UpdateOne(ctx, bson.M{"_id": token}, bson.M{"$set": bson.M{"accepted": true}})
 	if err != nil {
@@ -157,16 +230,25 @@ func decodeInvite(raw bson.M) (*Invite, error) {
 	if err != nil {
 		return nil, err
 	}
-	var expiry time.Time
+	var expiry, sent time.Time
 	if v, ok := raw["expires_at"]; ok {
 		expiry = v.(primitive.DateTime).Time()
 	}
+	if v, ok := raw["sent_at"]; ok {
+		sent = v.(primitive.DateTime).Time()
+	}
+	var usernameTo string
+	if v, ok := raw["username_to"]; ok {
+		usernameTo = v.(string)
+	}
 	return &Invite{
-		Token:     raw["_id"].(string),
-		Org:       raw["org"].(string),
-		From:      from,
-		EmailTo:   raw["email_to"].(string),
-		Accepted:  raw["accepted"].(bool),
-		ExpiresAt: expiry,
+		Token:      raw["_id"].(string),
+		Org:        raw["org"].(string),
+		From:       from,
+		EmailTo:    raw["email_to"].(string),
+		UsernameTo: usernameTo,
+		Accepted:   raw["accepted"].(bool),
+		ExpiresAt:  expiry,
+		SentAt:     sent,
 	}, nil
 }
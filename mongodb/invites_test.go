@@ -25,6 +25,23 @@ func TestInvites_Create(t *testing.T) {
 	assert.True(t, created.ExpiresAt.After(time.Now()))
 }
 
+func TestInvites_CreateForUsername(t *testing.T) {
+	db := newDB(t)
+	col, err := NewInvites(context.Background(), db)
+	require.NoError(t, err)
+
+	_, from, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.CreateForUsername(context.Background(), thread.NewLibp2pPubKey(from), "myorg", "jane")
+	require.NoError(t, err)
+	assert.Equal(t, "jane", created.To())
+
+	got, err := col.Get(context.Background(), created.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "jane", got.UsernameTo)
+	assert.Empty(t, got.EmailTo)
+}
+
 func TestInvites_Get(t *testing.T) {
 	db := newDB(t)
 	col, err := NewInvites(context.Background(), db)
@@ -60,6 +77,52 @@ func TestInvites_ListByEmail(t *testing.T) {
 	require.Equal(t, created.Token, list[0].Token)
 }
 
+func TestInvites_ListByOrg(t *testing.T) {
+	db := newDB(t)
+	col, err := NewInvites(context.Background(), db)
+	require.NoError(t, err)
+
+	list, err := col.ListByOrg(context.Background(), "myorg")
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	_, from, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, err = col.Create(context.Background(), thread.NewLibp2pPubKey(from), "myorg", "jane@doe.com")
+	require.NoError(t, err)
+	_, err = col.CreateForUsername(context.Background(), thread.NewLibp2pPubKey(from), "myorg", "joe")
+	require.NoError(t, err)
+	_, err = col.Create(context.Background(), thread.NewLibp2pPubKey(from), "otherorg", "jane@doe.com")
+	require.NoError(t, err)
+
+	list, err = col.ListByOrg(context.Background(), "myorg")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+}
+
+func TestInvites_Renew(t *testing.T) {
+	db := newDB(t)
+	col, err := NewInvites(context.Background(), db)
+	require.NoError(t, err)
+
+	_, from, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(from), "myorg", "jane@doe.com")
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.Token)
+	require.NoError(t, err)
+
+	renewed, err := col.Renew(context.Background(), created.Token)
+	require.NoError(t, err)
+	assert.Equal(t, created.Token, renewed.Token)
+	assert.False(t, renewed.ExpiresAt.Before(got.ExpiresAt))
+	assert.False(t, renewed.SentAt.Before(got.SentAt))
+
+	_, err = col.Renew(context.Background(), "missing")
+	require.Error(t, err)
+}
+
 func TestInvites_Accept(t *testing.T) {
 	db := newDB(t)
 	col, err := NewInvites(context.Background(), db)
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/textileio/go-threads/core/thread"
@@ -11,13 +12,31 @@ import (
 )
 
 type IPNSKey struct {
-	Name      string
-	Cid       string
-	Path      string
-	ThreadID  thread.ID
+	Name     string
+	Cid      string
+	Path     string
+	ThreadID thread.ID
+	Settings IPNSSettings
+	// PrivKey is the marshaled private key, encrypted with a server secret.
+	// It's empty for keys that aren't exportable.
+	PrivKey   []byte
 	CreatedAt time.Time
 }
 
+// IPNSSettings controls how a key is published.
+// Zero durations use the manager defaults.
+type IPNSSettings struct {
+	// Disabled stops the key from being published and republished.
+	Disabled bool
+	// Lifetime is how long published records are valid.
+	Lifetime time.Duration
+	// TTL is how long resolvers may cache published records.
+	TTL time.Duration
+	// CoalesceInterval delays publishing after an update so that updates within the interval
+	// result in a single publish. If zero, every update is published.
+	CoalesceInterval time.Duration
+}
+
 type IPNSKeys struct {
 	col *mongo.Collection
 }
@@ -35,14 +54,18 @@ func NewIPNSKeys(ctx context.Context, db *mongo.Database) (*IPNSKeys, error) {
 	return k, err
 }
 
-func (k *IPNSKeys) Create(ctx context.Context, name, cid string, threadID thread.ID, pth string) error {
-	_, err := k.col.InsertOne(ctx, bson.M{
+func (k *IPNSKeys) Create(ctx context.Context, name, cid string, threadID thread.ID, pth string, privKey []byte) error {
+	doc := bson.M{
 		"_id":        name,
 		"cid":        cid,
 		"path":       pth,
 		"thread_id":  threadID.Bytes(),
 		"created_at": time.Now(),
-	})
+	}
+	if len(privKey) > 0 {
+		doc["priv_key"] = privKey
+	}
+	_, err := k.col.InsertOne(ctx, doc)
 	return err
 }
 
@@ -134,6 +157,27 @@ func (k *IPNSKeys) SetPath(ctx context.Context, pth string, name string) error {
 	return nil
 }
 
+// SetSettings updates the publishing settings for the ipnskey
+func (k *IPNSKeys) SetSettings(ctx context.Context, name string, settings IPNSSettings) error {
+	res, err := k.col.UpdateOne(
+		ctx,
+		bson.M{"_id": name},
+		bson.D{{"$set", bson.D{{"settings", bson.M{
+			"disabled":          settings.Disabled,
+			"lifetime":          int64(settings.Lifetime),
+			"ttl":               int64(settings.TTL),
+			"coalesce_interval": int64(settings.CoalesceInterval),
+		}}}}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (k *IPNSKeys) Delete(ctx context.Context, name string) error {
 	res, err := k.col.DeleteOne(ctx, bson.M{"_id": name})
 	if err != nil {
@@ -146,19 +190,51 @@ func (k *IPNSKeys) Delete(ctx context.Context, name string) error {
 }
 
 func decodeIPNSKey(raw bson.M) (*IPNSKey, error) {
-	threadID, err := thread.Cast(raw["thread_id"].(primitive.Binary).Data)
+	tid, ok := raw["thread_id"].(primitive.Binary)
+	if !ok {
+		return nil, errors.New("invalid ipns key thread id")
+	}
+	threadID, err := thread.Cast(tid.Data)
 	if err != nil {
 		return nil, err
 	}
 	var created time.Time
-	if v, ok := raw["created_at"]; ok {
-		created = v.(primitive.DateTime).Time()
-	}
+	if v, ok := raw["created_at"].(primitive.DateTime); ok {
+		created = v.Time()
+	}
+	var privKey []byte
+	if v, ok := raw["priv_key"].(primitive.Binary); ok {
+		privKey = v.Data
+	}
+	var settings IPNSSettings
+	if sraw, ok := raw["settings"].(bson.M); ok {
+		settings.Disabled, _ = sraw["disabled"].(bool)
+		settings.Lifetime = time.Duration(int64Value(sraw["lifetime"]))
+		settings.TTL = time.Duration(int64Value(sraw["ttl"]))
+		settings.CoalesceInterval = time.Duration(int64Value(sraw["coalesce_interval"]))
+	}
+	name, _ := raw["_id"].(string)
+	keyCid, _ := raw["cid"].(string)
+	pth, _ := raw["path"].(string)
 	return &IPNSKey{
-		Name:      raw["_id"].(string),
-		Cid:       raw["cid"].(string),
-		Path:      raw["path"].(string),
+		Name:      name,
+		Cid:       keyCid,
+		Path:      pth,
 		ThreadID:  threadID,
+		Settings:  settings,
+		PrivKey:   privKey,
 		CreatedAt: created,
 	}, nil
 }
+
+// int64Value returns v as an int64 if it's an integer, or zero.
+func int64Value(v interface{}) int64 {
+	switch v := v.(type) {
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	default:
+		return 0
+	}
+}
@@ -3,6 +3,7 @@ package mongodb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,17 +16,30 @@ func TestIPNSKeys_Create(t *testing.T) {
 	col, err := NewIPNSKeys(context.Background(), db)
 	require.NoError(t, err)
 
-	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path")
+	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path", nil)
 	require.NoError(t, err)
 }
 
+func TestIPNSKeys_CreateWithPrivKey(t *testing.T) {
+	db := newDB(t)
+	col, err := NewIPNSKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path", []byte("key"))
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), got.PrivKey)
+}
+
 func TestIPNSKeys_Get(t *testing.T) {
 	db := newDB(t)
 	col, err := NewIPNSKeys(context.Background(), db)
 	require.NoError(t, err)
 
 	threadID := thread.NewIDV1(thread.Raw, 32)
-	err = col.Create(context.Background(), "foo", "cid", threadID, "path")
+	err = col.Create(context.Background(), "foo", "cid", threadID, "path", nil)
 	require.NoError(t, err)
 
 	got, err := col.Get(context.Background(), "foo")
@@ -41,7 +55,7 @@ func TestIPNSKeys_GetByCid(t *testing.T) {
 	require.NoError(t, err)
 
 	threadID := thread.NewIDV1(thread.Raw, 32)
-	err = col.Create(context.Background(), "foo", "cid", threadID, "path")
+	err = col.Create(context.Background(), "foo", "cid", threadID, "path", nil)
 	require.NoError(t, err)
 
 	got, err := col.GetByCid(context.Background(), "cid")
@@ -57,7 +71,7 @@ func TestIPNSKeys_SetPath(t *testing.T) {
 	require.NoError(t, err)
 
 	threadID := thread.NewIDV1(thread.Raw, 32)
-	err = col.Create(context.Background(), "foo", "cid", threadID, "path")
+	err = col.Create(context.Background(), "foo", "cid", threadID, "path", nil)
 	require.NoError(t, err)
 
 	err = col.SetPath(context.Background(), "path2", "foo")
@@ -74,15 +88,40 @@ func TestIPNSKeys_SetPath(t *testing.T) {
 	assert.Equal(t, threadID, got.ThreadID)
 }
 
+func TestIPNSKeys_SetSettings(t *testing.T) {
+	db := newDB(t)
+	col, err := NewIPNSKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path", nil)
+	require.NoError(t, err)
+
+	settings := IPNSSettings{
+		Disabled:         true,
+		Lifetime:         time.Hour * 48,
+		TTL:              time.Minute,
+		CoalesceInterval: time.Minute * 5,
+	}
+	err = col.SetSettings(context.Background(), "foo", settings)
+	require.NoError(t, err)
+
+	err = col.SetSettings(context.Background(), "notfoo", settings)
+	require.Error(t, err)
+
+	got, err := col.Get(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, settings, got.Settings)
+}
+
 func TestIPNSKeys_ListByThreadID(t *testing.T) {
 	db := newDB(t)
 	col, err := NewIPNSKeys(context.Background(), db)
 	require.NoError(t, err)
 
 	threadID := thread.NewIDV1(thread.Raw, 32)
-	err = col.Create(context.Background(), "foo1", "cid1", threadID, "path1")
+	err = col.Create(context.Background(), "foo1", "cid1", threadID, "path1", nil)
 	require.NoError(t, err)
-	err = col.Create(context.Background(), "foo2", "cid2", threadID, "path2")
+	err = col.Create(context.Background(), "foo2", "cid2", threadID, "path2", nil)
 	require.NoError(t, err)
 
 	list1, err := col.ListByThreadID(context.Background(), threadID)
@@ -99,7 +138,7 @@ func TestIPNSKeys_Delete(t *testing.T) {
 	col, err := NewIPNSKeys(context.Background(), db)
 	require.NoError(t, err)
 
-	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path")
+	err = col.Create(context.Background(), "foo", "cid", thread.NewIDV1(thread.Raw, 32), "path", nil)
 	require.NoError(t, err)
 
 	err = col.Delete(context.Background(), "foo")
@@ -0,0 +1,190 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	loginDur = time.Minute * 30
+)
+
+// LoginType is the type of a pending login.
+type LoginType int
+
+const (
+	LoginSignup LoginType = iota
+	LoginSignin
+)
+
+// Login is a signup or signin that is waiting for email verification.
+type Login struct {
+	// ID is given to the client to poll for the login status.
+	ID string
+	// Secret is sent to the email address for verification.
+	Secret    string
+	Type      LoginType
+	Username  string
+	Email     string
+	Confirmed bool
+	// SSOIssuer and SSOClientID identify the identity provider used to sign on, if any.
+	SSOIssuer   string
+	SSOClientID string
+	ExpiresAt   time.Time
+}
+
+type Logins struct {
+	col *mongo.Collection
+}
+
+func NewLogins(ctx context.Context, db *mongo.Database) (*Logins, error) {
+	l := &Logins{col: db.Collection("logins")}
+	_, err := l.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{primitive.E{Key: "secret", Value: 1}},
+		},
+		{
+			Keys:    bson.D{primitive.E{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	return l, err
+}
+
+func (l *Logins) Create(ctx context.Context, typ LoginType, username, email, secret string) (*Login, error) {
+	doc := &Login{
+		ID:        util.MakeToken(tokenLen),
+		Secret:    secret,
+		Type:      typ,
+		Username:  username,
+		Email:     email,
+		ExpiresAt: time.Now().Add(loginDur),
+	}
+	if _, err := l.col.InsertOne(ctx, bson.M{
+		"_id":        doc.ID,
+		"secret":     doc.Secret,
+		"type":       int32(doc.Type),
+		"username":   doc.Username,
+		"email":      doc.Email,
+		"confirmed":  doc.Confirmed,
+		"expires_at": doc.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// CreateSSO creates a confirmed signin for a dev who signed on with the identity provider issuer
+// using the client clientID, but still needs to complete a second factor.
+func (l *Logins) CreateSSO(ctx context.Context, username, email, issuer, clientID string) (*Login, error) {
+	doc := &Login{
+		ID:          util.MakeToken(tokenLen),
+		Secret:      util.MakeToken(tokenLen),
+		Type:        LoginSignin,
+		Username:    username,
+		Email:       email,
+		Confirmed:   true,
+		SSOIssuer:   issuer,
+		SSOClientID: clientID,
+		ExpiresAt:   time.Now().Add(loginDur),
+	}
+	if _, err := l.col.InsertOne(ctx, bson.M{
+		"_id":           doc.ID,
+		"secret":        doc.Secret,
+		"type":          int32(doc.Type),
+		"username":      doc.Username,
+		"email":         doc.Email,
+		"confirmed":     doc.Confirmed,
+		"sso_issuer":    doc.SSOIssuer,
+		"sso_client_id": doc.SSOClientID,
+		"expires_at":    doc.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (l *Logins) Get(ctx context.Context, id string) (*Login, error) {
+	res := l.col.FindOne(ctx, bson.M{"_id": id})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeLogin(raw), nil
+}
+
+// Confirm marks unexpired logins with secret as confirmed.
+func (l *Logins) Confirm(ctx context.Context, secret string) error {
+	res, err := l.col.UpdateMany(ctx, bson.M{
+		"secret":     secret,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}, bson.M{"$set": bson.M{"confirmed": true}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Complete deletes and returns a confirmed login.
+// A login can only be completed once.
+func (l *Logins) Complete(ctx context.Context, id string) (*Login, error) {
+	res := l.col.FindOneAndDelete(ctx, bson.M{"_id": id, "confirmed": true})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeLogin(raw), nil
+}
+
+func (l *Logins) Delete(ctx context.Context, id string) error {
+	res, err := l.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodeLogin(raw bson.M) *Login {
+	var expiry time.Time
+	if v, ok := raw["expires_at"]; ok {
+		expiry = v.(primitive.DateTime).Time()
+	}
+	var typ LoginType
+	if v, ok := raw["type"]; ok {
+		typ = LoginType(v.(int32))
+	}
+	str := func(k string) string {
+		v, _ := raw[k].(string)
+		return v
+	}
+	confirmed, _ := raw["confirmed"].(bool)
+	return &Login{
+		ID:          str("_id"),
+		Secret:      str("secret"),
+		Type:        typ,
+		Username:    str("username"),
+		Email:       str("email"),
+		Confirmed:   confirmed,
+		SSOIssuer:   str("sso_issuer"),
+		SSOClientID: str("sso_client_id"),
+		ExpiresAt:   expiry,
+	}
+}
@@ -0,0 +1,92 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/v2/mongodb"
+)
+
+func TestLogins_Create(t *testing.T) {
+	db := newDB(t)
+	col, err := NewLogins(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), LoginSignup, "jane", "jane@doe.com", "secret")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.False(t, created.Confirmed)
+	assert.True(t, created.ExpiresAt.After(time.Now()))
+}
+
+func TestLogins_Get(t *testing.T) {
+	db := newDB(t)
+	col, err := NewLogins(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), LoginSignin, "jane", "jane@doe.com", "secret")
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, LoginSignin, got.Type)
+	assert.Equal(t, "jane", got.Username)
+	assert.Equal(t, "jane@doe.com", got.Email)
+	assert.Equal(t, "secret", got.Secret)
+}
+
+func TestLogins_Confirm(t *testing.T) {
+	db := newDB(t)
+	col, err := NewLogins(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), LoginSignup, "jane", "jane@doe.com", "secret")
+	require.NoError(t, err)
+
+	err = col.Confirm(context.Background(), "other")
+	require.Error(t, err)
+	err = col.Confirm(context.Background(), "secret")
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Confirmed)
+}
+
+func TestLogins_Complete(t *testing.T) {
+	db := newDB(t)
+	col, err := NewLogins(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), LoginSignup, "jane", "jane@doe.com", "secret")
+	require.NoError(t, err)
+
+	_, err = col.Complete(context.Background(), created.ID)
+	require.Error(t, err)
+	err = col.Confirm(context.Background(), "secret")
+	require.NoError(t, err)
+	completed, err := col.Complete(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "jane", completed.Username)
+
+	// Logins can only be completed once
+	_, err = col.Complete(context.Background(), created.ID)
+	require.Error(t, err)
+}
+
+func TestLogins_CreateSSO(t *testing.T) {
+	db := newDB(t)
+	col, err := NewLogins(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.CreateSSO(context.Background(), "jane", "jane@doe.com", "https://idp.example.com", "client")
+	require.NoError(t, err)
+	completed, err := col.Complete(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, LoginSignin, completed.Type)
+	assert.Equal(t, "https://idp.example.com", completed.SSOIssuer)
+	assert.Equal(t, "client", completed.SSOClientID)
+}
@@ -180,6 +180,55 @@ var m004 = migrate.Migration{
 	},
 }
 
+var m005 = migrate.Migration{
+	Version:     5,
+	Description: "drop sessions developer_id index",
+	Up: func(db *mongo.Database) error {
+		log.Info("migrating 005 up")
+		ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+		defer cancel()
+		count, err := db.Collection("sessions").CountDocuments(ctx, bson.M{})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil // namespace doesn't exist
+		}
+		cursor, err := db.Collection("sessions").Indexes().List(ctx)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close(ctx)
+		for cursor.Next(ctx) {
+			var index bson.M
+			if err := cursor.Decode(&index); err != nil {
+				return err
+			}
+			if index["name"] == "developer_id_1" {
+				_, err = db.Collection("sessions").Indexes().DropOne(ctx, "developer_id_1")
+				return err
+			}
+		}
+		return cursor.Err()
+	},
+	Down: func(db *mongo.Database) error {
+		log.Info("migrating 005 down")
+		ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+		defer cancel()
+		count, err := db.Collection("sessions").CountDocuments(ctx, bson.M{})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil // namespace doesn't exist
+		}
+		_, err = db.Collection("sessions").Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{{"developer_id", 1}},
+		})
+		return err
+	},
+}
+
 func Migrate(db *mongo.Database) error {
 	m := migrate.NewMigrate(
 		db,
@@ -187,6 +236,7 @@ func Migrate(db *mongo.Database) error {
 		m002,
 		m003,
 		m004,
+		m005,
 	)
 	return m.Up(migrate.AllAvailable)
 }
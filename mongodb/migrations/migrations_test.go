@@ -183,6 +183,51 @@ func TestMigrations_m004(t *testing.T) {
 	assert.Nil(t, key2["path"])
 }
 
+// Test drop sessions developer_id index
+func TestMigrations_m005(t *testing.T) {
+	ctx := context.Background()
+	db := setup(t, ctx)
+
+	// Run up without a sessions collection
+	err := migrate.NewMigrate(db, m005).Up(migrate.AllAvailable)
+	require.NoError(t, err)
+	err = migrate.NewMigrate(db, m005).Down(migrate.AllAvailable)
+	require.NoError(t, err)
+
+	// Preload collection
+	_, err = db.Collection("sessions").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"developer_id", 1}},
+	})
+	require.NoError(t, err)
+	_, err = db.Collection("sessions").InsertOne(ctx, bson.M{"_id": "id", "owner_id": "owner"})
+	require.NoError(t, err)
+
+	// Run up
+	err = migrate.NewMigrate(db, m005).Up(migrate.AllAvailable)
+	require.NoError(t, err)
+	assert.False(t, hasIndex(t, ctx, db.Collection("sessions"), "developer_id_1"))
+
+	// Run down
+	err = migrate.NewMigrate(db, m005).Down(migrate.AllAvailable)
+	require.NoError(t, err)
+	assert.True(t, hasIndex(t, ctx, db.Collection("sessions"), "developer_id_1"))
+}
+
+func hasIndex(t *testing.T, ctx context.Context, col *mongo.Collection, name string) bool {
+	cursor, err := col.Indexes().List(ctx)
+	require.NoError(t, err)
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var index bson.M
+		require.NoError(t, cursor.Decode(&index))
+		if index["name"] == name {
+			return true
+		}
+	}
+	require.NoError(t, cursor.Err())
+	return false
+}
+
 func setup(t *testing.T, ctx context.Context) *mongo.Database {
 	client, err := mongo.Connect(ctx, options.Client().ApplyURI(test.GetMongoUri()))
 	require.NoError(t, err)
@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/textileio/go-threads/core/thread"
@@ -9,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -16,9 +19,29 @@ const (
 )
 
 type Session struct {
-	ID        string
-	Owner     thread.PubKey
-	ExpiresAt time.Time
+	ID    string
+	Owner thread.PubKey
+	// SSOIssuer and SSOClientID identify the identity provider used to sign on, if any.
+	SSOIssuer   string
+	SSOClientID string
+	// Device describes the client that created the session.
+	Device     SessionDevice
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// SessionDevice describes the client a session was created from.
+type SessionDevice struct {
+	UserAgent string
+	IP        string
+}
+
+// Ref returns a reference to the session that can be shown to users.
+// Unlike the ID, the reference can't be used to authenticate.
+func (s *Session) Ref() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:8])
 }
 
 func NewSessionContext(ctx context.Context, session *Session) context.Context {
@@ -38,27 +61,53 @@ func NewSessions(ctx context.Context, db *mongo.Database) (*Sessions, error) {
 	s := &Sessions{col: db.Collection("sessions")}
 	_, err := s.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
-			Keys: bson.D{primitive.E{Key: "developer_id", Value: 1}},
+			Keys: bson.D{primitive.E{Key: "owner_id", Value: 1}},
 		},
 	})
 	return s, err
 }
 
-func (s *Sessions) Create(ctx context.Context, owner thread.PubKey) (*Session, error) {
+func (s *Sessions) Create(ctx context.Context, owner thread.PubKey, device SessionDevice) (*Session, error) {
+	return s.CreateSSO(ctx, owner, "", "", device)
+}
+
+// CreateSSO creates a session for an owner who signed on with the identity provider issuer
+// using the client clientID.
+func (s *Sessions) CreateSSO(
+	ctx context.Context,
+	owner thread.PubKey,
+	issuer, clientID string,
+	device SessionDevice,
+) (*Session, error) {
+	now := time.Now()
 	doc := &Session{
-		ID:        util.MakeToken(tokenLen),
-		Owner:     owner,
-		ExpiresAt: time.Now().Add(sessionDur),
+		ID:          util.MakeToken(tokenLen),
+		Owner:       owner,
+		SSOIssuer:   issuer,
+		SSOClientID: clientID,
+		Device:      device,
+		CreatedAt:   now,
+		LastSeenAt:  now,
+		ExpiresAt:   now.Add(sessionDur),
 	}
 	ownerID, err := owner.MarshalBinary()
 	if err != nil {
 		return nil, err
 	}
-	if _, err := s.col.InsertOne(ctx, bson.M{
-		"_id":        doc.ID,
-		"owner_id":   ownerID,
-		"expires_at": doc.ExpiresAt,
-	}); err != nil {
+	data := bson.M{
+		"_id":          doc.ID,
+		"owner_id":     ownerID,
+		"user_agent":   device.UserAgent,
+		"ip":           device.IP,
+		"created_at":   doc.CreatedAt,
+		"last_seen_at": doc.LastSeenAt,
+		"expires_at":   doc.ExpiresAt,
+	}
+	if issuer != "" {
+		data["sso_issuer"] = issuer
+		data["sso_client_id"] = clientID
+	}
+	if _, err := s.col.InsertOne(ctx, data); err != nil {
 		return nil, err
 	}
 	return doc, nil
@@ -76,9 +125,44 @@ func (s *Sessions) Get(ctx context.Context, id string) (*Session, error) {
 	return decodeSession(raw)
 }
 
+// ListByOwner returns the unexpired sessions of owner, most recently seen first.
+func (s *Sessions) ListByOwner(ctx context.Context, owner thread.PubKey) ([]Session, error) {
+	ownerID, err := owner.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"owner_id": ownerID, "expires_at": bson.M{"$gt": time.Now()}}
+	opts := options.Find().SetSort(bson.D{primitive.E{Key: "last_seen_at", Value: -1}})
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []Session
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeSession(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Touch extends the session expiry and records that the session was seen.
 func (s *Sessions) Touch(ctx context.Context, id string) error {
-	expiry := time.Now().Add(sessionDur)
-	res, err := s.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"expires_at": expiry}})
+	now := time.Now()
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{
+		"last_seen_at": now,
+		"expires_at":   now.Add(sessionDur),
+	}})
 	if err != nil {
 		return err
 	}
@@ -108,6 +192,16 @@ func (s *Sessions) DeleteByOwner(ctx context.Context, owner thread.PubKey) error
 	return err
 }
 
+// DeleteByOwnerExcept deletes all sessions of owner except the session with id.
+func (s *Sessions) DeleteByOwnerExcept(ctx context.Context, owner thread.PubKey, id string) error {
+	ownerID, err := owner.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = s.col.DeleteMany(ctx, bson.M{"owner_id": ownerID, "_id": bson.M{"$ne": id}})
+	return err
+}
+
 func decodeSession(raw bson.M) (*Session, error) {
 	owner := &thread.Libp2pPubKey{}
 	err := owner.UnmarshalBinary(raw["owner_id"].(primitive.Binary).Data)
@@ -118,9 +212,30 @@ func decodeSession(raw bson.M) (*Session, error) {
 	if v, ok := raw["expires_at"]; ok {
 		expiry = v.(primitive.DateTime).Time()
 	}
+	issuer, _ := raw["sso_issuer"].(string)
+	clientID, _ := raw["sso_client_id"].(string)
+	var device SessionDevice
+	if v, ok := raw["user_agent"]; ok {
+		device.UserAgent = v.(string)
+	}
+	if v, ok := raw["ip"]; ok {
+		device.IP = v.(string)
+	}
+	var created, lastSeen time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["last_seen_at"]; ok {
+		lastSeen = v.(primitive.DateTime).Time()
+	}
 	return &Session{
-		ID:        raw["_id"].(string),
-		Owner:     owner,
-		ExpiresAt: expiry,
+		ID:          raw["_id"].(string),
+		Owner:       owner,
+		SSOIssuer:   issuer,
+		SSOClientID: clientID,
+		Device:      device,
+		CreatedAt:   created,
+		LastSeenAt:  lastSeen,
+		ExpiresAt:   expiry,
 	}, nil
 }
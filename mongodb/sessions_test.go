@@ -20,7 +20,7 @@ func TestSessions_Create(t *testing.T) {
 
 	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
 	require.NoError(t, err)
-	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner))
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
 	require.NoError(t, err)
 	assert.True(t, created.ExpiresAt.After(time.Now()))
 }
@@ -32,12 +32,39 @@ func TestSessions_Get(t *testing.T) {
 
 	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
 	require.NoError(t, err)
-	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner))
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
 	require.NoError(t, err)
 
 	got, err := col.Get(context.Background(), created.ID)
 	require.NoError(t, err)
 	assert.Equal(t, created.ID, got.ID)
+
+	t.Run("with device", func(t *testing.T) {
+		device := SessionDevice{UserAgent: "hub/v2.0.0", IP: "203.0.113.7"}
+		created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), device)
+		require.NoError(t, err)
+		got, err := col.Get(context.Background(), created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, device, got.Device)
+		assert.False(t, got.CreatedAt.IsZero())
+		assert.False(t, got.LastSeenAt.IsZero())
+	})
+}
+
+func TestSessions_CreateSSO(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSessions(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.CreateSSO(context.Background(), thread.NewLibp2pPubKey(owner), "https://idp.example.com", "client", SessionDevice{})
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com", got.SSOIssuer)
+	assert.Equal(t, "client", got.SSOClientID)
 }
 
 func TestSessions_Touch(t *testing.T) {
@@ -47,7 +74,7 @@ func TestSessions_Touch(t *testing.T) {
 
 	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
 	require.NoError(t, err)
-	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner))
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
 	require.NoError(t, err)
 
 	time.Sleep(time.Second)
@@ -56,6 +83,30 @@ func TestSessions_Touch(t *testing.T) {
 	got, err := col.Get(context.Background(), created.ID)
 	require.NoError(t, err)
 	assert.True(t, got.ExpiresAt.After(created.ExpiresAt))
+	assert.True(t, got.LastSeenAt.After(created.LastSeenAt))
+}
+
+func TestSessions_ListByOwner(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSessions(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	first, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
+	require.NoError(t, err)
+	second, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
+	require.NoError(t, err)
+
+	time.Sleep(time.Second)
+	err = col.Touch(context.Background(), first.ID)
+	require.NoError(t, err)
+	list, err := col.ListByOwner(context.Background(), thread.NewLibp2pPubKey(owner))
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, first.ID, list[0].ID)
+	assert.Equal(t, second.ID, list[1].ID)
+	assert.NotEqual(t, list[0].Ref(), list[1].Ref())
 }
 
 func TestSessions_Delete(t *testing.T) {
@@ -65,7 +116,7 @@ func TestSessions_Delete(t *testing.T) {
 
 	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
 	require.NoError(t, err)
-	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner))
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
 	require.NoError(t, err)
 
 	err = col.Delete(context.Background(), created.ID)
@@ -81,7 +132,7 @@ func TestSessions_DeleteByOwner(t *testing.T) {
 
 	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
 	require.NoError(t, err)
-	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner))
+	created, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
 	require.NoError(t, err)
 
 	err = col.DeleteByOwner(context.Background(), created.Owner)
@@ -89,3 +140,23 @@ func TestSessions_DeleteByOwner(t *testing.T) {
 	_, err = col.Get(context.Background(), created.ID)
 	require.Error(t, err)
 }
+
+func TestSessions_DeleteByOwnerExcept(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSessions(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	keep, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
+	require.NoError(t, err)
+	other, err := col.Create(context.Background(), thread.NewLibp2pPubKey(owner), SessionDevice{})
+	require.NoError(t, err)
+
+	err = col.DeleteByOwnerExcept(context.Background(), keep.Owner, keep.ID)
+	require.NoError(t, err)
+	_, err = col.Get(context.Background(), keep.ID)
+	require.NoError(t, err)
+	_, err = col.Get(context.Background(), other.ID)
+	require.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ssoStateDur = time.Minute * 30
+)
+
+// SSOState is a pending single sign-on with an identity provider.
+type SSOState struct {
+	// ID is the OAuth2 state parameter.
+	ID string
+	// Org is the username of the org whose identity provider is used.
+	// It's empty for the hub's default provider.
+	Org string
+	// Nonce is the OpenID Connect nonce expected in the ID token.
+	Nonce       string
+	RedirectURI string
+	// Verifier is the PKCE code verifier when the hub receives the callback instead of the client.
+	Verifier string
+	// Username is used if sign on creates a new account.
+	Username string
+	// LinkOwner is the dev that started the sign on from a session to link the provider account.
+	// It's nil for sign ons that sign in or create an account.
+	LinkOwner thread.PubKey
+	// Code and Error are set when the hub receives the callback.
+	Code      string
+	Error     string
+	ExpiresAt time.Time
+}
+
+type SSOStates struct {
+	col *mongo.Collection
+}
+
+func NewSSOStates(ctx context.Context, db *mongo.Database) (*SSOStates, error) {
+	s := &SSOStates{col: db.Collection("ssostates")}
+	_, err := s.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{primitive.E{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	return s, err
+}
+
+func (s *SSOStates) Create(
+	ctx context.Context,
+	org, redirectURI, verifier, username string,
+	linkOwner thread.PubKey,
+) (*SSOState, error) {
+	doc := &SSOState{
+		ID:          util.MakeToken(tokenLen),
+		Org:         org,
+		Nonce:       util.MakeToken(tokenLen),
+		RedirectURI: redirectURI,
+		Verifier:    verifier,
+		Username:    username,
+		LinkOwner:   linkOwner,
+		ExpiresAt:   time.Now().Add(ssoStateDur),
+	}
+	data := bson.M{
+		"_id":          doc.ID,
+		"org":          doc.Org,
+		"nonce":        doc.Nonce,
+		"redirect_uri": doc.RedirectURI,
+		"verifier":     doc.Verifier,
+		"username":     doc.Username,
+		"code":         doc.Code,
+		"error":        doc.Error,
+		"expires_at":   doc.ExpiresAt,
+	}
+	if linkOwner != nil {
+		ownerID, err := linkOwner.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		data["link_owner_id"] = ownerID
+	}
+	if _, err := s.col.InsertOne(ctx, data); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (s *SSOStates) Get(ctx context.Context, id string) (*SSOState, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": id})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeSSOState(raw)
+}
+
+// SetResult records the authorization code or error received by the callback.
+// A result can only be set once.
+func (s *SSOStates) SetResult(ctx context.Context, id, code, errMsg string) error {
+	res, err := s.col.UpdateOne(ctx, bson.M{
+		"_id":   id,
+		"code":  "",
+		"error": "",
+	}, bson.M{"$set": bson.M{"code": code, "error": errMsg}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (s *SSOStates) Delete(ctx context.Context, id string) error {
+	res, err := s.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodeSSOState(raw bson.M) (*SSOState, error) {
+	var linkOwner thread.PubKey
+	if v, ok := raw["link_owner_id"].(primitive.Binary); ok {
+		pk := &thread.Libp2pPubKey{}
+		if err := pk.UnmarshalBinary(v.Data); err != nil {
+			return nil, err
+		}
+		linkOwner = pk
+	}
+	var expiry time.Time
+	if v, ok := raw["expires_at"]; ok {
+		expiry = v.(primitive.DateTime).Time()
+	}
+	str := func(k string) string {
+		v, _ := raw[k].(string)
+		return v
+	}
+	return &SSOState{
+		ID:          str("_id"),
+		Org:         str("org"),
+		Nonce:       str("nonce"),
+		RedirectURI: str("redirect_uri"),
+		Verifier:    str("verifier"),
+		Username:    str("username"),
+		LinkOwner:   linkOwner,
+		Code:        str("code"),
+		Error:       str("error"),
+		ExpiresAt:   expiry,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/v2/mongodb"
+)
+
+func TestSSOStates_Create(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSSOStates(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), "myorg", "http://127.0.0.1:8080/callback", "", "jane", nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.Nonce)
+	assert.True(t, created.ExpiresAt.After(time.Now()))
+}
+
+func TestSSOStates_Get(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSSOStates(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), "myorg", "http://127.0.0.1:8080/callback", "verifier", "jane", nil)
+	require.NoError(t, err)
+
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, "myorg", got.Org)
+	assert.Equal(t, created.Nonce, got.Nonce)
+	assert.Equal(t, "http://127.0.0.1:8080/callback", got.RedirectURI)
+	assert.Equal(t, "verifier", got.Verifier)
+	assert.Equal(t, "jane", got.Username)
+	assert.Nil(t, got.LinkOwner)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err = col.Create(context.Background(), "", "", "verifier", "", thread.NewLibp2pPubKey(owner))
+	require.NoError(t, err)
+	got, err = col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LinkOwner)
+	assert.True(t, got.LinkOwner.Equals(thread.NewLibp2pPubKey(owner)))
+}
+
+func TestSSOStates_SetResult(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSSOStates(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), "", "http://localhost/sso/callback", "verifier", "", nil)
+	require.NoError(t, err)
+
+	err = col.SetResult(context.Background(), created.ID, "code", "")
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "code", got.Code)
+
+	// Results can't be overwritten
+	err = col.SetResult(context.Background(), created.ID, "other", "")
+	require.Error(t, err)
+}
+
+func TestSSOStates_Delete(t *testing.T) {
+	db := newDB(t)
+	col, err := NewSSOStates(context.Background(), db)
+	require.NoError(t, err)
+
+	created, err := col.Create(context.Background(), "", "http://localhost/sso/callback", "", "", nil)
+	require.NoError(t, err)
+
+	err = col.Delete(context.Background(), created.ID)
+	require.NoError(t, err)
+	_, err = col.Get(context.Background(), created.ID)
+	require.Error(t, err)
+}
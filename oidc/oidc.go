@@ -0,0 +1,408 @@
+// Package oidc implements OpenID Connect sign in with the authorization code flow and PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/oauth2"
+)
+
+// CallbackPath is the hub gateway path that receives identity provider callbacks.
+const CallbackPath = "/sso/callback"
+
+var (
+	// ErrInvalidToken indicates the provider returned an ID token that failed verification.
+	ErrInvalidToken = errors.New("invalid ID token")
+	// ErrEmailNotVerified indicates the provider has not verified the user's email address.
+	ErrEmailNotVerified = errors.New("email address is not verified by the identity provider")
+
+	// keysRefreshInterval limits how often signing keys are refetched for an unknown key ID.
+	keysRefreshInterval = time.Minute
+	// clockSkew is the leeway allowed when validating token times.
+	clockSkew = time.Minute
+	// requestTimeout bounds requests made to the provider.
+	requestTimeout = time.Second * 30
+)
+
+// Config describes an identity provider client.
+type Config struct {
+	// Issuer is the provider's issuer URL, used for discovery.
+	Issuer string
+	// ClientID and ClientSecret are the registered client credentials.
+	ClientID     string
+	ClientSecret string
+	// Scopes are requested in addition to openid, email and profile.
+	Scopes []string
+	// HTTPClient is used for requests to the provider. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Claims are the verified user claims from an ID token.
+type Claims struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// Provider is an OpenID Connect identity provider.
+// Provider metadata is discovered on first use.
+type Provider struct {
+	conf   Config
+	client *http.Client
+
+	lk        sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+	keysAt    time.Time
+}
+
+// discovery is the subset of provider metadata used for sign in.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewProvider returns a provider for conf.
+func NewProvider(conf Config) (*Provider, error) {
+	if conf.Issuer == "" {
+		return nil, fmt.Errorf("issuer is required")
+	}
+	if conf.ClientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	client := conf.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{
+		conf:   conf,
+		client: client,
+	}, nil
+}
+
+// Issuer returns the provider's issuer URL.
+func (p *Provider) Issuer() string {
+	return p.conf.Issuer
+}
+
+// ClientID returns the client ID registered with the provider.
+func (p *Provider) ClientID() string {
+	return p.conf.ClientID
+}
+
+// Discover fetches the provider metadata if it hasn't been fetched yet.
+func (p *Provider) Discover(ctx context.Context) error {
+	_, err := p.getDiscovery(ctx)
+	return err
+}
+
+// AuthCodeURL returns the URL to send the user to for sign in.
+// challenge is the PKCE code challenge for the verifier later passed to Exchange.
+func (p *Provider) AuthCodeURL(ctx context.Context, redirectURI, state, nonce, challenge string) (string, error) {
+	oc, err := p.oauthConfig(ctx, redirectURI)
+	if err != nil {
+		return "", err
+	}
+	return oc.AuthCodeURL(
+		state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.SetAuthURLParam("code_challenge", challenge),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	), nil
+}
+
+// Exchange exchanges an authorization code for verified user claims.
+// nonce must match the nonce passed to AuthCodeURL.
+func (p *Provider) Exchange(ctx context.Context, redirectURI, code, verifier, nonce string) (*Claims, error) {
+	oc, err := p.oauthConfig(ctx, redirectURI)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, oauth2.HTTPClient, p.client), requestTimeout)
+	defer cancel()
+	tok, err := oc.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchanging code: %v", err)
+	}
+	raw, ok := tok.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("%w: token response is missing id_token", ErrInvalidToken)
+	}
+	return p.Verify(ctx, raw, nonce)
+}
+
+// Verify verifies an ID token issued to this client and returns its claims.
+func (p *Provider) Verify(ctx context.Context, raw, nonce string) (*Claims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var claims idClaims
+	if _, err := new(jwt.Parser).ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unsupported signing method %v", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		return p.getKey(ctx, d.JWKSURI, kid)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	switch {
+	case claims.Issuer != d.Issuer:
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidToken, claims.Issuer)
+	case !claims.Audience.contains(p.conf.ClientID):
+		return nil, fmt.Errorf("%w: token was not issued for this client", ErrInvalidToken)
+	case claims.Nonce != nonce:
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidToken)
+	case claims.Subject == "":
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	return &Claims{
+		Issuer:            claims.Issuer,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     bool(claims.EmailVerified),
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+	}, nil
+}
+
+func (p *Provider) oauthConfig(ctx context.Context, redirectURI string) (*oauth2.Config, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID:     p.conf.ClientID,
+		ClientSecret: p.conf.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+		RedirectURL: redirectURI,
+		Scopes:      append([]string{"openid", "email", "profile"}, p.conf.Scopes...),
+	}, nil
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	var d discovery
+	u := strings.TrimSuffix(p.conf.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, u, &d); err != nil {
+		return nil, fmt.Errorf("discovering provider: %v", err)
+	}
+	if d.Issuer != p.conf.Issuer {
+		return nil, fmt.Errorf("discovering provider: issuer %s does not match %s", d.Issuer, p.conf.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, fmt.Errorf("discovering provider: missing endpoints")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey returns the signing key with ID kid, refetching the key set if needed.
+func (p *Provider) getKey(ctx context.Context, jwksURI, kid string) (*rsa.PublicKey, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+	if k, ok := p.lookupKey(kid); ok {
+		return k, nil
+	}
+	if time.Since(p.keysAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("signing key %s not found", kid)
+	}
+	var set jwks
+	if err := p.getJSON(ctx, jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("fetching signing keys: %v", err)
+	}
+	p.keys = make(map[string]*rsa.PublicKey)
+	p.keysAt = time.Now()
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		pk, err := k.rsaKey()
+		if err != nil {
+			return nil, fmt.Errorf("fetching signing keys: %v", err)
+		}
+		p.keys[k.Kid] = pk
+	}
+	if k, ok := p.lookupKey(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("signing key %s not found", kid)
+}
+
+// lookupKey returns the key with ID kid, or the only key if kid is empty.
+func (p *Provider) lookupKey(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k, true
+		}
+	}
+	k, ok := p.keys[kid]
+	return k, ok
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", u, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// NewVerifier returns a random PKCE code verifier.
+func NewVerifier() string {
+	return randomString(32)
+}
+
+// Challenge returns the S256 PKCE code challenge for verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// idClaims are the ID token claims.
+type idClaims struct {
+	Issuer            string       `json:"iss"`
+	Subject           string       `json:"sub"`
+	Audience          audience     `json:"aud"`
+	ExpiresAt         int64        `json:"exp"`
+	IssuedAt          int64        `json:"iat"`
+	NotBefore         int64        `json:"nbf"`
+	Nonce             string       `json:"nonce"`
+	Email             string       `json:"email"`
+	EmailVerified     flexibleBool `json:"email_verified"`
+	Name              string       `json:"name"`
+	PreferredUsername string       `json:"preferred_username"`
+}
+
+// Valid implements jwt.Claims.
+func (c idClaims) Valid() error {
+	now := time.Now()
+	if c.ExpiresAt == 0 || now.After(time.Unix(c.ExpiresAt, 0).Add(clockSkew)) {
+		return errors.New("token is expired")
+	}
+	if c.IssuedAt != 0 && now.Before(time.Unix(c.IssuedAt, 0).Add(-clockSkew)) {
+		return errors.New("token used before issued")
+	}
+	if c.NotBefore != 0 && now.Before(time.Unix(c.NotBefore, 0).Add(-clockSkew)) {
+		return errors.New("token is not valid yet")
+	}
+	return nil
+}
+
+// audience is a JWT aud claim, which may be a string or an array.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+	var l []string
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+	*a = l
+	return nil
+}
+
+func (a audience) contains(v string) bool {
+	for _, s := range a {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// flexibleBool is a bool claim that some providers encode as a string.
+type flexibleBool bool
+
+func (f *flexibleBool) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case bool:
+		*f = flexibleBool(v)
+	case string:
+		*f = v == "true"
+	}
+	return nil
+}
+
+// jwks is a JSON Web Key Set.
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key modulus: %v", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key exponent: %v", err)
+	}
+	if len(e) == 0 || len(e) > 4 {
+		return nil, fmt.Errorf("invalid key exponent")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
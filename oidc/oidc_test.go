@@ -0,0 +1,159 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/v2/oidc"
+	"github.com/textileio/textile/v2/oidc/oidctest"
+)
+
+const redirectURI = "http://127.0.0.1:9999/callback"
+
+func TestProvider_Exchange(t *testing.T) {
+	server, p := setup(t)
+	ctx := context.Background()
+
+	claims := signin(t, server, p, "nonce", oidc.NewVerifier(), "")
+	assert.Equal(t, server.Issuer(), claims.Issuer)
+	assert.Equal(t, "123", claims.Subject)
+	assert.Equal(t, "jane@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "jane", claims.PreferredUsername)
+
+	t.Run("bad verifier", func(t *testing.T) {
+		u, err := p.AuthCodeURL(ctx, redirectURI, "state", "nonce", oidc.Challenge(oidc.NewVerifier()))
+		require.NoError(t, err)
+		redirect, err := server.Authorize(u)
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, redirectURI, redirect.Query().Get("code"), oidc.NewVerifier(), "nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("bad nonce", func(t *testing.T) {
+		verifier := oidc.NewVerifier()
+		u, err := p.AuthCodeURL(ctx, redirectURI, "state", "nonce", oidc.Challenge(verifier))
+		require.NoError(t, err)
+		redirect, err := server.Authorize(u)
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, redirectURI, redirect.Query().Get("code"), verifier, "other")
+		require.True(t, errors.Is(err, oidc.ErrInvalidToken))
+	})
+
+	t.Run("code reuse", func(t *testing.T) {
+		verifier := oidc.NewVerifier()
+		u, err := p.AuthCodeURL(ctx, redirectURI, "state", "nonce", oidc.Challenge(verifier))
+		require.NoError(t, err)
+		redirect, err := server.Authorize(u)
+		require.NoError(t, err)
+		code := redirect.Query().Get("code")
+		_, err = p.Exchange(ctx, redirectURI, code, verifier, "nonce")
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, redirectURI, code, verifier, "nonce")
+		require.Error(t, err)
+	})
+}
+
+func TestProvider_Verify(t *testing.T) {
+	server, p := setup(t)
+	ctx := context.Background()
+	now := time.Now()
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            server.Issuer(),
+			"sub":            "123",
+			"aud":            []string{"other", oidctest.ClientID},
+			"exp":            now.Add(time.Hour).Unix(),
+			"iat":            now.Unix(),
+			"nonce":          "nonce",
+			"email":          "jane@example.com",
+			"email_verified": "true",
+		}
+	}
+
+	tok, err := server.SignIDToken(valid())
+	require.NoError(t, err)
+	claims, err := p.Verify(ctx, tok, "nonce")
+	require.NoError(t, err)
+	assert.True(t, claims.EmailVerified)
+
+	for name, change := range map[string]func(jwt.MapClaims){
+		"expired":      func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Hour).Unix() },
+		"wrong issuer": func(c jwt.MapClaims) { c["iss"] = "https://example.com" },
+		"wrong client": func(c jwt.MapClaims) { c["aud"] = "other" },
+		"no subject":   func(c jwt.MapClaims) { delete(c, "sub") },
+		"no expiry":    func(c jwt.MapClaims) { delete(c, "exp") },
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := valid()
+			change(c)
+			tok, err := server.SignIDToken(c)
+			require.NoError(t, err)
+			_, err = p.Verify(ctx, tok, "nonce")
+			require.True(t, errors.Is(err, oidc.ErrInvalidToken))
+		})
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		tok, err := jwt.NewWithClaims(jwt.SigningMethodHS256, valid()).SignedString([]byte(oidctest.ClientSecret))
+		require.NoError(t, err)
+		_, err = p.Verify(ctx, tok, "nonce")
+		require.True(t, errors.Is(err, oidc.ErrInvalidToken))
+	})
+}
+
+func TestProvider_Discover(t *testing.T) {
+	server, _ := setup(t)
+	p, err := oidc.NewProvider(oidc.Config{
+		Issuer:   server.Issuer() + "/other",
+		ClientID: oidctest.ClientID,
+	})
+	require.NoError(t, err)
+	require.Error(t, p.Discover(context.Background()))
+
+	_, err = oidc.NewProvider(oidc.Config{Issuer: server.Issuer()})
+	require.Error(t, err)
+}
+
+func TestChallenge(t *testing.T) {
+	// From RFC 7636 appendix B
+	assert.Equal(t,
+		"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		oidc.Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+	assert.NotEqual(t, oidc.NewVerifier(), oidc.NewVerifier())
+}
+
+func setup(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	server, err := oidctest.NewServer(oidctest.User{
+		Subject:           "123",
+		Email:             "jane@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "jane",
+	})
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	p, err := oidc.NewProvider(oidc.Config{
+		Issuer:       server.Issuer(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+	})
+	require.NoError(t, err)
+	return server, p
+}
+
+func signin(t *testing.T, server *oidctest.Server, p *oidc.Provider, nonce, verifier, state string) *oidc.Claims {
+	ctx := context.Background()
+	u, err := p.AuthCodeURL(ctx, redirectURI, state, nonce, oidc.Challenge(verifier))
+	require.NoError(t, err)
+	redirect, err := server.Authorize(u)
+	require.NoError(t, err)
+	assert.Equal(t, state, redirect.Query().Get("state"))
+	claims, err := p.Exchange(ctx, redirectURI, redirect.Query().Get("code"), verifier, nonce)
+	require.NoError(t, err)
+	return claims
+}
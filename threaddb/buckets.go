@@ -50,9 +50,17 @@ type Bucket struct {
 type Metadata struct {
 	Key       string                  `json:"key,omitempty"`
 	Roles     map[string]buckets.Role `json:"roles"`
+	Info      *FileInfo               `json:"info,omitempty"`
 	UpdatedAt int64                   `json:"updated_at"`
 }
 
+// FileInfo contains POSIX attributes of a bucket file.
+type FileInfo struct {
+	Mode    uint32 `json:"mode"`
+	ModTime int64  `json:"mod_time,omitempty"`
+	Symlink string `json:"symlink,omitempty"`
+}
+
 // NewDefaultMetadata returns the default metadata for a path.
 func NewDefaultMetadata(owner thread.PubKey, key []byte, ts time.Time) Metadata {
 	roles := make(map[string]buckets.Role)
@@ -219,6 +227,9 @@ func (b *Bucket) SetMetadataAtPath(pth string, md Metadata) {
 		if md.Roles != nil {
 			x.Roles = md.Roles
 		}
+		if md.Info != nil {
+			x.Info = md.Info
+		}
 		x.UpdatedAt = md.UpdatedAt
 		b.Metadata[pth] = x
 	} else {